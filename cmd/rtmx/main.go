@@ -2,7 +2,6 @@
 package main
 
 import (
-	"errors"
 	"os"
 
 	"github.com/rtmx-ai/rtmx-go/internal/cmd"
@@ -10,13 +9,8 @@ import (
 
 func main() {
 	if err := cmd.Execute(); err != nil {
-		// Print error (SilenceErrors suppresses Cobra output)
-		os.Stderr.WriteString("Error: " + err.Error() + "\n")
-		// Check for ExitError to get specific exit code
-		var exitErr *cmd.ExitError
-		if errors.As(err, &exitErr) {
-			os.Exit(exitErr.Code)
-		}
-		os.Exit(1)
+		// Execute has already reported the error (SilenceErrors
+		// suppresses Cobra output); exit with its specific code
+		os.Exit(cmd.ExitCode(err))
 	}
 }
@@ -1656,6 +1656,23 @@ func TestJiraServerTrailingSlash(t *testing.T) {
 // Helper Function Tests
 // =============================================================================
 
+func TestRTMXAssignee(t *testing.T) {
+	mapping := map[string]string{"carol": "u3", "bob": "u2", "alice": "u2", "dave": "u4"}
+
+	// Several assignees mapped to one user resolve to the first in order
+	for i := 0; i < 20; i++ {
+		if got := rtmxAssignee(mapping, "", "u2"); got != "alice" {
+			t.Fatalf("rtmxAssignee(u2) = %q, want alice", got)
+		}
+	}
+	if got := rtmxAssignee(mapping, "fallback", "", "u3"); got != "carol" {
+		t.Errorf("rtmxAssignee(u3) = %q, want carol", got)
+	}
+	if got := rtmxAssignee(mapping, "fallback", "u9"); got != "fallback" {
+		t.Errorf("rtmxAssignee(u9) = %q, want fallback", got)
+	}
+}
+
 func TestTruncateStr(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1784,6 +1801,7 @@ func TestJiraIssueWithAllFields(t *testing.T) {
 		Name string `json:"name"`
 	}{Name: "High"}
 	issue.Fields.Assignee = &struct {
+		AccountID   string `json:"accountId"`
 		DisplayName string `json:"displayName"`
 	}{DisplayName: "John Doe"}
 
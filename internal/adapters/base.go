@@ -2,6 +2,10 @@
 package adapters
 
 import (
+	"regexp"
+	"sort"
+	"strings"
+
 	"github.com/rtmx-ai/rtmx-go/internal/database"
 )
 
@@ -38,15 +42,117 @@ type ServiceAdapter interface {
 
 // ExternalItem represents an item from an external service
 type ExternalItem struct {
-	ExternalID    string   // Service-specific ID (issue number, ticket key)
-	Title         string   // Item title/summary
-	Description   string   // Item description/body
-	Status        string   // Service-specific status
-	Labels        []string // Tags/labels
-	URL           string   // Web URL to view item
-	CreatedAt     string   // ISO timestamp
-	UpdatedAt     string   // ISO timestamp
-	Assignee      string   // Assigned user
-	Priority      string   // Priority level
-	RequirementID string   // Linked RTMX requirement ID (if found)
+	ExternalID    string          // Service-specific ID (issue number, ticket key)
+	Title         string          // Item title/summary
+	Description   string          // Item description/body
+	Status        string          // Service-specific status
+	LabelStatus   string          // RTM status the item's labels map to, overriding Status; "" if none
+	Labels        []string        // Tags/labels
+	URL           string          // Web URL to view item
+	CreatedAt     string          // ISO timestamp
+	UpdatedAt     string          // ISO timestamp
+	Assignee      string          // Assigned user
+	AssigneeID    string          // Service user ID when it differs from Assignee (Jira accountId)
+	Priority      string          // Priority level
+	Release       string          // Milestone or fix version
+	Blocked       string          // Why the item is blocked or flagged, "" if it is not
+	Repo          string          // Source repository, for services with several (GitHub owner/repo)
+	Subtasks      map[string]bool // Child requirement ID -> checked, from the item's task list
+	RequirementID string          // Linked RTMX requirement ID (if found)
+}
+
+// SubtaskAdapter is implemented by adapters that carry a requirement's
+// children (hierarchical IDs such as REQ-AUTH-001.1) as a task list in the
+// parent's item instead of as items of their own.
+type SubtaskAdapter interface {
+	// SetSubtasks gives the adapter the lookup for a requirement's
+	// children, used when rendering the parent's item.
+	SetSubtasks(children func(reqID string) []*database.Requirement)
+}
+
+// ItemLinker is implemented by adapters that can give an item's web
+// address from its ID, e.g. for an item just created.
+type ItemLinker interface {
+	// ItemURL returns the web address of the item with externalID.
+	ItemURL(externalID string) string
+}
+
+// AssigneeMapper is implemented by adapters that sync assignees between
+// the RTM and the external service.
+type AssigneeMapper interface {
+	// MapAssigneeToRTMX returns the RTM assignee for an item's assignee.
+	MapAssigneeToRTMX(item ExternalItem) string
+
+	// MapAssigneeFromRTMX returns the external user for an RTM assignee,
+	// or "" when the assignee is not mapped.
+	MapAssigneeFromRTMX(assignee string) string
+}
+
+// rtmxAssignee looks up the RTM assignee mapped to any of the external
+// user identifiers, falling back to fallback when none is mapped.
+func rtmxAssignee(mapping map[string]string, fallback string, ids ...string) string {
+	// Take assignees in order so the choice is stable when several map to
+	// the same user
+	assignees := make([]string, 0, len(mapping))
+	for assignee := range mapping {
+		assignees = append(assignees, assignee)
+	}
+	sort.Strings(assignees)
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		for _, assignee := range assignees {
+			if mapping[assignee] == id {
+				return assignee
+			}
+		}
+	}
+	return fallback
+}
+
+// ExternalIDFor returns the ID linking req to the named service. A legacy
+// unprefixed external_id is attributed to the service only when the
+// requirement has no per-service IDs, so links made before per-service
+// IDs existed keep working.
+func ExternalIDFor(req *database.Requirement, service string) string {
+	if id := req.ExternalIDFor(service); id != "" {
+		return id
+	}
+	if len(req.ExternalIDs) == 0 {
+		return req.ExternalID
+	}
+	return ""
+}
+
+// LinkExternalID records id as req's link to the named service. A legacy
+// unprefixed external_id referring to the same item is migrated.
+func LinkExternalID(req *database.Requirement, service, id string) {
+	req.SetExternalIDFor(service, id)
+	if req.ExternalID == id {
+		req.ExternalID = ""
+	}
+}
+
+// StripMarker removes the "RTMX: <ID>" lines linking an item's body to a
+// requirement whose ID starts with prefix, and the "---" rule RTMX writes
+// above its marker.
+func StripMarker(body, prefix string) string {
+	marker := regexp.MustCompile(`^\s*RTMX:\s*` + regexp.QuoteMeta(prefix) + `-\S+\s*$`)
+	lines := strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if !marker.MatchString(line) {
+			kept = append(kept, line)
+		}
+	}
+	stripped := strings.TrimSpace(strings.Join(kept, "\n"))
+	return strings.TrimSpace(strings.TrimSuffix(stripped, "---"))
+}
+
+// markerPattern matches the "RTMX: <ID>" marker that links an issue to a
+// requirement whose ID starts with prefix, capturing the ID.
+func markerPattern(prefix string) *regexp.Regexp {
+	p := regexp.QuoteMeta(prefix)
+	return regexp.MustCompile(`(?:RTMX:|` + p + `-)\s*(` + p + `-[A-Z0-9_]+-\d+)`)
 }
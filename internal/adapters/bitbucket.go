@@ -0,0 +1,430 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// defaultBitbucketServer is Bitbucket Cloud's REST API.
+const defaultBitbucketServer = "https://api.bitbucket.org/2.0"
+
+// BitbucketAdapter syncs requirements with Bitbucket issues
+type BitbucketAdapter struct {
+	config *config.BitbucketAdapterConfig
+	client HTTPClient
+	onPage PageCallback
+	marker *regexp.Regexp
+	title  *titleFormatter
+	auth   string // Authorization header value
+}
+
+// BitbucketIssue represents a Bitbucket issue from the API
+type BitbucketIssue struct {
+	ID       int    `json:"id"`
+	Title    string `json:"title"`
+	State    string `json:"state"`
+	Kind     string `json:"kind"`
+	Priority string `json:"priority"`
+	Content  struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	Assignee *struct {
+		DisplayName string `json:"display_name"`
+		AccountID   string `json:"account_id"`
+	} `json:"assignee"`
+	Milestone *struct {
+		Name string `json:"name"`
+	} `json:"milestone"`
+	CreatedOn string `json:"created_on"`
+	UpdatedOn string `json:"updated_on"`
+	Links     struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+}
+
+// BitbucketIssuePage is one page of a Bitbucket issue listing. Next is
+// the URL of the following page, "" on the last.
+type BitbucketIssuePage struct {
+	Values []BitbucketIssue `json:"values"`
+	Next   string           `json:"next"`
+}
+
+// NewBitbucketAdapter creates a new Bitbucket adapter.
+// Options can be provided to inject custom dependencies for testing.
+func NewBitbucketAdapter(cfg *config.BitbucketAdapterConfig, opts ...AdapterOption) (*BitbucketAdapter, error) {
+	if !cfg.Enabled {
+		return nil, fmt.Errorf("Bitbucket adapter is not enabled")
+	}
+
+	options := applyOptions(opts)
+
+	tokenEnv := cfg.TokenEnv
+	if tokenEnv == "" {
+		tokenEnv = "BITBUCKET_TOKEN"
+	}
+	usernameEnv := cfg.UsernameEnv
+	if usernameEnv == "" {
+		usernameEnv = "BITBUCKET_USERNAME"
+	}
+
+	token := options.getEnv(tokenEnv)
+	if token == "" {
+		return nil, fmt.Errorf("Bitbucket token not found. Set %s environment variable", tokenEnv)
+	}
+	title, err := newTitleFormatter(cfg.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	// App passwords authenticate as a user; access tokens on their own
+	secrets := []string{token}
+	auth := "Bearer " + token
+	if username := options.getEnv(usernameEnv); username != "" {
+		basic := base64.StdEncoding.EncodeToString([]byte(username + ":" + token))
+		auth = "Basic " + basic
+		secrets = append(secrets, basic, username+":"+token)
+	}
+
+	redact := options.redactor(secrets...)
+	return &BitbucketAdapter{
+		config: cfg,
+		client: options.client(redact),
+		onPage: options.onPage,
+		marker: markerPattern(options.idPrefix),
+		title:  title,
+		auth:   auth,
+	}, nil
+}
+
+// Name returns the adapter name
+func (b *BitbucketAdapter) Name() string {
+	return "bitbucket"
+}
+
+// IsConfigured checks if the adapter is properly configured
+func (b *BitbucketAdapter) IsConfigured() bool {
+	return b.config.Enabled && b.config.Workspace != "" && b.config.Repo != "" && b.auth != ""
+}
+
+// repoURL returns the API URL of the configured repository.
+func (b *BitbucketAdapter) repoURL() string {
+	server := b.config.Server
+	if server == "" {
+		server = defaultBitbucketServer
+	}
+	return fmt.Sprintf("%s/repositories/%s/%s", strings.TrimSuffix(server, "/"),
+		url.PathEscape(b.config.Workspace), url.PathEscape(b.config.Repo))
+}
+
+// do sends an authenticated request with an optional JSON payload.
+func (b *BitbucketAdapter) do(ctx context.Context, method, endpoint string, payload interface{}) (*http.Response, error) {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal payload: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", b.auth)
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	return resp, nil
+}
+
+// TestConnection tests the connection to Bitbucket
+func (b *BitbucketAdapter) TestConnection() (bool, string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := b.do(ctx, "GET", b.repoURL(), nil)
+	if err != nil {
+		return false, fmt.Sprintf("Connection failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return false, fmt.Sprintf("Connection failed: HTTP %d", resp.StatusCode)
+	}
+
+	var repo struct {
+		FullName  string `json:"full_name"`
+		HasIssues bool   `json:"has_issues"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&repo); err != nil {
+		return false, fmt.Sprintf("Failed to parse response: %v", err)
+	}
+	if !repo.HasIssues {
+		return false, fmt.Sprintf("Repository %s has no issue tracker", repo.FullName)
+	}
+
+	return true, fmt.Sprintf("Connected to %s", repo.FullName)
+}
+
+// FetchItems fetches issues from Bitbucket. The query may hold a
+// Bitbucket query language filter under "q" and an issue state under
+// "state".
+func (b *BitbucketAdapter) FetchItems(query map[string]interface{}) ([]ExternalItem, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var filters []string
+	if query != nil {
+		if q, ok := query["q"].(string); ok && q != "" {
+			filters = append(filters, q)
+		}
+		if state, ok := query["state"].(string); ok && state != "" {
+			filters = append(filters, fmt.Sprintf("state = %q", state))
+		}
+	}
+	params := url.Values{}
+	params.Set("pagelen", "50")
+	if len(filters) > 0 {
+		params.Set("q", strings.Join(filters, " AND "))
+	}
+
+	var allItems []ExternalItem
+	next := b.repoURL() + "/issues?" + params.Encode()
+	for page := 1; next != ""; page++ {
+		resp, err := b.do(ctx, "GET", next, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var issuePage BitbucketIssuePage
+		if resp.StatusCode != 200 {
+			resp.Body.Close()
+			return nil, fmt.Errorf("API error: HTTP %d", resp.StatusCode)
+		}
+		err = json.NewDecoder(resp.Body).Decode(&issuePage)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		for _, issue := range issuePage.Values {
+			allItems = append(allItems, b.issueToItem(issue))
+		}
+		notifyPage(b.onPage, page, len(allItems))
+		next = issuePage.Next
+	}
+
+	return allItems, nil
+}
+
+// GetItem gets a single issue by ID
+func (b *BitbucketAdapter) GetItem(externalID string) (*ExternalItem, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := b.do(ctx, "GET", b.repoURL()+"/issues/"+url.PathEscape(externalID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("API error: HTTP %d", resp.StatusCode)
+	}
+
+	var issue BitbucketIssue
+	if err := json.NewDecoder(resp.Body).Decode(&issue); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	item := b.issueToItem(issue)
+	return &item, nil
+}
+
+// ItemURL returns the web address of a Bitbucket issue. For a server
+// other than Bitbucket Cloud, the API path is dropped from its URL.
+func (b *BitbucketAdapter) ItemURL(externalID string) string {
+	web := "https://bitbucket.org"
+	if server := strings.TrimSuffix(b.config.Server, "/"); server != "" && server != defaultBitbucketServer {
+		web = strings.TrimSuffix(server, "/2.0")
+	}
+	return fmt.Sprintf("%s/%s/%s/issues/%s", web, b.config.Workspace, b.config.Repo, externalID)
+}
+
+// issueContent returns the issue body for a requirement, ending with the
+// marker linking it back.
+func issueContent(req *database.Requirement) map[string]string {
+	raw := req.RequirementText
+	if req.Notes != "" {
+		raw += "\n\nNotes:\n" + req.Notes
+	}
+	raw += fmt.Sprintf("\n\n---\nRTMX: %s", req.ReqID)
+	return map[string]string{"raw": raw}
+}
+
+// CreateItem creates a new Bitbucket issue from a requirement
+func (b *BitbucketAdapter) CreateItem(req *database.Requirement) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	kind := b.config.Kind
+	if kind == "" {
+		kind = "task"
+	}
+	payload := map[string]interface{}{
+		"title":   b.title.Title(req),
+		"content": issueContent(req),
+		"kind":    kind,
+		"state":   b.MapStatusFromRTMX(req.Status),
+	}
+
+	resp, err := b.do(ctx, "POST", b.repoURL()+"/issues", payload)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 201 {
+		return "", fmt.Errorf("API error: HTTP %d", resp.StatusCode)
+	}
+
+	var created struct {
+		ID int `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return strconv.Itoa(created.ID), nil
+}
+
+// UpdateItem updates an existing Bitbucket issue, including its state
+func (b *BitbucketAdapter) UpdateItem(externalID string, req *database.Requirement) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	payload := map[string]interface{}{
+		"title":   b.title.Title(req),
+		"content": issueContent(req),
+		"state":   b.MapStatusFromRTMX(req.Status),
+	}
+
+	resp, err := b.do(ctx, "PUT", b.repoURL()+"/issues/"+url.PathEscape(externalID), payload)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode == 200
+}
+
+// MapStatusToRTMX maps a Bitbucket issue state to RTMX status
+func (b *BitbucketAdapter) MapStatusToRTMX(state string) database.Status {
+	// Use configured mapping if available
+	for bbState, rtmxStatus := range b.config.StatusMapping {
+		if strings.EqualFold(bbState, state) {
+			if parsed, err := database.ParseStatus(rtmxStatus); err == nil {
+				return parsed
+			}
+		}
+	}
+
+	// Default mapping
+	switch strings.ToLower(state) {
+	case "resolved", "closed":
+		return database.StatusComplete
+	case "on hold", "in progress":
+		return database.StatusPartial
+	default:
+		return database.StatusMissing
+	}
+}
+
+// MapStatusFromRTMX maps RTMX status to a Bitbucket issue state
+func (b *BitbucketAdapter) MapStatusFromRTMX(status database.Status) string {
+	// Reverse the status mapping, taking states in order so the choice is stable
+	states := make([]string, 0, len(b.config.StatusMapping))
+	for bbState := range b.config.StatusMapping {
+		states = append(states, bbState)
+	}
+	sort.Strings(states)
+	for _, bbState := range states {
+		if parsed, err := database.ParseStatus(b.config.StatusMapping[bbState]); err == nil && parsed == status {
+			return bbState
+		}
+	}
+
+	// Default mapping
+	switch status {
+	case database.StatusComplete:
+		return "resolved"
+	case database.StatusPartial:
+		return "open"
+	default:
+		return "new"
+	}
+}
+
+// issueToItem converts a Bitbucket issue to an ExternalItem
+func (b *BitbucketAdapter) issueToItem(issue BitbucketIssue) ExternalItem {
+	// Extract requirement ID from the issue content
+	reqID := ""
+	if matches := b.marker.FindStringSubmatch(issue.Content.Raw); len(matches) > 1 {
+		reqID = matches[1]
+	}
+
+	assignee, assigneeID := "", ""
+	if issue.Assignee != nil {
+		assignee = issue.Assignee.DisplayName
+		assigneeID = issue.Assignee.AccountID
+	}
+
+	release := ""
+	if issue.Milestone != nil {
+		release = issue.Milestone.Name
+	}
+
+	id := strconv.Itoa(issue.ID)
+	issueURL := issue.Links.HTML.Href
+	if issueURL == "" {
+		issueURL = b.ItemURL(id)
+	}
+
+	return ExternalItem{
+		ExternalID:    id,
+		Title:         issue.Title,
+		Description:   issue.Content.Raw,
+		Status:        issue.State,
+		URL:           issueURL,
+		CreatedAt:     issue.CreatedOn,
+		UpdatedAt:     issue.UpdatedOn,
+		Assignee:      assignee,
+		AssigneeID:    assigneeID,
+		Priority:      issue.Priority,
+		Release:       release,
+		RequirementID: reqID,
+	}
+}
@@ -0,0 +1,232 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// bitbucketMockClient serves issue pages by URL and answers other
+// requests with a fixed response.
+type bitbucketMockClient struct {
+	pages    map[string]string // request URL -> response
+	status   int
+	body     string
+	Requests []*http.Request
+	Bodies   []string
+}
+
+func (m *bitbucketMockClient) Do(req *http.Request) (*http.Response, error) {
+	m.Requests = append(m.Requests, req)
+	body := ""
+	if req.Body != nil {
+		data, _ := io.ReadAll(req.Body)
+		body = string(data)
+	}
+	m.Bodies = append(m.Bodies, body)
+	if page, ok := m.pages[req.URL.String()]; ok {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(page))}, nil
+	}
+	return &http.Response{StatusCode: m.status, Body: io.NopCloser(bytes.NewBufferString(m.body))}, nil
+}
+
+func newBitbucketTestAdapter(t *testing.T, cfg config.BitbucketAdapterConfig, client HTTPClient, env map[string]string) *BitbucketAdapter {
+	t.Helper()
+	cfg.Enabled = true
+	adapter, err := NewBitbucketAdapter(&cfg,
+		WithHTTPClient(client),
+		WithEnvGetter(func(key string) string { return env[key] }),
+	)
+	if err != nil {
+		t.Fatalf("NewBitbucketAdapter failed: %v", err)
+	}
+	return adapter
+}
+
+func TestNewBitbucketAdapter(t *testing.T) {
+	cfg := config.BitbucketAdapterConfig{Enabled: true, Workspace: "acme", Repo: "app"}
+	if _, err := NewBitbucketAdapter(&cfg, WithEnvGetter(func(string) string { return "" })); err == nil {
+		t.Error("Expected error when the token is not set")
+	}
+	cfg.Enabled = false
+	if _, err := NewBitbucketAdapter(&cfg, WithEnvGetter(func(string) string { return "token" })); err == nil {
+		t.Error("Expected error when the adapter is disabled")
+	}
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{"access token", map[string]string{"BITBUCKET_TOKEN": "secret"}, "Bearer secret"},
+		{"app password", map[string]string{"BITBUCKET_TOKEN": "secret", "BITBUCKET_USERNAME": "alice"}, "Basic YWxpY2U6c2VjcmV0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &bitbucketMockClient{status: http.StatusOK, body: `{"full_name":"acme/app","has_issues":true}`}
+			adapter := newBitbucketTestAdapter(t, config.BitbucketAdapterConfig{Workspace: "acme", Repo: "app"}, client, tt.env)
+			if adapter.Name() != "bitbucket" || !adapter.IsConfigured() {
+				t.Fatalf("Expected a configured bitbucket adapter")
+			}
+			if ok, msg := adapter.TestConnection(); !ok || msg != "Connected to acme/app" {
+				t.Errorf("TestConnection = %v, %q", ok, msg)
+			}
+			if got := client.Requests[0].Header.Get("Authorization"); got != tt.want {
+				t.Errorf("Authorization = %q, want %q", got, tt.want)
+			}
+			if got := client.Requests[0].URL.String(); got != "https://api.bitbucket.org/2.0/repositories/acme/app" {
+				t.Errorf("URL = %s, want the Bitbucket Cloud repository", got)
+			}
+		})
+	}
+}
+
+func TestBitbucketStatusMapping(t *testing.T) {
+	adapter := newBitbucketTestAdapter(t, config.BitbucketAdapterConfig{}, &bitbucketMockClient{}, map[string]string{"BITBUCKET_TOKEN": "t"})
+
+	toRTMX := map[string]database.Status{
+		"new":         database.StatusMissing,
+		"open":        database.StatusMissing,
+		"on hold":     database.StatusPartial,
+		"In Progress": database.StatusPartial,
+		"resolved":    database.StatusComplete,
+		"closed":      database.StatusComplete,
+		"wontfix":     database.StatusMissing,
+	}
+	for state, want := range toRTMX {
+		if got := adapter.MapStatusToRTMX(state); got != want {
+			t.Errorf("MapStatusToRTMX(%q) = %s, want %s", state, got, want)
+		}
+	}
+	fromRTMX := map[database.Status]string{
+		database.StatusMissing:  "new",
+		database.StatusPartial:  "open",
+		database.StatusComplete: "resolved",
+	}
+	for status, want := range fromRTMX {
+		if got := adapter.MapStatusFromRTMX(status); got != want {
+			t.Errorf("MapStatusFromRTMX(%s) = %q, want %q", status, got, want)
+		}
+	}
+
+	// A configured mapping overrides the defaults both ways
+	adapter = newBitbucketTestAdapter(t, config.BitbucketAdapterConfig{
+		StatusMapping: map[string]string{"wontfix": "NOT_STARTED", "on hold": "PARTIAL", "closed": "COMPLETE", "resolved": "COMPLETE"},
+	}, &bitbucketMockClient{}, map[string]string{"BITBUCKET_TOKEN": "t"})
+	if got := adapter.MapStatusToRTMX("Wontfix"); got != database.StatusNotStarted {
+		t.Errorf("MapStatusToRTMX(wontfix) = %s, want NOT_STARTED from the mapping", got)
+	}
+	if got := adapter.MapStatusFromRTMX(database.StatusPartial); got != "on hold" {
+		t.Errorf("MapStatusFromRTMX(PARTIAL) = %q, want on hold from the mapping", got)
+	}
+	if got := adapter.MapStatusFromRTMX(database.StatusComplete); got != "closed" {
+		t.Errorf("MapStatusFromRTMX(COMPLETE) = %q, want the first mapped state", got)
+	}
+}
+
+func TestBitbucketFetchItems(t *testing.T) {
+	base := "https://api.bitbucket.org/2.0/repositories/acme/app/issues"
+	client := &bitbucketMockClient{pages: map[string]string{
+		base + "?pagelen=50": `{"values":[
+			{"id":1,"title":"Login","state":"open","priority":"major",
+			 "content":{"raw":"Users sign in\n\n---\nRTMX: REQ-AUTH-001"},
+			 "assignee":{"display_name":"Alice","account_id":"557058:abc"},
+			 "milestone":{"name":"v1.0"},
+			 "links":{"html":{"href":"https://bitbucket.org/acme/app/issues/1"}}}
+		],"next":"` + base + `?page=2&pagelen=50"}`,
+		base + "?page=2&pagelen=50": `{"values":[{"id":2,"title":"Logout","state":"resolved","content":{"raw":"No marker"}}]}`,
+	}}
+	adapter := newBitbucketTestAdapter(t, config.BitbucketAdapterConfig{Workspace: "acme", Repo: "app"}, client, map[string]string{"BITBUCKET_TOKEN": "t"})
+
+	items, err := adapter.FetchItems(nil)
+	if err != nil {
+		t.Fatalf("FetchItems failed: %v", err)
+	}
+	if len(items) != 2 || len(client.Requests) != 2 {
+		t.Fatalf("Expected 2 items from 2 pages, got %d items from %d requests", len(items), len(client.Requests))
+	}
+
+	first := items[0]
+	if first.ExternalID != "1" || first.RequirementID != "REQ-AUTH-001" || first.Status != "open" {
+		t.Errorf("first item = %+v, want issue 1 linked to REQ-AUTH-001", first)
+	}
+	if first.Assignee != "Alice" || first.AssigneeID != "557058:abc" || first.Release != "v1.0" || first.Priority != "major" {
+		t.Errorf("first item = %+v, want its assignee, milestone and priority", first)
+	}
+	if first.URL != "https://bitbucket.org/acme/app/issues/1" {
+		t.Errorf("URL = %q, want the issue's html link", first.URL)
+	}
+	second := items[1]
+	if second.RequirementID != "" || adapter.MapStatusToRTMX(second.Status) != database.StatusComplete {
+		t.Errorf("second item = %+v, want an unlinked resolved issue", second)
+	}
+	if second.URL != "https://bitbucket.org/acme/app/issues/2" {
+		t.Errorf("URL = %q, want one built from the issue ID", second.URL)
+	}
+
+	// Filters go into the q parameter
+	client.Requests = nil
+	_, _ = adapter.FetchItems(map[string]interface{}{"state": "open", "q": `kind = "task"`})
+	if got := client.Requests[0].URL.Query().Get("q"); got != `kind = "task" AND state = "open"` {
+		t.Errorf("q = %q, want both filters", got)
+	}
+}
+
+func TestBitbucketCreateAndUpdateItem(t *testing.T) {
+	client := &bitbucketMockClient{status: http.StatusCreated, body: `{"id":42}`}
+	adapter := newBitbucketTestAdapter(t, config.BitbucketAdapterConfig{Workspace: "acme", Repo: "app", Kind: "enhancement"},
+		client, map[string]string{"BITBUCKET_TOKEN": "t"})
+
+	req := database.NewRequirement("REQ-AUTH-001")
+	req.RequirementText = "Users sign in with SSO"
+	req.Status = database.StatusPartial
+
+	id, err := adapter.CreateItem(req)
+	if err != nil {
+		t.Fatalf("CreateItem failed: %v", err)
+	}
+	if id != "42" {
+		t.Errorf("CreateItem = %q, want 42", id)
+	}
+	if r := client.Requests[0]; r.Method != "POST" || r.URL.Path != "/2.0/repositories/acme/app/issues" {
+		t.Errorf("request = %s %s, want POST to the issues endpoint", r.Method, r.URL.Path)
+	}
+	var payload struct {
+		Title   string            `json:"title"`
+		Kind    string            `json:"kind"`
+		State   string            `json:"state"`
+		Content map[string]string `json:"content"`
+	}
+	if err := json.Unmarshal([]byte(client.Bodies[0]), &payload); err != nil {
+		t.Fatalf("Failed to decode payload %s: %v", client.Bodies[0], err)
+	}
+	if payload.Title != "[REQ-AUTH-001] Users sign in with SSO" || payload.Kind != "enhancement" || payload.State != "open" {
+		t.Errorf("payload = %+v", payload)
+	}
+	if !strings.HasSuffix(payload.Content["raw"], "RTMX: REQ-AUTH-001") {
+		t.Errorf("content = %q, want the RTMX marker", payload.Content["raw"])
+	}
+
+	client.status, client.body = http.StatusOK, `{"id":42}`
+	req.Status = database.StatusComplete
+	if !adapter.UpdateItem("42", req) {
+		t.Fatal("UpdateItem failed")
+	}
+	if r := client.Requests[1]; r.Method != "PUT" || r.URL.Path != "/2.0/repositories/acme/app/issues/42" {
+		t.Errorf("request = %s %s, want PUT to the issue", r.Method, r.URL.Path)
+	}
+	if !strings.Contains(client.Bodies[1], `"state":"resolved"`) {
+		t.Errorf("update payload = %s, want the resolved state", client.Bodies[1])
+	}
+
+	client.status = http.StatusNotFound
+	if adapter.UpdateItem("43", req) {
+		t.Error("Expected UpdateItem to fail on HTTP 404")
+	}
+}
@@ -18,7 +18,16 @@ type GitHubAdapter struct {
 	config *config.GitHubAdapterConfig
 	client HTTPClient
 	getEnv func(string) string
+	onPage PageCallback
+	marker *regexp.Regexp
+	title  *titleFormatter
 	token  string
+
+	// includePRs keeps pull requests, which the issues API also lists.
+	includePRs bool
+
+	// children looks up the requirements rendered as the issue's task list.
+	children func(reqID string) []*database.Requirement
 }
 
 // GitHubIssue represents a GitHub issue from the API
@@ -36,6 +45,18 @@ type GitHubIssue struct {
 	Assignee *struct {
 		Login string `json:"login"`
 	} `json:"assignee"`
+	Milestone *struct {
+		Title string `json:"title"`
+	} `json:"milestone"`
+	// PullRequest is set when the issue is a pull request.
+	PullRequest *struct {
+		URL string `json:"url"`
+	} `json:"pull_request"`
+}
+
+// IsPullRequest reports whether the issue is a pull request.
+func (i GitHubIssue) IsPullRequest() bool {
+	return i.PullRequest != nil
 }
 
 // NewGitHubAdapter creates a new GitHub adapter.
@@ -56,12 +77,21 @@ func NewGitHubAdapter(cfg *config.GitHubAdapterConfig, opts ...AdapterOption) (*
 	if token == "" {
 		return nil, fmt.Errorf("GitHub token not found. Set %s environment variable", tokenEnv)
 	}
+	title, err := newTitleFormatter(cfg.Title)
+	if err != nil {
+		return nil, err
+	}
 
 	return &GitHubAdapter{
 		config: cfg,
-		client: options.httpClient,
+		client: options.client(options.redactor(token)),
 		getEnv: options.getEnv,
+		onPage: options.onPage,
+		marker: markerPattern(options.idPrefix),
+		title:  title,
 		token:  token,
+
+		includePRs: options.includePRs,
 	}, nil
 }
 
@@ -72,7 +102,49 @@ func (g *GitHubAdapter) Name() string {
 
 // IsConfigured checks if the adapter is properly configured
 func (g *GitHubAdapter) IsConfigured() bool {
-	return g.config.Enabled && g.config.Repo != "" && g.token != ""
+	return g.config.Enabled && g.defaultRepo() != "" && g.token != ""
+}
+
+// defaultRepo is the repository new issues are created in.
+func (g *GitHubAdapter) defaultRepo() string {
+	if g.config.Repo != "" {
+		return g.config.Repo
+	}
+	if len(g.config.Repos) > 0 {
+		return g.config.Repos[0]
+	}
+	return ""
+}
+
+// repos lists every repository issues are fetched from, the default first.
+func (g *GitHubAdapter) repos() []string {
+	var repos []string
+	seen := make(map[string]bool)
+	for _, repo := range append([]string{g.defaultRepo()}, g.config.Repos...) {
+		if repo = strings.TrimSpace(repo); repo != "" && !seen[repo] {
+			seen[repo] = true
+			repos = append(repos, repo)
+		}
+	}
+	return repos
+}
+
+// issueRef splits an external ID into its repository and issue number.
+// Plain numbers refer to the default repository.
+func (g *GitHubAdapter) issueRef(externalID string) (repo, number string) {
+	if repo, number, ok := strings.Cut(externalID, "#"); ok && strings.Contains(repo, "/") {
+		return repo, number
+	}
+	return g.defaultRepo(), externalID
+}
+
+// issueID is the external ID of an issue: its number in the default
+// repository, owner/repo#number elsewhere.
+func (g *GitHubAdapter) issueID(repo string, number int) string {
+	if repo == g.defaultRepo() {
+		return fmt.Sprintf("%d", number)
+	}
+	return fmt.Sprintf("%s#%d", repo, number)
 }
 
 // TestConnection tests the connection to GitHub
@@ -80,7 +152,7 @@ func (g *GitHubAdapter) TestConnection() (bool, string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	url := fmt.Sprintf("https://api.github.com/repos/%s", g.config.Repo)
+	url := fmt.Sprintf("https://api.github.com/repos/%s", g.defaultRepo())
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -110,7 +182,8 @@ func (g *GitHubAdapter) TestConnection() (bool, string) {
 	return true, fmt.Sprintf("Connected to %s", repo.FullName)
 }
 
-// FetchItems fetches issues from GitHub
+// FetchItems fetches issues from every configured repository. Pull
+// requests are skipped unless the adapter was built WithPullRequests.
 func (g *GitHubAdapter) FetchItems(query map[string]interface{}) ([]ExternalItem, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -122,7 +195,31 @@ func (g *GitHubAdapter) FetchItems(query map[string]interface{}) ([]ExternalItem
 		}
 	}
 
-	url := fmt.Sprintf("https://api.github.com/repos/%s/issues?state=%s&per_page=100", g.config.Repo, state)
+	repos := g.repos()
+	items := make([]ExternalItem, 0)
+	for page, repo := range repos {
+		issues, err := g.fetchIssues(ctx, repo, state)
+		if err != nil {
+			if len(repos) > 1 {
+				return nil, fmt.Errorf("%s: %w", repo, err)
+			}
+			return nil, err
+		}
+		for _, issue := range issues {
+			if issue.IsPullRequest() && !g.includePRs {
+				continue
+			}
+			items = append(items, g.repoItem(issue, repo))
+		}
+		notifyPage(g.onPage, page+1, len(items))
+	}
+
+	return items, nil
+}
+
+// fetchIssues fetches the issues of one repository.
+func (g *GitHubAdapter) fetchIssues(ctx context.Context, repo, state string) ([]GitHubIssue, error) {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/issues?state=%s&per_page=100", repo, state)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -146,13 +243,7 @@ func (g *GitHubAdapter) FetchItems(query map[string]interface{}) ([]ExternalItem
 	if err := json.NewDecoder(resp.Body).Decode(&issues); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
-
-	items := make([]ExternalItem, 0, len(issues))
-	for _, issue := range issues {
-		items = append(items, g.issueToItem(issue))
-	}
-
-	return items, nil
+	return issues, nil
 }
 
 // GetItem gets a single issue by number
@@ -160,7 +251,8 @@ func (g *GitHubAdapter) GetItem(externalID string) (*ExternalItem, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	url := fmt.Sprintf("https://api.github.com/repos/%s/issues/%s", g.config.Repo, externalID)
+	repo, number := g.issueRef(externalID)
+	url := fmt.Sprintf("https://api.github.com/repos/%s/issues/%s", repo, number)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -185,7 +277,7 @@ func (g *GitHubAdapter) GetItem(externalID string) (*ExternalItem, error) {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	item := g.issueToItem(issue)
+	item := g.repoItem(issue, repo)
 	return &item, nil
 }
 
@@ -194,25 +286,12 @@ func (g *GitHubAdapter) CreateItem(req *database.Requirement) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	url := fmt.Sprintf("https://api.github.com/repos/%s/issues", g.config.Repo)
-
-	// Build description
-	desc := req.RequirementText
-	if req.Notes != "" {
-		desc += "\n\n## Notes\n" + req.Notes
-	}
-	desc += fmt.Sprintf("\n\n---\nRTMX: %s", req.ReqID)
-
-	title := fmt.Sprintf("[%s] %s", req.ReqID, truncateStr(req.RequirementText, 80))
+	url := fmt.Sprintf("https://api.github.com/repos/%s/issues", g.defaultRepo())
 
-	payload := map[string]interface{}{
-		"title": title,
-		"body":  desc,
-	}
-
-	// Add labels if configured
-	if g.config.Labels.Requirement != "" {
-		payload["labels"] = []string{g.config.Labels.Requirement}
+	// Build title, body and labels, following the issue template if configured
+	payload, err := g.createPayload(req)
+	if err != nil {
+		return "", err
 	}
 
 	payloadBytes, err := json.Marshal(payload)
@@ -247,26 +326,30 @@ func (g *GitHubAdapter) CreateItem(req *database.Requirement) (string, error) {
 	return fmt.Sprintf("%d", issue.Number), nil
 }
 
+// ItemURL returns the web address of a GitHub issue.
+func (g *GitHubAdapter) ItemURL(externalID string) string {
+	repo, number := g.issueRef(externalID)
+	return fmt.Sprintf("https://github.com/%s/issues/%s", repo, number)
+}
+
 // UpdateItem updates an existing GitHub issue
 func (g *GitHubAdapter) UpdateItem(externalID string, req *database.Requirement) bool {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	url := fmt.Sprintf("https://api.github.com/repos/%s/issues/%s", g.config.Repo, externalID)
+	repo, number := g.issueRef(externalID)
+	url := fmt.Sprintf("https://api.github.com/repos/%s/issues/%s", repo, number)
 
-	// Build description
-	desc := req.RequirementText
-	if req.Notes != "" {
-		desc += "\n\n## Notes\n" + req.Notes
-	}
-	desc += fmt.Sprintf("\n\n---\nRTMX: %s", req.ReqID)
-
-	title := fmt.Sprintf("[%s] %s", req.ReqID, truncateStr(req.RequirementText, 80))
+	title := g.title.Title(req)
 
 	payload := map[string]interface{}{
-		"title": title,
-		"body":  desc,
-		"state": g.MapStatusFromRTMX(req.Status),
+		"title":        title,
+		"body":         issueBody(req, g.subtasks(req.ReqID)),
+		"state":        g.MapStatusFromRTMX(req.Status),
+		"state_reason": githubStateReason(req.Status),
+	}
+	if login := g.MapAssigneeFromRTMX(req.Assignee); login != "" {
+		payload["assignees"] = []string{login}
 	}
 
 	payloadBytes, _ := json.Marshal(payload)
@@ -289,6 +372,19 @@ func (g *GitHubAdapter) UpdateItem(externalID string, req *database.Requirement)
 	return resp.StatusCode == 200
 }
 
+// SetSubtasks renders each requirement's children as a task list in its
+// issue.
+func (g *GitHubAdapter) SetSubtasks(children func(reqID string) []*database.Requirement) {
+	g.children = children
+}
+
+func (g *GitHubAdapter) subtasks(reqID string) []*database.Requirement {
+	if g.children == nil {
+		return nil
+	}
+	return g.children(reqID)
+}
+
 // MapStatusToRTMX maps GitHub issue state to RTMX status
 func (g *GitHubAdapter) MapStatusToRTMX(state string) database.Status {
 	switch strings.ToLower(state) {
@@ -301,23 +397,67 @@ func (g *GitHubAdapter) MapStatusToRTMX(state string) database.Status {
 	}
 }
 
+// notPlannedStatuses are the custom statuses of requirements that were
+// dropped rather than completed.
+var notPlannedStatuses = map[database.Status]bool{
+	"DEPRECATED": true,
+	"CANCELLED":  true,
+	"CANCELED":   true,
+}
+
 // MapStatusFromRTMX maps RTMX status to GitHub issue state
 func (g *GitHubAdapter) MapStatusFromRTMX(status database.Status) string {
-	switch status {
-	case database.StatusComplete:
+	switch {
+	case status == database.StatusComplete, notPlannedStatuses[status]:
 		return "closed"
 	default:
 		return "open"
 	}
 }
 
+// githubStateReason returns the state_reason for an issue whose
+// requirement has status: "completed" or "not_planned" when it is closed,
+// and "reopened" otherwise, so a regressed requirement reopens its issue.
+// GitHub ignores the reason when the state does not change.
+func githubStateReason(status database.Status) string {
+	switch {
+	case status == database.StatusComplete:
+		return "completed"
+	case notPlannedStatuses[status]:
+		return "not_planned"
+	default:
+		return "reopened"
+	}
+}
+
+// MapAssigneeToRTMX maps an issue's assignee login to an RTM assignee.
+// Logins without a mapping are used as-is.
+func (g *GitHubAdapter) MapAssigneeToRTMX(item ExternalItem) string {
+	return rtmxAssignee(g.config.AssigneeMapping, item.Assignee, item.Assignee)
+}
+
+// MapAssigneeFromRTMX maps an RTM assignee to a GitHub login.
+func (g *GitHubAdapter) MapAssigneeFromRTMX(assignee string) string {
+	if assignee == "" {
+		return ""
+	}
+	return g.config.AssigneeMapping[assignee]
+}
+
+// repoItem converts an issue fetched from repo to an ExternalItem.
+func (g *GitHubAdapter) repoItem(issue GitHubIssue, repo string) ExternalItem {
+	item := g.issueToItem(issue)
+	item.ExternalID = g.issueID(repo, issue.Number)
+	item.Repo = repo
+	return item
+}
+
 // issueToItem converts a GitHub issue to an ExternalItem
 func (g *GitHubAdapter) issueToItem(issue GitHubIssue) ExternalItem {
 	// Extract requirement ID from body
 	reqID := ""
 	if issue.Body != "" {
-		re := regexp.MustCompile(`(?:RTMX:|REQ-)\s*(REQ-[A-Z]+-\d+)`)
-		if matches := re.FindStringSubmatch(issue.Body); len(matches) > 1 {
+		if matches := g.marker.FindStringSubmatch(issue.Body); len(matches) > 1 {
 			reqID = matches[1]
 		}
 	}
@@ -334,6 +474,23 @@ func (g *GitHubAdapter) issueToItem(issue GitHubIssue) ExternalItem {
 		assignee = issue.Assignee.Login
 	}
 
+	release := ""
+	if issue.Milestone != nil {
+		release = issue.Milestone.Title
+	}
+
+	blocked := ""
+	blockedLabel := g.config.Labels.Blocked
+	if blockedLabel == "" {
+		blockedLabel = "blocked"
+	}
+	for _, label := range labels {
+		if strings.EqualFold(label, blockedLabel) {
+			blocked = fmt.Sprintf("labeled %s", label)
+			break
+		}
+	}
+
 	return ExternalItem{
 		ExternalID:    fmt.Sprintf("%d", issue.Number),
 		Title:         issue.Title,
@@ -345,12 +502,30 @@ func (g *GitHubAdapter) issueToItem(issue GitHubIssue) ExternalItem {
 		UpdatedAt:     issue.UpdatedAt.Format(time.RFC3339),
 		Assignee:      assignee,
 		Priority:      g.extractPriority(labels),
+		LabelStatus:   g.extractStatus(labels),
+		Release:       release,
+		Blocked:       blocked,
 		RequirementID: reqID,
+		Subtasks:      parseTaskList(issue.Body),
 	}
 }
 
-// extractPriority extracts priority from issue labels
+// extractStatus returns the RTM status the first label with a configured
+// status mapping maps to, or "".
+func (g *GitHubAdapter) extractStatus(labels []string) string {
+	if status := lookupLabel(g.config.Labels.Status, labels); status != "" {
+		return strings.ToUpper(status)
+	}
+	return ""
+}
+
+// extractPriority extracts priority from issue labels, trying the
+// configured priority labels before the built-in ones
 func (g *GitHubAdapter) extractPriority(labels []string) string {
+	if priority := lookupLabel(g.config.Labels.Priority, labels); priority != "" {
+		return strings.ToUpper(priority)
+	}
+
 	priorityMap := map[string]string{
 		"priority:critical": "P0",
 		"priority:high":     "HIGH",
@@ -371,9 +546,31 @@ func (g *GitHubAdapter) extractPriority(labels []string) string {
 	return ""
 }
 
+// lookupLabel returns the value mapping gives the first of labels it has,
+// matching label names case-insensitively.
+func lookupLabel(mapping map[string]string, labels []string) string {
+	if len(mapping) == 0 {
+		return ""
+	}
+	lower := make(map[string]string, len(mapping))
+	for label, value := range mapping {
+		lower[strings.ToLower(label)] = value
+	}
+	for _, label := range labels {
+		if value := lower[strings.ToLower(label)]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
 func truncateStr(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
 		return s
 	}
-	return s[:maxLen-3] + "..."
+	if maxLen <= 3 {
+		return string(runes[:maxLen])
+	}
+	return string(runes[:maxLen-3]) + "..."
 }
@@ -0,0 +1,333 @@
+package adapters
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultIssueTemplateDir is where GitHub looks for issue templates.
+const defaultIssueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+// issueTemplate is the part of a GitHub issue form or Markdown template
+// that created issues must follow.
+type issueTemplate struct {
+	Name     string
+	Labels   []string
+	Type     string
+	Sections []string
+}
+
+// issueForm is the YAML shape of a GitHub issue form.
+type issueForm struct {
+	Name   string      `yaml:"name"`
+	Labels interface{} `yaml:"labels"`
+	Type   string      `yaml:"type"`
+	Body   []struct {
+		Type       string `yaml:"type"`
+		Attributes struct {
+			Label string `yaml:"label"`
+		} `yaml:"attributes"`
+	} `yaml:"body"`
+}
+
+// loadIssueTemplate finds the template called name in dir, matching either
+// the file name (without extension) or the template's name field.
+func loadIssueTemplate(dir, name string) (*issueTemplate, error) {
+	if dir == "" {
+		dir = defaultIssueTemplateDir
+	}
+
+	var candidates []string
+	if filepath.Ext(name) != "" {
+		candidates = append(candidates, filepath.Join(dir, name))
+	} else {
+		for _, ext := range []string{".yml", ".yaml", ".md"} {
+			candidates = append(candidates, filepath.Join(dir, name+ext))
+		}
+	}
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return parseIssueTemplateFile(path)
+		}
+	}
+
+	// Fall back to matching the name declared inside each template
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("issue template %q not found: %w", name, err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		tmpl, err := parseIssueTemplateFile(filepath.Join(dir, entry.Name()))
+		if err == nil && strings.EqualFold(tmpl.Name, name) {
+			return tmpl, nil
+		}
+	}
+	return nil, fmt.Errorf("issue template %q not found in %s", name, dir)
+}
+
+// parseIssueTemplateFile parses an issue form (.yml/.yaml) or a Markdown
+// template with YAML front matter (.md).
+func parseIssueTemplateFile(path string) (*issueTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read issue template: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		return parseIssueForm(data)
+	case ".md":
+		return parseMarkdownTemplate(string(data))
+	default:
+		return nil, fmt.Errorf("unsupported issue template %s", path)
+	}
+}
+
+func parseIssueForm(data []byte) (*issueTemplate, error) {
+	var form issueForm
+	if err := yaml.Unmarshal(data, &form); err != nil {
+		return nil, fmt.Errorf("failed to parse issue form: %w", err)
+	}
+
+	tmpl := &issueTemplate{
+		Name:   form.Name,
+		Labels: templateLabels(form.Labels),
+		Type:   form.Type,
+	}
+	for _, field := range form.Body {
+		// Markdown blocks are instructions, not fields
+		if field.Type == "markdown" || field.Attributes.Label == "" {
+			continue
+		}
+		tmpl.Sections = append(tmpl.Sections, field.Attributes.Label)
+	}
+	return tmpl, nil
+}
+
+func parseMarkdownTemplate(content string) (*issueTemplate, error) {
+	tmpl := &issueTemplate{}
+
+	body := content
+	if strings.HasPrefix(content, "---") {
+		rest := strings.TrimPrefix(content, "---")
+		front, after, ok := strings.Cut(rest, "\n---")
+		if !ok {
+			return nil, fmt.Errorf("unterminated front matter in issue template")
+		}
+		var meta struct {
+			Name   string      `yaml:"name"`
+			Labels interface{} `yaml:"labels"`
+			Type   string      `yaml:"type"`
+		}
+		if err := yaml.Unmarshal([]byte(front), &meta); err != nil {
+			return nil, fmt.Errorf("failed to parse issue template front matter: %w", err)
+		}
+		tmpl.Name = meta.Name
+		tmpl.Labels = templateLabels(meta.Labels)
+		tmpl.Type = meta.Type
+		body = after
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			if heading := strings.TrimSpace(strings.TrimLeft(line, "#")); heading != "" {
+				tmpl.Sections = append(tmpl.Sections, heading)
+			}
+		}
+	}
+	return tmpl, nil
+}
+
+// templateLabels accepts labels as a YAML list or a comma-separated string.
+func templateLabels(v interface{}) []string {
+	var labels []string
+	switch l := v.(type) {
+	case string:
+		for _, label := range strings.Split(l, ",") {
+			if label = strings.TrimSpace(label); label != "" {
+				labels = append(labels, label)
+			}
+		}
+	case []interface{}:
+		for _, item := range l {
+			if label, ok := item.(string); ok && strings.TrimSpace(label) != "" {
+				labels = append(labels, strings.TrimSpace(label))
+			}
+		}
+	}
+	return labels
+}
+
+// renderTemplateBody fills each template section from the requirement,
+// in the layout GitHub uses for submitted issue forms.
+func renderTemplateBody(tmpl *issueTemplate, req *database.Requirement, fields map[string]string, children []*database.Requirement) string {
+	var sb strings.Builder
+	for _, section := range tmpl.Sections {
+		value := req.Field(sectionField(section, fields))
+		if value == "" {
+			value = "_No response_"
+		}
+		sb.WriteString("### " + section + "\n\n" + value + "\n\n")
+	}
+	if len(children) > 0 {
+		sb.WriteString(taskList(children) + "\n\n")
+	}
+	sb.WriteString(fmt.Sprintf("---\nRTMX: %s", req.ReqID))
+	return sb.String()
+}
+
+// sectionField picks the requirement field for a template section: an
+// explicit mapping first, then a match on common section names.
+func sectionField(section string, fields map[string]string) string {
+	for label, field := range fields {
+		if strings.EqualFold(label, section) {
+			return field
+		}
+	}
+
+	lower := strings.ToLower(section)
+	matches := []struct {
+		words []string
+		field string
+	}{
+		{[]string{"acceptance", "criteria", "target"}, "target_value"},
+		{[]string{"note", "additional", "context"}, "notes"},
+		{[]string{"priority"}, "priority"},
+		{[]string{"category", "component", "area"}, "category"},
+		{[]string{"test", "verification", "validation"}, "validation_method"},
+		{[]string{"description", "summary", "requirement", "problem", "feature"}, "requirement_text"},
+	}
+	for _, m := range matches {
+		for _, word := range m.words {
+			if strings.Contains(lower, word) {
+				return m.field
+			}
+		}
+	}
+	return ""
+}
+
+// issueLabels merges label lists in order, dropping blanks and duplicates.
+func issueLabels(lists ...[]string) []string {
+	var labels []string
+	seen := make(map[string]bool)
+	for _, list := range lists {
+		for _, label := range list {
+			if label == "" || seen[label] {
+				continue
+			}
+			seen[label] = true
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// createPayload builds the issue payload for a requirement, following
+// the configured issue template when there is one.
+func (g *GitHubAdapter) createPayload(req *database.Requirement) (map[string]interface{}, error) {
+	payload := map[string]interface{}{
+		"title": g.title.Title(req),
+		"body":  issueBody(req, g.subtasks(req.ReqID)),
+	}
+
+	tmplCfg := g.config.IssueTemplate
+	var tmpl *issueTemplate
+	if tmplCfg.Name != "" {
+		var err error
+		tmpl, err = loadIssueTemplate(tmplCfg.Dir, tmplCfg.Name)
+		if err != nil {
+			return nil, err
+		}
+		payload["body"] = renderTemplateBody(tmpl, req, tmplCfg.Fields, g.subtasks(req.ReqID))
+		if tmpl.Type != "" {
+			payload["type"] = tmpl.Type
+		}
+	} else {
+		tmpl = &issueTemplate{}
+	}
+
+	labels := issueLabels([]string{g.config.Labels.Requirement}, tmpl.Labels, tmplCfg.RequiredLabels)
+	if len(labels) > 0 {
+		payload["labels"] = labels
+	}
+	if login := g.MapAssigneeFromRTMX(req.Assignee); login != "" {
+		payload["assignees"] = []string{login}
+	}
+	return payload, nil
+}
+
+// issueBody is the default issue description for a requirement, listing
+// its children as a task list.
+func issueBody(req *database.Requirement, children []*database.Requirement) string {
+	desc := req.RequirementText
+	if req.Notes != "" {
+		desc += "\n\n## Notes\n" + req.Notes
+	}
+	if len(children) > 0 {
+		desc += "\n\n" + taskList(children)
+	}
+	return desc + fmt.Sprintf("\n\n---\nRTMX: %s", req.ReqID)
+}
+
+// taskList renders child requirements as a Markdown task list, checked
+// when complete.
+func taskList(children []*database.Requirement) string {
+	var sb strings.Builder
+	sb.WriteString("## Sub-tasks\n")
+	for i, child := range children {
+		box := "[ ]"
+		if child.IsComplete() {
+			box = "[x]"
+		}
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(fmt.Sprintf("- %s %s %s", box, child.ReqID, strings.TrimSpace(truncateStr(child.RequirementText, 80))))
+	}
+	return sb.String()
+}
+
+// parseTaskList reads the checked state of task list items that start with
+// a child requirement ID, such as "- [x] REQ-AUTH-001.1 Password reset".
+func parseTaskList(body string) map[string]bool {
+	var tasks map[string]bool
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) < 6 || !strings.ContainsRune("-*+", rune(line[0])) || line[1] != ' ' || line[2] != '[' || line[4] != ']' {
+			continue
+		}
+		var checked bool
+		switch line[3] {
+		case 'x', 'X':
+			checked = true
+		case ' ':
+		default:
+			continue
+		}
+		fields := strings.Fields(line[5:])
+		if len(fields) == 0 {
+			continue
+		}
+		id := strings.TrimRight(fields[0], ":")
+		if database.ParentID(id) == "" {
+			continue
+		}
+		if tasks == nil {
+			tasks = make(map[string]bool)
+		}
+		tasks[id] = checked
+	}
+	return tasks
+}
@@ -1,10 +1,15 @@
 package adapters
 
 import (
+	"bytes"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -214,6 +219,9 @@ func TestIssueToItem(t *testing.T) {
 		Assignee: &struct {
 			Login string `json:"login"`
 		}{Login: "testuser"},
+		Milestone: &struct {
+			Title string `json:"title"`
+		}{Title: "v1.1"},
 	}
 
 	item := adapter.issueToItem(issue)
@@ -233,6 +241,9 @@ func TestIssueToItem(t *testing.T) {
 	if item.Assignee != "testuser" {
 		t.Errorf("Expected Assignee 'testuser', got '%s'", item.Assignee)
 	}
+	if item.Release != "v1.1" {
+		t.Errorf("Expected Release from milestone 'v1.1', got '%s'", item.Release)
+	}
 }
 
 func TestTestConnection(t *testing.T) {
@@ -288,3 +299,571 @@ func TestFetchIssues(t *testing.T) {
 	// Note: Full integration test would require mocking the base URL
 	// This test verifies the function signature and basic structure
 }
+
+func TestExternalIDForService(t *testing.T) {
+	legacy := database.NewRequirement("REQ-TEST-001")
+	legacy.ExternalID = "42"
+	if got := ExternalIDFor(legacy, "github"); got != "42" {
+		t.Errorf("ExternalIDFor(legacy) = %q, want 42", got)
+	}
+
+	LinkExternalID(legacy, "github", "42")
+	if legacy.ExternalID != "" {
+		t.Errorf("Expected legacy ID to be migrated, still %q", legacy.ExternalID)
+	}
+	if got := ExternalIDFor(legacy, "github"); got != "42" {
+		t.Errorf("ExternalIDFor(github) = %q, want 42", got)
+	}
+
+	// Once per-service IDs exist, other services are not given the legacy ID
+	legacy.ExternalID = "99"
+	if got := ExternalIDFor(legacy, "jira"); got != "" {
+		t.Errorf("ExternalIDFor(jira) = %q, want empty", got)
+	}
+}
+
+// createWithTemplate creates an issue for req with the given template
+// config and returns the decoded create payload.
+func createWithTemplate(t *testing.T, tmpl config.GitHubIssueTemplate) map[string]interface{} {
+	t.Helper()
+
+	mockClient := &MockHTTPClient{
+		Response: &http.Response{
+			StatusCode: 201,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"number":7}`)),
+		},
+	}
+	cfg := config.GitHubAdapterConfig{
+		Enabled:       true,
+		Repo:          "owner/repo",
+		Labels:        config.GitHubLabels{Requirement: "requirement"},
+		IssueTemplate: tmpl,
+	}
+	adapter, err := NewGitHubAdapter(&cfg,
+		WithHTTPClient(mockClient),
+		WithEnvGetter(func(key string) string { return "test-token" }),
+	)
+	if err != nil {
+		t.Fatalf("NewGitHubAdapter failed: %v", err)
+	}
+
+	req := database.NewRequirement("REQ-AUTH-001")
+	req.RequirementText = "Users can log in with SSO"
+	req.TargetValue = "Login completes in under 2s"
+	req.Priority = database.PriorityHigh
+
+	if _, err := adapter.CreateItem(req); err != nil {
+		t.Fatalf("CreateItem failed: %v", err)
+	}
+	if len(mockClient.Requests) != 1 {
+		t.Fatalf("Expected 1 request, got %d", len(mockClient.Requests))
+	}
+	body, _ := io.ReadAll(mockClient.Requests[0].Body)
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("Failed to decode payload %s: %v", body, err)
+	}
+	return payload
+}
+
+func payloadLabels(payload map[string]interface{}) []string {
+	var labels []string
+	for _, l := range payload["labels"].([]interface{}) {
+		labels = append(labels, l.(string))
+	}
+	return labels
+}
+
+func TestGitHubCreateItemIssueForm(t *testing.T) {
+	dir := t.TempDir()
+	form := `name: Requirement
+description: Track a requirement
+labels: ["enhancement", "requirement"]
+type: Feature
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for filing!
+  - type: textarea
+    id: description
+    attributes:
+      label: Description
+    validations:
+      required: true
+  - type: textarea
+    id: acceptance
+    attributes:
+      label: Acceptance Criteria
+  - type: dropdown
+    id: priority
+    attributes:
+      label: Priority
+  - type: input
+    id: owner
+    attributes:
+      label: Owning team
+`
+	if err := os.WriteFile(filepath.Join(dir, "requirement.yml"), []byte(form), 0644); err != nil {
+		t.Fatalf("Failed to write form: %v", err)
+	}
+
+	payload := createWithTemplate(t, config.GitHubIssueTemplate{
+		Name:           "requirement",
+		Dir:            dir,
+		RequiredLabels: []string{"triage"},
+	})
+
+	wantLabels := []string{"requirement", "enhancement", "triage"}
+	if got := payloadLabels(payload); !reflect.DeepEqual(got, wantLabels) {
+		t.Errorf("labels = %v, want %v", got, wantLabels)
+	}
+	if payload["type"] != "Feature" {
+		t.Errorf("type = %v, want Feature", payload["type"])
+	}
+
+	body := payload["body"].(string)
+	for _, want := range []string{
+		"### Description\n\nUsers can log in with SSO\n",
+		"### Acceptance Criteria\n\nLogin completes in under 2s\n",
+		"### Priority\n\nHIGH\n",
+		"### Owning team\n\n_No response_\n",
+		"RTMX: REQ-AUTH-001",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected %q in body:\n%s", want, body)
+		}
+	}
+	if strings.Contains(body, "Thanks for filing") {
+		t.Error("Markdown blocks should not become body sections")
+	}
+}
+
+func TestGitHubCreateItemMarkdownTemplate(t *testing.T) {
+	dir := t.TempDir()
+	tmpl := `---
+name: Feature request
+about: Suggest a feature
+labels: feature, needs-review
+---
+
+## Summary
+
+## Notes for reviewers
+`
+	if err := os.WriteFile(filepath.Join(dir, "feature.md"), []byte(tmpl), 0644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+
+	// Matched by the template's name field, with an explicit field mapping
+	payload := createWithTemplate(t, config.GitHubIssueTemplate{
+		Name:   "Feature request",
+		Dir:    dir,
+		Fields: map[string]string{"Notes for reviewers": "target_value"},
+	})
+
+	wantLabels := []string{"requirement", "feature", "needs-review"}
+	if got := payloadLabels(payload); !reflect.DeepEqual(got, wantLabels) {
+		t.Errorf("labels = %v, want %v", got, wantLabels)
+	}
+	body := payload["body"].(string)
+	for _, want := range []string{
+		"### Summary\n\nUsers can log in with SSO\n",
+		"### Notes for reviewers\n\nLogin completes in under 2s\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected %q in body:\n%s", want, body)
+		}
+	}
+	if _, ok := payload["type"]; ok {
+		t.Error("Expected no issue type without one in the template")
+	}
+}
+
+func TestGitHubCreateItemMissingTemplate(t *testing.T) {
+	cfg := config.GitHubAdapterConfig{
+		Enabled:       true,
+		Repo:          "owner/repo",
+		IssueTemplate: config.GitHubIssueTemplate{Name: "nope", Dir: t.TempDir()},
+	}
+	mockClient := &MockHTTPClient{}
+	adapter, _ := NewGitHubAdapter(&cfg,
+		WithHTTPClient(mockClient),
+		WithEnvGetter(func(key string) string { return "test-token" }),
+	)
+
+	if _, err := adapter.CreateItem(database.NewRequirement("REQ-AUTH-001")); err == nil {
+		t.Error("Expected error for a missing issue template")
+	}
+	if len(mockClient.Requests) != 0 {
+		t.Error("No request should be sent when the template cannot be loaded")
+	}
+}
+
+func TestGitHubAssigneeMapping(t *testing.T) {
+	cfg := config.GitHubAdapterConfig{
+		Enabled:         true,
+		Repo:            "owner/repo",
+		AssigneeMapping: map[string]string{"alice": "alice-gh"},
+	}
+	mockClient := &MockHTTPClient{
+		Response: &http.Response{
+			StatusCode: 201,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"number":7}`)),
+		},
+	}
+	adapter, _ := NewGitHubAdapter(&cfg,
+		WithHTTPClient(mockClient),
+		WithEnvGetter(func(key string) string { return "test-token" }),
+	)
+
+	// Import: mapped logins become RTM assignees, others pass through
+	if got := adapter.MapAssigneeToRTMX(ExternalItem{Assignee: "alice-gh"}); got != "alice" {
+		t.Errorf("MapAssigneeToRTMX(alice-gh) = %q, want alice", got)
+	}
+	if got := adapter.MapAssigneeToRTMX(ExternalItem{Assignee: "bob"}); got != "bob" {
+		t.Errorf("MapAssigneeToRTMX(bob) = %q, want bob", got)
+	}
+
+	// Export: the mapped login is assigned on the issue
+	req := database.NewRequirement("REQ-AUTH-001")
+	req.RequirementText = "Users can log in with SSO"
+	req.Assignee = "alice"
+	if _, err := adapter.CreateItem(req); err != nil {
+		t.Fatalf("CreateItem failed: %v", err)
+	}
+	body, _ := io.ReadAll(mockClient.Requests[0].Body)
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("Failed to decode payload %s: %v", body, err)
+	}
+	if got, ok := payload["assignees"].([]interface{}); !ok || len(got) != 1 || got[0] != "alice-gh" {
+		t.Errorf("assignees = %v, want [alice-gh]", payload["assignees"])
+	}
+
+	// Unmapped assignees are not sent
+	if got := adapter.MapAssigneeFromRTMX("carol"); got != "" {
+		t.Errorf("MapAssigneeFromRTMX(carol) = %q, want empty", got)
+	}
+}
+
+// repoMockClient answers GitHub API requests with a per-repository issue
+// list and records every request.
+type repoMockClient struct {
+	issues   map[string]string
+	Requests []*http.Request
+}
+
+func (m *repoMockClient) Do(req *http.Request) (*http.Response, error) {
+	m.Requests = append(m.Requests, req)
+	for repo, body := range m.issues {
+		if strings.HasPrefix(req.URL.Path, "/repos/"+repo+"/") {
+			status := 200
+			if req.Method == "POST" {
+				status = 201
+			}
+			return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}, nil
+		}
+	}
+	return &http.Response{StatusCode: 404, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+}
+
+func TestGitHubMultiRepoFetch(t *testing.T) {
+	cfg := config.GitHubAdapterConfig{
+		Enabled: true,
+		Repo:    "org/api",
+		Repos:   []string{"org/web", "org/api"},
+	}
+	mockClient := &repoMockClient{issues: map[string]string{
+		"org/api": `[{"number":1,"title":"API login","body":"RTMX: REQ-API-001","state":"open"}]`,
+		"org/web": `[{"number":1,"title":"Web login","body":"RTMX: REQ-WEB-001","state":"closed"},
+			{"number":2,"title":"Web logout","state":"open"}]`,
+	}}
+	var pages []int
+	adapter, err := NewGitHubAdapter(&cfg,
+		WithHTTPClient(mockClient),
+		WithEnvGetter(func(key string) string { return "test-token" }),
+		WithPageCallback(func(page, fetched int) { pages = append(pages, fetched) }),
+	)
+	if err != nil {
+		t.Fatalf("NewGitHubAdapter failed: %v", err)
+	}
+
+	items, err := adapter.FetchItems(nil)
+	if err != nil {
+		t.Fatalf("FetchItems failed: %v", err)
+	}
+
+	// Each repository is fetched once, the default first
+	if len(mockClient.Requests) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(mockClient.Requests))
+	}
+	want := []struct{ id, repo, reqID string }{
+		{"1", "org/api", "REQ-API-001"},
+		{"org/web#1", "org/web", "REQ-WEB-001"},
+		{"org/web#2", "org/web", ""},
+	}
+	if len(items) != len(want) {
+		t.Fatalf("Expected %d merged items, got %d: %+v", len(want), len(items), items)
+	}
+	for i, w := range want {
+		if items[i].ExternalID != w.id || items[i].Repo != w.repo || items[i].RequirementID != w.reqID {
+			t.Errorf("items[%d] = {%s %s %s}, want %+v", i, items[i].ExternalID, items[i].Repo, items[i].RequirementID, w)
+		}
+	}
+	if !reflect.DeepEqual(pages, []int{1, 3}) {
+		t.Errorf("page callback totals = %v, want [1 3]", pages)
+	}
+
+	// Qualified IDs address their own repository; new issues go to the default
+	mockClient.Requests = nil
+	mockClient.issues["org/web"] = `{"number":2,"title":"Web logout","state":"open"}`
+	item, err := adapter.GetItem("org/web#2")
+	if err != nil {
+		t.Fatalf("GetItem failed: %v", err)
+	}
+	if item.ExternalID != "org/web#2" || item.Repo != "org/web" {
+		t.Errorf("GetItem = {%s %s}, want org/web#2 from org/web", item.ExternalID, item.Repo)
+	}
+	if got := mockClient.Requests[0].URL.Path; got != "/repos/org/web/issues/2" {
+		t.Errorf("GetItem path = %s", got)
+	}
+
+	mockClient.issues["org/api"] = `{"number":9}`
+	req := database.NewRequirement("REQ-API-002")
+	req.RequirementText = "Rate limiting"
+	id, err := adapter.CreateItem(req)
+	if err != nil {
+		t.Fatalf("CreateItem failed: %v", err)
+	}
+	if got := mockClient.Requests[1].URL.Path; got != "/repos/org/api/issues" || id != "9" {
+		t.Errorf("CreateItem created %q via %s, want 9 in org/api", id, got)
+	}
+}
+
+func TestGitHubSubtaskTaskList(t *testing.T) {
+	db := database.NewDatabase()
+	parent := database.NewRequirement("REQ-AUTH-001")
+	parent.RequirementText = "Account recovery"
+	_ = db.Add(parent)
+	done := database.NewRequirement("REQ-AUTH-001.1")
+	done.RequirementText = "Reset by email"
+	done.Status = database.StatusComplete
+	_ = db.Add(done)
+	todo := database.NewRequirement("REQ-AUTH-001.2")
+	todo.RequirementText = "Reset by SMS"
+	_ = db.Add(todo)
+
+	cfg := config.GitHubAdapterConfig{Enabled: true, Repo: "owner/repo"}
+	mockClient := &MockHTTPClient{
+		Response: &http.Response{
+			StatusCode: 201,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"number":7}`)),
+		},
+	}
+	adapter, _ := NewGitHubAdapter(&cfg,
+		WithHTTPClient(mockClient),
+		WithEnvGetter(func(key string) string { return "test-token" }),
+	)
+	adapter.SetSubtasks(db.Children)
+
+	// Export: children render as a task list in the parent issue
+	if _, err := adapter.CreateItem(parent); err != nil {
+		t.Fatalf("CreateItem failed: %v", err)
+	}
+	body, _ := io.ReadAll(mockClient.Requests[0].Body)
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("Failed to decode payload %s: %v", body, err)
+	}
+	want := "Account recovery\n\n## Sub-tasks\n- [x] REQ-AUTH-001.1 Reset by email\n- [ ] REQ-AUTH-001.2 Reset by SMS\n\n---\nRTMX: REQ-AUTH-001"
+	if payload["body"] != want {
+		t.Errorf("body =\n%v\nwant\n%s", payload["body"], want)
+	}
+
+	// Import: the task list's checked state is read back
+	item := adapter.issueToItem(GitHubIssue{
+		Number: 7,
+		Body:   "Account recovery\n\n## Sub-tasks\n- [x] REQ-AUTH-001.1 Reset by email\n- [X] REQ-AUTH-001.2: Reset by SMS\n- [ ] Write docs\n\n---\nRTMX: REQ-AUTH-001",
+	})
+	wantTasks := map[string]bool{"REQ-AUTH-001.1": true, "REQ-AUTH-001.2": true}
+	if !reflect.DeepEqual(item.Subtasks, wantTasks) {
+		t.Errorf("Subtasks = %v, want %v", item.Subtasks, wantTasks)
+	}
+	if item.RequirementID != "REQ-AUTH-001" {
+		t.Errorf("RequirementID = %q, want REQ-AUTH-001", item.RequirementID)
+	}
+}
+
+func TestGitHubIssueToItemIDPrefix(t *testing.T) {
+	cfg := config.GitHubAdapterConfig{Enabled: true, Repo: "owner/repo"}
+	adapter, err := NewGitHubAdapter(&cfg,
+		WithEnvGetter(func(key string) string { return "test-token" }),
+		WithIDPrefix("PROJ"),
+	)
+	if err != nil {
+		t.Fatalf("NewGitHubAdapter failed: %v", err)
+	}
+
+	tests := []struct {
+		body string
+		want string
+	}{
+		{"Details\n\n---\nRTMX: PROJ-AUTH-001", "PROJ-AUTH-001"},
+		{"Details\n\n---\nRTMX: PROJ-USER_ACCOUNTS-001", "PROJ-USER_ACCOUNTS-001"},
+		{"Details\n\n---\nRTMX: PROJ-OAUTH2-001", "PROJ-OAUTH2-001"},
+		{"Details\n\n---\nRTMX: REQ-AUTH-001", ""},
+	}
+	for _, tt := range tests {
+		item := adapter.issueToItem(GitHubIssue{Number: 1, Body: tt.body})
+		if item.RequirementID != tt.want {
+			t.Errorf("issueToItem(%q).RequirementID = %q, want %q", tt.body, item.RequirementID, tt.want)
+		}
+	}
+}
+
+func TestGitHubIssueToItemBlocked(t *testing.T) {
+	cfg := config.GitHubAdapterConfig{Enabled: true, Repo: "owner/repo"}
+	adapter, err := NewGitHubAdapter(&cfg, WithEnvGetter(func(key string) string { return "test-token" }))
+	if err != nil {
+		t.Fatalf("NewGitHubAdapter failed: %v", err)
+	}
+
+	issue := GitHubIssue{Number: 7}
+	if item := adapter.issueToItem(issue); item.Blocked != "" {
+		t.Errorf("Blocked = %q, want \"\" without the label", item.Blocked)
+	}
+	issue.Labels = []struct {
+		Name string `json:"name"`
+	}{{Name: "requirement"}, {Name: "Blocked"}}
+	if item := adapter.issueToItem(issue); item.Blocked != "labeled Blocked" {
+		t.Errorf("Blocked = %q, want \"labeled Blocked\"", item.Blocked)
+	}
+}
+
+func TestGitHubFetchItemsSkipsPullRequests(t *testing.T) {
+	cfg := config.GitHubAdapterConfig{Enabled: true, Repo: "org/api"}
+	response := `[{"number":1,"title":"Login","body":"RTMX: REQ-API-001","state":"open"},
+		{"number":2,"title":"Implement login","body":"RTMX: REQ-API-001","state":"open",
+			"pull_request":{"url":"https://api.github.com/repos/org/api/pulls/2"}},
+		{"number":3,"title":"Logout","state":"closed"}]`
+
+	tests := []struct {
+		name       string
+		includePRs bool
+		want       []string
+	}{
+		{"issues only by default", false, []string{"1", "3"}},
+		{"pull requests opted in", true, []string{"1", "2", "3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter, err := NewGitHubAdapter(&cfg,
+				WithHTTPClient(&repoMockClient{issues: map[string]string{"org/api": response}}),
+				WithEnvGetter(func(key string) string { return "test-token" }),
+				WithPullRequests(tt.includePRs),
+			)
+			if err != nil {
+				t.Fatalf("NewGitHubAdapter failed: %v", err)
+			}
+			items, err := adapter.FetchItems(nil)
+			if err != nil {
+				t.Fatalf("FetchItems failed: %v", err)
+			}
+			var got []string
+			for _, item := range items {
+				got = append(got, item.ExternalID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("items = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitHubUpdateItemStateReason(t *testing.T) {
+	cfg := config.GitHubAdapterConfig{Enabled: true, Repo: "owner/repo"}
+	tests := []struct {
+		status     database.Status
+		wantState  string
+		wantReason string
+	}{
+		{database.StatusComplete, "closed", "completed"},
+		{"DEPRECATED", "closed", "not_planned"},
+		{database.StatusPartial, "open", "reopened"},
+		{database.StatusMissing, "open", "reopened"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				Response: &http.Response{StatusCode: 200, Body: io.NopCloser(bytes.NewBufferString(`{}`))},
+			}
+			adapter, _ := NewGitHubAdapter(&cfg,
+				WithHTTPClient(mockClient),
+				WithEnvGetter(func(key string) string { return "test-token" }),
+			)
+
+			req := database.NewRequirement("REQ-AUTH-001")
+			req.RequirementText = "Users can log in with SSO"
+			req.Status = tt.status
+			if !adapter.UpdateItem("12", req) {
+				t.Fatal("UpdateItem failed")
+			}
+			if got := mockClient.Requests[0].Method; got != "PATCH" {
+				t.Errorf("method = %s, want PATCH", got)
+			}
+			body, _ := io.ReadAll(mockClient.Requests[0].Body)
+			var payload map[string]interface{}
+			if err := json.Unmarshal(body, &payload); err != nil {
+				t.Fatalf("Failed to decode payload %s: %v", body, err)
+			}
+			if payload["state"] != tt.wantState || payload["state_reason"] != tt.wantReason {
+				t.Errorf("state = %v, state_reason = %v; want %s, %s",
+					payload["state"], payload["state_reason"], tt.wantState, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestGitHubLabelStatusAndPriority(t *testing.T) {
+	cfg := config.GitHubAdapterConfig{
+		Enabled: true,
+		Repo:    "owner/repo",
+		Labels: config.GitHubLabels{
+			Status:   map[string]string{"wontfix": "deprecated"},
+			Priority: map[string]string{"P0": "P0", "bug": "HIGH"},
+		},
+	}
+	adapter, _ := NewGitHubAdapter(&cfg, WithEnvGetter(func(key string) string { return "test-token" }))
+
+	issue := func(state string, labels ...string) GitHubIssue {
+		i := GitHubIssue{Number: 1, State: state}
+		for _, name := range labels {
+			i.Labels = append(i.Labels, struct {
+				Name string `json:"name"`
+			}{Name: name})
+		}
+		return i
+	}
+
+	tests := []struct {
+		name         string
+		issue        GitHubIssue
+		wantStatus   string
+		wantPriority string
+	}{
+		{"closed wontfix", issue("closed", "wontfix"), "DEPRECATED", ""},
+		{"open p0", issue("open", "p0"), "", "P0"},
+		{"configured label before built-in", issue("open", "priority:low", "bug"), "", "HIGH"},
+		{"built-in label without mapping", issue("open", "priority:low"), "", "LOW"},
+		{"no labels", issue("open"), "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := adapter.issueToItem(tt.issue)
+			if item.LabelStatus != tt.wantStatus || item.Priority != tt.wantPriority {
+				t.Errorf("LabelStatus = %q, Priority = %q; want %q, %q",
+					item.LabelStatus, item.Priority, tt.wantStatus, tt.wantPriority)
+			}
+		})
+	}
+}
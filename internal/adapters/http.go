@@ -1,8 +1,11 @@
 package adapters
 
 import (
+	"io"
 	"net/http"
 	"time"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
 )
 
 // HTTPClient abstracts HTTP operations for testing.
@@ -22,8 +25,17 @@ func DefaultHTTPClient() HTTPClient {
 type adapterOptions struct {
 	httpClient HTTPClient
 	getEnv     func(string) string
+	onPage     PageCallback
+	debugLog   io.Writer
+	redact     config.RedactConfig
+	idPrefix   string
+	includePRs bool
 }
 
+// PageCallback is called after each page of items is fetched, with the
+// 1-based page number and the running total of items fetched so far.
+type PageCallback func(page, fetched int)
+
 // AdapterOption configures optional adapter dependencies.
 type AdapterOption func(*adapterOptions)
 
@@ -43,11 +55,74 @@ func WithEnvGetter(fn func(string) string) AdapterOption {
 	}
 }
 
+// WithPageCallback registers a callback invoked after each fetched page.
+// Use this to drive progress indicators during long fetches.
+func WithPageCallback(fn PageCallback) AdapterOption {
+	return func(o *adapterOptions) {
+		o.onPage = fn
+	}
+}
+
+// WithDebugLog logs every request and response to w, with secrets
+// redacted.
+func WithDebugLog(w io.Writer) AdapterOption {
+	return func(o *adapterOptions) {
+		o.debugLog = w
+	}
+}
+
+// WithRedaction adds configured headers and environment variables to
+// the secrets redacted from logs and errors.
+func WithRedaction(cfg config.RedactConfig) AdapterOption {
+	return func(o *adapterOptions) {
+		o.redact = cfg
+	}
+}
+
+// WithIDPrefix sets the requirement ID prefix looked for in issue
+// markers. The default is config.DefaultIDPrefix.
+func WithIDPrefix(prefix string) AdapterOption {
+	return func(o *adapterOptions) {
+		o.idPrefix = prefix
+	}
+}
+
+// WithPullRequests makes the GitHub adapter fetch pull requests as
+// well as issues. By default they are left out.
+func WithPullRequests(include bool) AdapterOption {
+	return func(o *adapterOptions) {
+		o.includePRs = include
+	}
+}
+
+// redactor builds the redactor for an adapter's secrets plus the
+// configured extras.
+func (o *adapterOptions) redactor(secrets ...string) *Redactor {
+	r := NewRedactor(o.redact.Headers, secrets...)
+	for _, name := range o.redact.Env {
+		r.AddSecrets(o.getEnv(name))
+	}
+	return r
+}
+
+// client returns the HTTP client wrapped with redaction.
+func (o *adapterOptions) client(redact *Redactor) HTTPClient {
+	return newRedactingClient(o.httpClient, redact, o.debugLog)
+}
+
+// notifyPage invokes a page callback if one is set.
+func notifyPage(fn PageCallback, page, fetched int) {
+	if fn != nil {
+		fn(page, fetched)
+	}
+}
+
 // defaultOptions returns adapter options with production defaults.
 func defaultOptions() *adapterOptions {
 	return &adapterOptions{
 		httpClient: DefaultHTTPClient(),
 		getEnv:     defaultGetEnv,
+		idPrefix:   config.DefaultIDPrefix,
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
@@ -19,6 +20,9 @@ type JiraAdapter struct {
 	config *config.JiraAdapterConfig
 	client HTTPClient
 	getEnv func(string) string
+	onPage PageCallback
+	marker *regexp.Regexp
+	title  *titleFormatter
 	auth   string // base64 encoded email:token
 }
 
@@ -36,22 +40,83 @@ type JiraIssue struct {
 		} `json:"priority"`
 		Labels   []string `json:"labels"`
 		Assignee *struct {
+			AccountID   string `json:"accountId"`
 			DisplayName string `json:"displayName"`
 		} `json:"assignee"`
-		Created string `json:"created"`
-		Updated string `json:"updated"`
+		FixVersions []struct {
+			Name string `json:"name"`
+		} `json:"fixVersions"`
+		IssueLinks []JiraIssueLink `json:"issuelinks"`
+		Created    string          `json:"created"`
+		Updated    string          `json:"updated"`
 	} `json:"fields"`
 	Self string `json:"self"`
+
+	// rawFields keeps every field, for custom fields such as the flag.
+	rawFields map[string]json.RawMessage
+}
+
+// JiraIssueLink is a link from an issue to another. InwardIssue is set
+// when the other issue is on the inward side, e.g. "is blocked by".
+type JiraIssueLink struct {
+	Type struct {
+		Name    string `json:"name"`
+		Inward  string `json:"inward"`
+		Outward string `json:"outward"`
+	} `json:"type"`
+	InwardIssue *struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Status struct {
+				Name string `json:"name"`
+			} `json:"status"`
+		} `json:"fields"`
+	} `json:"inwardIssue"`
+}
+
+// UnmarshalJSON decodes an issue, keeping its raw fields as well.
+func (i *JiraIssue) UnmarshalJSON(data []byte) error {
+	type plain JiraIssue
+	if err := json.Unmarshal(data, (*plain)(i)); err != nil {
+		return err
+	}
+	var raw struct {
+		Fields map[string]json.RawMessage `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	i.rawFields = raw.Fields
+	return nil
 }
 
-// JiraSearchResponse represents a Jira search API response
+// defaultJiraFlaggedField is Jira Cloud's "Flagged" custom field.
+const defaultJiraFlaggedField = "customfield_10021"
+
+// JiraSearchResponse represents a Jira search API response. The legacy
+// /search API pages with StartAt; /search/jql with NextPageToken and IsLast.
 type JiraSearchResponse struct {
 	Issues     []JiraIssue `json:"issues"`
 	Total      int         `json:"total"`
 	MaxResults int         `json:"maxResults"`
 	StartAt    int         `json:"startAt"`
+
+	NextPageToken string `json:"nextPageToken"`
+	IsLast        *bool  `json:"isLast"`
 }
 
+// Jira search APIs, selected by the search_api setting.
+const (
+	// jiraSearchAuto tries /search/jql and falls back to the legacy API
+	// when the server does not have it.
+	jiraSearchAuto = "auto"
+	// jiraSearchJQL is Jira Cloud's /rest/api/3/search/jql.
+	jiraSearchJQL = "jql"
+	// jiraSearchLegacy is /rest/api/3/search, paged with startAt, as on
+	// Server and Data Center.
+	jiraSearchLegacy = "legacy"
+)
+
 // NewJiraAdapter creates a new Jira adapter.
 // Options can be provided to inject custom dependencies for testing.
 func NewJiraAdapter(cfg *config.JiraAdapterConfig, opts ...AdapterOption) (*JiraAdapter, error) {
@@ -80,14 +145,27 @@ func NewJiraAdapter(cfg *config.JiraAdapterConfig, opts ...AdapterOption) (*Jira
 	if email == "" {
 		return nil, fmt.Errorf("Jira email not found. Set %s environment variable", emailEnv)
 	}
+	switch cfg.SearchAPI {
+	case "", jiraSearchAuto, jiraSearchJQL, jiraSearchLegacy:
+	default:
+		return nil, fmt.Errorf("invalid Jira search_api %q (use auto, jql or legacy)", cfg.SearchAPI)
+	}
+	title, err := newTitleFormatter(cfg.Title)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create basic auth string
 	auth := base64.StdEncoding.EncodeToString([]byte(email + ":" + token))
 
+	redact := options.redactor(token, auth, email+":"+token)
 	return &JiraAdapter{
 		config: cfg,
-		client: options.httpClient,
+		client: options.client(redact),
 		getEnv: options.getEnv,
+		onPage: options.onPage,
+		marker: markerPattern(options.idPrefix),
+		title:  title,
 		auth:   auth,
 	}, nil
 }
@@ -171,51 +249,95 @@ func (j *JiraAdapter) FetchItems(query map[string]interface{}) ([]ExternalItem,
 	var allItems []ExternalItem
 	startAt := 0
 	maxResults := 50
-
-	for {
-		url := fmt.Sprintf("%s/rest/api/3/search?jql=%s&startAt=%d&maxResults=%d",
-			strings.TrimSuffix(j.config.Server, "/"),
-			jql,
-			startAt,
-			maxResults)
-
-		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
+	nextPageToken := ""
+	api := j.config.SearchAPI
+	if api == "" {
+		api = jiraSearchAuto
+	}
+
+	for page := 1; ; page++ {
+		searchResp, status, err := j.search(ctx, api, jql, startAt, maxResults, nextPageToken)
+		if status == http.StatusNotFound && api == jiraSearchAuto {
+			// Server and Data Center have no /search/jql
+			api = jiraSearchLegacy
+			searchResp, _, err = j.search(ctx, api, jql, startAt, maxResults, nextPageToken)
 		}
-
-		req.Header.Set("Authorization", "Basic "+j.auth)
-		req.Header.Set("Accept", "application/json")
-
-		resp, err := j.client.Do(req)
 		if err != nil {
-			return nil, fmt.Errorf("request failed: %w", err)
+			return nil, err
 		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != 200 {
-			return nil, fmt.Errorf("API error: HTTP %d", resp.StatusCode)
-		}
-
-		var searchResp JiraSearchResponse
-		if err := json.NewDecoder(resp.Body).Decode(&searchResp); err != nil {
-			return nil, fmt.Errorf("failed to parse response: %w", err)
+		if api == jiraSearchAuto {
+			// Only /search/jql answers with a cursor
+			api = jiraSearchLegacy
+			if searchResp.IsLast != nil || searchResp.NextPageToken != "" {
+				api = jiraSearchJQL
+			}
 		}
 
 		for _, issue := range searchResp.Issues {
 			allItems = append(allItems, j.issueToItem(issue))
 		}
+		notifyPage(j.onPage, page, len(allItems))
 
+		if api == jiraSearchJQL {
+			if searchResp.NextPageToken == "" || (searchResp.IsLast != nil && *searchResp.IsLast) {
+				break
+			}
+			nextPageToken = searchResp.NextPageToken
+			continue
+		}
 		if len(searchResp.Issues) < maxResults {
 			break
 		}
-
 		startAt += maxResults
 	}
 
 	return allItems, nil
 }
 
+// search fetches one page of issues matching jql. The jql API pages with
+// nextPageToken, the others with startAt; auto starts on the jql API. It
+// also returns the HTTP status, so a missing endpoint can be detected.
+func (j *JiraAdapter) search(ctx context.Context, api, jql string, startAt, maxResults int, nextPageToken string) (*JiraSearchResponse, int, error) {
+	params := url.Values{}
+	params.Set("jql", jql)
+	params.Set("maxResults", fmt.Sprintf("%d", maxResults))
+	endpoint := "/rest/api/3/search"
+	if api == jiraSearchLegacy {
+		params.Set("startAt", fmt.Sprintf("%d", startAt))
+	} else {
+		// Unlike the legacy API, /search/jql returns only IDs by default
+		endpoint = "/rest/api/3/search/jql"
+		params.Set("fields", "*all")
+		if nextPageToken != "" {
+			params.Set("nextPageToken", nextPageToken)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(j.config.Server, "/")+endpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Basic "+j.auth)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, resp.StatusCode, fmt.Errorf("API error: HTTP %d", resp.StatusCode)
+	}
+
+	var searchResp JiraSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&searchResp); err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &searchResp, resp.StatusCode, nil
+}
+
 // GetItem gets a single issue by key
 func (j *JiraAdapter) GetItem(externalID string) (*ExternalItem, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -250,6 +372,11 @@ func (j *JiraAdapter) GetItem(externalID string) (*ExternalItem, error) {
 	return &item, nil
 }
 
+// ItemURL returns the web address of a Jira issue.
+func (j *JiraAdapter) ItemURL(externalID string) string {
+	return fmt.Sprintf("%s/browse/%s", strings.TrimSuffix(j.config.Server, "/"), externalID)
+}
+
 // CreateItem creates a new Jira issue from a requirement
 func (j *JiraAdapter) CreateItem(req *database.Requirement) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -291,7 +418,7 @@ func (j *JiraAdapter) CreateItem(req *database.Requirement) (string, error) {
 			"project": map[string]string{
 				"key": j.config.Project,
 			},
-			"summary":     fmt.Sprintf("[%s] %s", req.ReqID, truncateStr(req.RequirementText, 80)),
+			"summary":     j.title.Title(req),
 			"description": description,
 			"issuetype": map[string]string{
 				"name": issueType,
@@ -303,6 +430,9 @@ func (j *JiraAdapter) CreateItem(req *database.Requirement) (string, error) {
 	if len(j.config.Labels) > 0 {
 		payload["fields"].(map[string]interface{})["labels"] = j.config.Labels
 	}
+	if accountID := j.MapAssigneeFromRTMX(req.Assignee); accountID != "" {
+		payload["fields"].(map[string]interface{})["assignee"] = map[string]string{"accountId": accountID}
+	}
 
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
@@ -370,10 +500,13 @@ func (j *JiraAdapter) UpdateItem(externalID string, req *database.Requirement) b
 
 	payload := map[string]interface{}{
 		"fields": map[string]interface{}{
-			"summary":     fmt.Sprintf("[%s] %s", req.ReqID, truncateStr(req.RequirementText, 80)),
+			"summary":     j.title.Title(req),
 			"description": description,
 		},
 	}
+	if accountID := j.MapAssigneeFromRTMX(req.Assignee); accountID != "" {
+		payload["fields"].(map[string]interface{})["assignee"] = map[string]string{"accountId": accountID}
+	}
 
 	payloadBytes, _ := json.Marshal(payload)
 
@@ -509,21 +642,35 @@ func (j *JiraAdapter) MapStatusFromRTMX(status database.Status) string {
 	}
 }
 
+// MapAssigneeToRTMX maps an issue's assignee accountId to an RTM
+// assignee. Unmapped users are imported by display name.
+func (j *JiraAdapter) MapAssigneeToRTMX(item ExternalItem) string {
+	return rtmxAssignee(j.config.AssigneeMapping, item.Assignee, item.AssigneeID, item.Assignee)
+}
+
+// MapAssigneeFromRTMX maps an RTM assignee to a Jira accountId.
+func (j *JiraAdapter) MapAssigneeFromRTMX(assignee string) string {
+	if assignee == "" {
+		return ""
+	}
+	return j.config.AssigneeMapping[assignee]
+}
+
 // issueToItem converts a Jira issue to an ExternalItem
 func (j *JiraAdapter) issueToItem(issue JiraIssue) ExternalItem {
 	// Extract requirement ID from description
 	reqID := ""
 	if issue.Fields.Description != "" {
-		re := regexp.MustCompile(`(?:RTMX:|REQ-)\s*(REQ-[A-Z]+-\d+)`)
-		if matches := re.FindStringSubmatch(issue.Fields.Description); len(matches) > 1 {
+		if matches := j.marker.FindStringSubmatch(issue.Fields.Description); len(matches) > 1 {
 			reqID = matches[1]
 		}
 	}
 
 	// Extract assignee
-	assignee := ""
+	assignee, assigneeID := "", ""
 	if issue.Fields.Assignee != nil {
 		assignee = issue.Fields.Assignee.DisplayName
+		assigneeID = issue.Fields.Assignee.AccountID
 	}
 
 	// Extract priority
@@ -532,8 +679,14 @@ func (j *JiraAdapter) issueToItem(issue JiraIssue) ExternalItem {
 		priority = issue.Fields.Priority.Name
 	}
 
+	// The first fix version is the release the issue ships in
+	release := ""
+	if len(issue.Fields.FixVersions) > 0 {
+		release = issue.Fields.FixVersions[0].Name
+	}
+
 	// Build URL
-	issueURL := fmt.Sprintf("%s/browse/%s", strings.TrimSuffix(j.config.Server, "/"), issue.Key)
+	issueURL := j.ItemURL(issue.Key)
 
 	return ExternalItem{
 		ExternalID:    issue.Key,
@@ -545,7 +698,36 @@ func (j *JiraAdapter) issueToItem(issue JiraIssue) ExternalItem {
 		CreatedAt:     issue.Fields.Created,
 		UpdatedAt:     issue.Fields.Updated,
 		Assignee:      assignee,
+		AssigneeID:    assigneeID,
 		Priority:      priority,
+		Release:       release,
+		Blocked:       j.blockedReason(issue),
 		RequirementID: reqID,
 	}
 }
+
+// blockedReason says why an issue is blocked: it is flagged, or it is
+// blocked by an issue that is not done. It returns "" otherwise.
+func (j *JiraAdapter) blockedReason(issue JiraIssue) string {
+	field := j.config.FlaggedField
+	if field == "" {
+		field = defaultJiraFlaggedField
+	}
+	if flag := strings.TrimSpace(string(issue.rawFields[field])); flag != "" && flag != "null" && flag != "[]" {
+		return "flagged"
+	}
+
+	var blockers []string
+	for _, link := range issue.Fields.IssueLinks {
+		if link.InwardIssue == nil || !strings.EqualFold(link.Type.Inward, "is blocked by") {
+			continue
+		}
+		if j.MapStatusToRTMX(link.InwardIssue.Fields.Status.Name) != database.StatusComplete {
+			blockers = append(blockers, link.InwardIssue.Key)
+		}
+	}
+	if len(blockers) > 0 {
+		return "blocked by " + strings.Join(blockers, ", ")
+	}
+	return ""
+}
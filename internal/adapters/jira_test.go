@@ -1,6 +1,11 @@
 package adapters
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"testing"
 
@@ -214,8 +219,12 @@ func TestJiraIssueToItem(t *testing.T) {
 		Name string `json:"name"`
 	}{Name: "High"}
 	issue.Fields.Assignee = &struct {
+		AccountID   string `json:"accountId"`
 		DisplayName string `json:"displayName"`
 	}{DisplayName: "Test User"}
+	issue.Fields.FixVersions = []struct {
+		Name string `json:"name"`
+	}{{Name: "v1.1"}, {Name: "v1.2"}}
 
 	item := adapter.issueToItem(issue)
 
@@ -237,6 +246,9 @@ func TestJiraIssueToItem(t *testing.T) {
 	if item.Status != "In Progress" {
 		t.Errorf("Expected Status 'In Progress', got '%s'", item.Status)
 	}
+	if item.Release != "v1.1" {
+		t.Errorf("Expected Release from first fix version 'v1.1', got '%s'", item.Release)
+	}
 }
 
 func TestJiraWithCustomStatusMapping(t *testing.T) {
@@ -383,3 +395,226 @@ func TestJiraExtractReqIDFromDescription(t *testing.T) {
 		}
 	}
 }
+
+func TestJiraAssigneeMapping(t *testing.T) {
+	cfg := config.JiraAdapterConfig{
+		Enabled:         true,
+		Server:          "https://test.atlassian.net",
+		Project:         "TEST",
+		TokenEnv:        "TEST_JIRA_TOKEN",
+		EmailEnv:        "TEST_JIRA_EMAIL",
+		AssigneeMapping: map[string]string{"alice": "5b10ac8d82e05b22cc7d4ef5"},
+	}
+	mockClient := &MockHTTPClient{
+		Response: &http.Response{
+			StatusCode: 201,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"key":"TEST-42"}`)),
+		},
+	}
+	adapter, _ := NewJiraAdapter(&cfg,
+		WithHTTPClient(mockClient),
+		WithEnvGetter(func(key string) string { return "test" }),
+	)
+
+	// Import: the accountId is mapped back to the RTM assignee
+	issue := JiraIssue{Key: "TEST-1"}
+	issue.Fields.Assignee = &struct {
+		AccountID   string `json:"accountId"`
+		DisplayName string `json:"displayName"`
+	}{AccountID: "5b10ac8d82e05b22cc7d4ef5", DisplayName: "Alice Smith"}
+	item := adapter.issueToItem(issue)
+	if got := adapter.MapAssigneeToRTMX(item); got != "alice" {
+		t.Errorf("MapAssigneeToRTMX = %q, want alice", got)
+	}
+
+	// Unmapped users fall back to their display name
+	issue.Fields.Assignee.AccountID = "unknown"
+	if got := adapter.MapAssigneeToRTMX(adapter.issueToItem(issue)); got != "Alice Smith" {
+		t.Errorf("MapAssigneeToRTMX = %q, want display name", got)
+	}
+
+	// Export: the mapped accountId is set on the issue
+	req := database.NewRequirement("REQ-TEST-001")
+	req.RequirementText = "Test requirement"
+	req.Assignee = "alice"
+	if _, err := adapter.CreateItem(req); err != nil {
+		t.Fatalf("CreateItem failed: %v", err)
+	}
+	body, _ := io.ReadAll(mockClient.Requests[0].Body)
+	var payload struct {
+		Fields struct {
+			Assignee map[string]string `json:"assignee"`
+		} `json:"fields"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("Failed to decode payload %s: %v", body, err)
+	}
+	if got := payload.Fields.Assignee["accountId"]; got != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("assignee accountId = %q, want mapped id", got)
+	}
+}
+
+func TestJiraIssueToItemIDPrefix(t *testing.T) {
+	cfg := config.JiraAdapterConfig{Enabled: true, Server: "https://test.atlassian.net", Project: "TEST"}
+	adapter, err := NewJiraAdapter(&cfg,
+		WithEnvGetter(func(key string) string { return "test-value" }),
+		WithIDPrefix("PROJ"),
+	)
+	if err != nil {
+		t.Fatalf("NewJiraAdapter failed: %v", err)
+	}
+
+	issue := JiraIssue{Key: "TEST-1"}
+	issue.Fields.Description = "Details\n\n---\nRTMX: PROJ-AUTH-001"
+	if got := adapter.issueToItem(issue).RequirementID; got != "PROJ-AUTH-001" {
+		t.Errorf("RequirementID = %q, want PROJ-AUTH-001", got)
+	}
+
+	issue.Fields.Description = "Details\n\n---\nRTMX: REQ-AUTH-001"
+	if got := adapter.issueToItem(issue).RequirementID; got != "" {
+		t.Errorf("RequirementID = %q, want none for another prefix", got)
+	}
+}
+
+func TestJiraIssueToItemBlocked(t *testing.T) {
+	t.Setenv("TEST_JIRA_TOKEN", "test-token")
+	t.Setenv("TEST_JIRA_EMAIL", "test@example.com")
+	cfg := config.JiraAdapterConfig{
+		Enabled:  true,
+		Server:   "https://test.atlassian.net",
+		Project:  "TEST",
+		TokenEnv: "TEST_JIRA_TOKEN",
+		EmailEnv: "TEST_JIRA_EMAIL",
+	}
+	adapter, err := NewJiraAdapter(&cfg)
+	if err != nil {
+		t.Fatalf("NewJiraAdapter failed: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		fields string
+		want   string
+	}{
+		{"not blocked", `{"customfield_10021": null, "issuelinks": []}`, ""},
+		{"flagged", `{"customfield_10021": [{"value": "Impediment"}]}`, "flagged"},
+		{
+			"blocked by open issue",
+			`{"issuelinks": [
+				{"type": {"inward": "is blocked by"}, "inwardIssue": {"key": "TEST-1", "fields": {"status": {"name": "In Progress"}}}},
+				{"type": {"inward": "is blocked by"}, "inwardIssue": {"key": "TEST-2", "fields": {"status": {"name": "Done"}}}},
+				{"type": {"inward": "relates to"}, "inwardIssue": {"key": "TEST-3", "fields": {"status": {"name": "To Do"}}}}
+			]}`,
+			"blocked by TEST-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var issue JiraIssue
+			if err := json.Unmarshal([]byte(`{"key": "TEST-9", "fields": `+tt.fields+`}`), &issue); err != nil {
+				t.Fatalf("Failed to decode issue: %v", err)
+			}
+			if got := adapter.issueToItem(issue).Blocked; got != tt.want {
+				t.Errorf("Blocked = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// jiraSearchMockClient serves /search/jql pages by nextPageToken, and the
+// legacy /search API only when legacy is set.
+type jiraSearchMockClient struct {
+	pages    map[string]string // nextPageToken -> response
+	legacy   string
+	Requests []*http.Request
+}
+
+func (m *jiraSearchMockClient) Do(req *http.Request) (*http.Response, error) {
+	m.Requests = append(m.Requests, req)
+	body, status := "", http.StatusNotFound
+	switch req.URL.Path {
+	case "/rest/api/3/search/jql":
+		if page, ok := m.pages[req.URL.Query().Get("nextPageToken")]; ok {
+			body, status = page, http.StatusOK
+		}
+	case "/rest/api/3/search":
+		if m.legacy != "" {
+			body, status = m.legacy, http.StatusOK
+		}
+	}
+	return &http.Response{StatusCode: status, Body: io.NopCloser(bytes.NewBufferString(body))}, nil
+}
+
+func newJiraSearchTestAdapter(t *testing.T, client HTTPClient, searchAPI string) *JiraAdapter {
+	t.Helper()
+	cfg := config.JiraAdapterConfig{
+		Enabled:   true,
+		Server:    "https://test.atlassian.net",
+		Project:   "TEST",
+		SearchAPI: searchAPI,
+	}
+	adapter, err := NewJiraAdapter(&cfg,
+		WithHTTPClient(client),
+		WithEnvGetter(func(key string) string { return "test" }),
+	)
+	if err != nil {
+		t.Fatalf("NewJiraAdapter failed: %v", err)
+	}
+	return adapter
+}
+
+func TestJiraFetchItemsCursorPagination(t *testing.T) {
+	client := &jiraSearchMockClient{pages: map[string]string{
+		"":       `{"issues":[{"key":"TEST-1"},{"key":"TEST-2"}],"nextPageToken":"page-2","isLast":false}`,
+		"page-2": `{"issues":[{"key":"TEST-3"}],"nextPageToken":"page-3","isLast":false}`,
+		"page-3": `{"issues":[{"key":"TEST-4"}],"isLast":true}`,
+	}}
+	for _, api := range []string{"", "jql"} {
+		client.Requests = nil
+		items, err := newJiraSearchTestAdapter(t, client, api).FetchItems(nil)
+		if err != nil {
+			t.Fatalf("search_api %q: FetchItems failed: %v", api, err)
+		}
+		var keys []string
+		for _, item := range items {
+			keys = append(keys, item.ExternalID)
+		}
+		if got := fmt.Sprint(keys); got != "[TEST-1 TEST-2 TEST-3 TEST-4]" {
+			t.Errorf("search_api %q: items = %s, want every page", api, got)
+		}
+		if len(client.Requests) != 3 {
+			t.Fatalf("search_api %q: %d requests, want one per page", api, len(client.Requests))
+		}
+		q := client.Requests[2].URL.Query()
+		if q.Get("nextPageToken") != "page-3" || q.Get("startAt") != "" || q.Get("jql") != "project = TEST" {
+			t.Errorf("search_api %q: last request query = %v, want the page-3 cursor", api, q)
+		}
+	}
+}
+
+func TestJiraFetchItemsLegacySearch(t *testing.T) {
+	client := &jiraSearchMockClient{legacy: `{"issues":[{"key":"TEST-1"}],"total":1,"maxResults":50,"startAt":0}`}
+
+	// Auto falls back when the server has no /search/jql
+	items, err := newJiraSearchTestAdapter(t, client, "").FetchItems(nil)
+	if err != nil {
+		t.Fatalf("FetchItems failed: %v", err)
+	}
+	if len(items) != 1 || len(client.Requests) != 2 || client.Requests[1].URL.Path != "/rest/api/3/search" {
+		t.Errorf("Expected one item from the legacy API after a /search/jql 404, got %d items from %d requests", len(items), len(client.Requests))
+	}
+
+	// Legacy goes straight to /search with startAt
+	client.Requests = nil
+	if _, err := newJiraSearchTestAdapter(t, client, "legacy").FetchItems(nil); err != nil {
+		t.Fatalf("FetchItems failed: %v", err)
+	}
+	if len(client.Requests) != 1 || client.Requests[0].URL.Query().Get("startAt") != "0" {
+		t.Errorf("Expected a single startAt request, got %d requests", len(client.Requests))
+	}
+
+	cfg := config.JiraAdapterConfig{Enabled: true, SearchAPI: "v2"}
+	if _, err := NewJiraAdapter(&cfg, WithEnvGetter(func(string) string { return "test" })); err == nil {
+		t.Error("Expected an invalid search_api to be rejected")
+	}
+}
@@ -0,0 +1,144 @@
+package adapters
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// redacted replaces secrets in logged output and error messages.
+const redacted = "***"
+
+// minSecretLength keeps very short values (such as an empty or one-letter
+// variable) from scrubbing unrelated text.
+const minSecretLength = 4
+
+// Redactor scrubs known secret values and sensitive header values from
+// text before it is logged or returned in an error.
+type Redactor struct {
+	secrets []string
+	headers map[string]bool
+}
+
+// NewRedactor creates a redactor for the given secret values. The
+// Authorization header is always redacted; headers adds more.
+func NewRedactor(headers []string, secrets ...string) *Redactor {
+	r := &Redactor{headers: map[string]bool{"Authorization": true, "Proxy-Authorization": true}}
+	for _, h := range headers {
+		if h = strings.TrimSpace(h); h != "" {
+			r.headers[http.CanonicalHeaderKey(h)] = true
+		}
+	}
+	r.AddSecrets(secrets...)
+	return r
+}
+
+// AddSecrets registers more values to scrub.
+func (r *Redactor) AddSecrets(secrets ...string) {
+	for _, s := range secrets {
+		if len(s) >= minSecretLength {
+			r.secrets = append(r.secrets, s)
+		}
+	}
+	// Replace longer secrets first so one containing another is fully hidden
+	sort.Slice(r.secrets, func(i, j int) bool { return len(r.secrets[i]) > len(r.secrets[j]) })
+}
+
+// Redact replaces every known secret in s.
+func (r *Redactor) Redact(s string) string {
+	if r == nil {
+		return s
+	}
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+	return s
+}
+
+// RedactError returns err with its message redacted, or err itself when
+// the message holds no secret.
+func (r *Redactor) RedactError(err error) error {
+	if err == nil || r == nil {
+		return err
+	}
+	msg := err.Error()
+	if clean := r.Redact(msg); clean != msg {
+		return &redactedError{msg: clean, err: err}
+	}
+	return err
+}
+
+// headerValue redacts a header value, keeping the auth scheme of
+// sensitive headers ("Basic ***").
+func (r *Redactor) headerValue(name, value string) string {
+	if !r.headers[http.CanonicalHeaderKey(name)] {
+		return r.Redact(value)
+	}
+	if scheme, _, ok := strings.Cut(value, " "); ok {
+		return scheme + " " + redacted
+	}
+	return redacted
+}
+
+// DumpRequest renders a request line and its headers with secrets
+// redacted, for debug logging.
+func (r *Redactor) DumpRequest(req *http.Request) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s %s\n", req.Method, r.Redact(req.URL.String())))
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range req.Header[name] {
+			sb.WriteString(fmt.Sprintf("%s: %s\n", name, r.headerValue(name, value)))
+		}
+	}
+	return sb.String()
+}
+
+// redactedError hides secrets in an error's message while keeping the
+// original error available to errors.Is and errors.As.
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (e *redactedError) Error() string { return e.msg }
+func (e *redactedError) Unwrap() error { return e.err }
+
+// redactingClient wraps an HTTPClient so transport errors never carry
+// secrets, optionally logging each request and response to a debug log.
+type redactingClient struct {
+	next   HTTPClient
+	redact *Redactor
+	log    io.Writer
+}
+
+// newRedactingClient wraps client with redaction and the debug log, if any.
+func newRedactingClient(client HTTPClient, redact *Redactor, log io.Writer) HTTPClient {
+	return &redactingClient{next: client, redact: redact, log: log}
+}
+
+// Do sends the request, redacting the error and the debug log.
+func (c *redactingClient) Do(req *http.Request) (*http.Response, error) {
+	if c.log != nil {
+		fmt.Fprintf(c.log, "→ %s", c.redact.DumpRequest(req))
+	}
+	resp, err := c.next.Do(req)
+	if err != nil {
+		err = c.redact.RedactError(err)
+		if c.log != nil {
+			fmt.Fprintf(c.log, "← error: %v\n", err)
+		}
+		return resp, err
+	}
+	if c.log != nil && resp != nil {
+		fmt.Fprintf(c.log, "← HTTP %d\n", resp.StatusCode)
+	}
+	return resp, nil
+}
@@ -0,0 +1,74 @@
+package adapters
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// defaultTitleTemplate is the title of items exported from requirements.
+const defaultTitleTemplate = "[{{.ID}}] {{.Text}}"
+
+// defaultTitleLength is how much requirement text a title keeps by default.
+const defaultTitleLength = 80
+
+// titleFormatter renders the titles of exported items. Only the
+// requirement text is truncated, so the ID always survives.
+type titleFormatter struct {
+	tmpl   *template.Template
+	maxLen int
+}
+
+// newTitleFormatter parses the configured title template, checking that
+// it only uses known fields.
+func newTitleFormatter(cfg config.IssueTitleConfig) (*titleFormatter, error) {
+	text := cfg.Template
+	if text == "" {
+		text = defaultTitleTemplate
+	}
+	tmpl, err := template.New("title").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid title template: %w", err)
+	}
+
+	f := &titleFormatter{tmpl: tmpl, maxLen: cfg.MaxLength}
+	if f.maxLen == 0 {
+		f.maxLen = defaultTitleLength
+	}
+	if _, err := f.render(database.NewRequirement("REQ-TITLE-001")); err != nil {
+		return nil, fmt.Errorf("invalid title template: %w", err)
+	}
+	return f, nil
+}
+
+func (f *titleFormatter) render(req *database.Requirement) (string, error) {
+	text := req.RequirementText
+	if f.maxLen > 0 {
+		text = truncateStr(text, f.maxLen)
+	}
+	data := struct {
+		ID       string
+		Text     string
+		Category string
+	}{req.ReqID, text, req.Category}
+
+	var sb strings.Builder
+	if err := f.tmpl.Execute(&sb, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(sb.String()), nil
+}
+
+// Title returns the title of the item exported from req. A nil formatter
+// uses the default template and length.
+func (f *titleFormatter) Title(req *database.Requirement) string {
+	if f != nil {
+		if title, err := f.render(req); err == nil {
+			return title
+		}
+	}
+	return fmt.Sprintf("[%s] %s", req.ReqID, truncateStr(req.RequirementText, defaultTitleLength))
+}
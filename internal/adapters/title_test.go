@@ -0,0 +1,120 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+func TestTitleFormatter(t *testing.T) {
+	req := database.NewRequirement("REQ-AUTH-001")
+	req.Category = "AUTH"
+	req.RequirementText = "Users can sign in with single sign-on through the corporate identity provider"
+
+	tests := []struct {
+		name string
+		cfg  config.IssueTitleConfig
+		want string
+	}{
+		{"default", config.IssueTitleConfig{}, "[REQ-AUTH-001] " + req.RequirementText},
+		{"truncated text", config.IssueTitleConfig{MaxLength: 20}, "[REQ-AUTH-001] Users can sign in..."},
+		{"whole text", config.IssueTitleConfig{MaxLength: -1}, "[REQ-AUTH-001] " + req.RequirementText},
+		{"template", config.IssueTitleConfig{Template: "{{.Category}}: {{.Text}} ({{.ID}})", MaxLength: 10},
+			"AUTH: Users c... (REQ-AUTH-001)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newTitleFormatter(tt.cfg)
+			if err != nil {
+				t.Fatalf("newTitleFormatter failed: %v", err)
+			}
+			if got := f.Title(req); got != tt.want {
+				t.Errorf("Title = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, bad := range []string{"[{{.ID}] {{.Text}}", "{{.Summary}}"} {
+		if _, err := newTitleFormatter(config.IssueTitleConfig{Template: bad}); err == nil {
+			t.Errorf("Expected template %q to be rejected", bad)
+		}
+	}
+
+	// Multi-byte text is cut on character boundaries
+	req.RequirementText = "Benutzer können sich über SSO anmelden"
+	f, _ := newTitleFormatter(config.IssueTitleConfig{MaxLength: 12})
+	if got := f.Title(req); got != "[REQ-AUTH-001] Benutzer ..." {
+		t.Errorf("Title = %q, want the text cut to 12 characters", got)
+	}
+}
+
+func TestExportTitleLength(t *testing.T) {
+	title := config.IssueTitleConfig{MaxLength: 24}
+	env := WithEnvGetter(func(string) string { return "test" })
+
+	req := database.NewRequirement("REQ-AUTH-001")
+	req.RequirementText = strings.Repeat("very long requirement text ", 10)
+	want := "[REQ-AUTH-001] very long requirement..."
+
+	tests := []struct {
+		name     string
+		response string
+		field    func(payload map[string]interface{}) interface{}
+		create   func(client HTTPClient) (ServiceAdapter, error)
+	}{
+		{
+			"github", `{"number":7}`,
+			func(p map[string]interface{}) interface{} { return p["title"] },
+			func(client HTTPClient) (ServiceAdapter, error) {
+				cfg := config.GitHubAdapterConfig{Enabled: true, Repo: "owner/repo", Title: title}
+				return NewGitHubAdapter(&cfg, WithHTTPClient(client), env)
+			},
+		},
+		{
+			"jira", `{"key":"TEST-7"}`,
+			func(p map[string]interface{}) interface{} { return p["fields"].(map[string]interface{})["summary"] },
+			func(client HTTPClient) (ServiceAdapter, error) {
+				cfg := config.JiraAdapterConfig{Enabled: true, Server: "https://test.atlassian.net", Project: "TEST", Title: title}
+				return NewJiraAdapter(&cfg, WithHTTPClient(client), env)
+			},
+		},
+		{
+			"bitbucket", `{"id":7}`,
+			func(p map[string]interface{}) interface{} { return p["title"] },
+			func(client HTTPClient) (ServiceAdapter, error) {
+				cfg := config.BitbucketAdapterConfig{Enabled: true, Workspace: "acme", Repo: "app", Title: title}
+				return NewBitbucketAdapter(&cfg, WithHTTPClient(client), env)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockHTTPClient{Response: &http.Response{
+				StatusCode: 201,
+				Body:       io.NopCloser(bytes.NewBufferString(tt.response)),
+			}}
+			adapter, err := tt.create(client)
+			if err != nil {
+				t.Fatalf("Failed to create adapter: %v", err)
+			}
+			if _, err := adapter.CreateItem(req); err != nil {
+				t.Fatalf("CreateItem failed: %v", err)
+			}
+
+			body, _ := io.ReadAll(client.Requests[0].Body)
+			var payload map[string]interface{}
+			if err := json.Unmarshal(body, &payload); err != nil {
+				t.Fatalf("Failed to decode payload %s: %v", body, err)
+			}
+			if got := tt.field(payload); got != want {
+				t.Errorf("title = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// acceptanceCriterion is one checklist item in a spec's Acceptance
+// Criteria section.
+type acceptanceCriterion struct {
+	Text    string
+	Checked bool
+	// Line is the item's zero-based line number in the spec.
+	Line int
+}
+
+// parseAcceptanceCriteria returns the checklist items under the spec's
+// Acceptance Criteria headings. Checklists in other sections are ignored.
+func parseAcceptanceCriteria(content string) []acceptanceCriterion {
+	var items []acceptanceCriterion
+	inSection := false
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			inSection = strings.Contains(strings.ToLower(line), "acceptance criteria")
+			continue
+		}
+		if !inSection {
+			continue
+		}
+		if text, checked, ok := parseChecklistItem(line); ok {
+			items = append(items, acceptanceCriterion{Text: text, Checked: checked, Line: i})
+		}
+	}
+	return items
+}
+
+// specFilePath resolves a requirement's spec file relative to root. It
+// returns "" when the requirement has none.
+func specFilePath(root string, req *database.Requirement) string {
+	if req.RequirementFile == "" {
+		return ""
+	}
+	if filepath.IsAbs(req.RequirementFile) {
+		return req.RequirementFile
+	}
+	return filepath.Join(root, filepath.FromSlash(req.RequirementFile))
+}
+
+// uncheckedCriteria returns the unchecked acceptance criteria in the spec
+// at path. A missing spec has none.
+func uncheckedCriteria(path string) []string {
+	if path == "" {
+		return nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var unchecked []string
+	for _, item := range parseAcceptanceCriteria(string(content)) {
+		if !item.Checked {
+			unchecked = append(unchecked, item.Text)
+		}
+	}
+	return unchecked
+}
+
+// tickAcceptanceCriteria checks every unchecked acceptance criterion in the
+// spec at path and returns how many it ticked. With write false, the spec
+// is left unchanged and only the count is returned.
+func tickAcceptanceCriteria(path string, write bool) (int, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	lines := strings.Split(string(content), "\n")
+	ticked := 0
+	for _, item := range parseAcceptanceCriteria(string(content)) {
+		if item.Checked {
+			continue
+		}
+		lines[item.Line] = strings.Replace(lines[item.Line], "[ ]", "[x]", 1)
+		ticked++
+	}
+	if ticked == 0 || !write {
+		return ticked, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), info.Mode().Perm()); err != nil {
+		return 0, err
+	}
+	return ticked, nil
+}
+
+// autocheckSpec ticks the acceptance criteria in req's spec file under
+// root. Requirements without a spec file have nothing to tick.
+func autocheckSpec(root string, req *database.Requirement, write bool) (int, error) {
+	path := specFilePath(root, req)
+	if path == "" {
+		return 0, nil
+	}
+	ticked, err := tickAcceptanceCriteria(path, write)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	return ticked, err
+}
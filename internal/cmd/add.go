@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	addCategory    string
+	addSubcategory string
+	addText        string
+	addPriority    string
+	addPhase       int
+	addEffort      float64
+	addAssignee    string
+	addDue         string
+)
+
+var addCmd = &cobra.Command{
+	Use:   "add [REQ-ID]",
+	Short: "Add a requirement to the RTM database",
+	Long: `Add a new requirement to the RTM database.
+
+Without an ID, the next free one in the category is used: the
+pytest.marker_prefix from rtmx.yaml upper-cased (REQ by default), the category and a number zero-padded to
+id_pad_width digits (3 by default), e.g. REQ-AUTH-003.
+
+Examples:
+    rtmx add REQ-AUTH-001 --category AUTH --text "Users can log in"
+    rtmx add REQ-AUTH-002 --category AUTH --text "Password reset" --priority HIGH --due 2025-06-30
+    rtmx add --category AUTH --text "Session timeout"`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runAdd,
+}
+
+func init() {
+	addCmd.Flags().StringVar(&addCategory, "category", "", "requirement category (required)")
+	addCmd.Flags().StringVar(&addSubcategory, "subcategory", "", "requirement subcategory")
+	addCmd.Flags().StringVar(&addText, "text", "", "requirement text (required)")
+	addCmd.Flags().StringVar(&addPriority, "priority", "MEDIUM", "priority: P0, HIGH, MEDIUM, LOW")
+	addCmd.Flags().IntVar(&addPhase, "phase", 0, "phase number")
+	addCmd.Flags().Float64Var(&addEffort, "effort", 0, "estimated effort in weeks")
+	addCmd.Flags().StringVar(&addAssignee, "assignee", "", "assignee")
+	addCmd.Flags().StringVar(&addDue, "due", "", "due date (YYYY-MM-DD or locale.date_format)")
+
+	rootCmd.AddCommand(addCmd)
+}
+
+func runAdd(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	if addCategory == "" {
+		return fmt.Errorf("--category is required")
+	}
+	if addText == "" {
+		return fmt.Errorf("--text is required")
+	}
+
+	priority, err := database.ParsePriority(addPriority)
+	if err != nil {
+		return err
+	}
+	if err := database.ValidateDueDate(addDue); err != nil {
+		return err
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+	if problem := categoryProblem(cfg, addCategory); problem != "" {
+		return NewValidationError(problem)
+	}
+
+	dbPath := cfg.DatabasePath(cwd)
+	db, err := database.Load(dbPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return databaseLoadError(err)
+		}
+		db = database.NewDatabase()
+	}
+
+	var reqID string
+	if len(args) > 0 {
+		reqID = args[0]
+	} else {
+		reqID = nextRequirementID(db, cfg.IDPrefix(), addCategory, cfg.IDPadWidth())
+	}
+
+	req := database.NewRequirement(reqID)
+	req.Category = addCategory
+	req.Subcategory = addSubcategory
+	req.RequirementText = addText
+	req.Priority = priority
+	req.Phase = addPhase
+	req.EffortWeeks = addEffort
+	req.Assignee = addAssignee
+	req.DueDate = addDue
+	req.RequirementFile, err = cfg.RequirementFile(addCategory, reqID, addPhase)
+	if err != nil {
+		return NewTypedError(ErrorTypeConfig, "", err)
+	}
+
+	if err := db.Add(req); err != nil {
+		return err
+	}
+
+	if err := db.Save(dbPath); err != nil {
+		return databaseSaveError(err)
+	}
+
+	cmd.Printf("%s Added %s\n", output.Color("✓", output.Green), reqID)
+	return nil
+}
+
+// nextRequirementID returns the ID after the highest numbered
+// <prefix>-<CATEGORY>-NNN requirement in db, whatever its padding, padded
+// to width digits.
+func nextRequirementID(db *database.Database, prefix, category string, width int) string {
+	return formatRequirementID(prefix, strings.ToUpper(category), highestRequirementNumber(db, prefix, category)+1, width)
+}
+
+// highestRequirementNumber returns the highest NNN of the
+// <prefix>-<CATEGORY>-NNN requirements in db, or 0 if there are none.
+func highestRequirementNumber(db *database.Database, prefix, category string) int {
+	category = strings.ToUpper(category)
+	pattern := regexp.MustCompile(`^` + regexp.QuoteMeta(prefix+"-"+category+"-") + `(\d+)$`)
+	highest := 0
+	for _, req := range db.All() {
+		if m := pattern.FindStringSubmatch(req.ReqID); m != nil {
+			if n, err := strconv.Atoi(m[1]); err == nil && n > highest {
+				highest = n
+			}
+		}
+	}
+	return highest
+}
+
+// formatRequirementID returns <prefix>-<category>-<n> with n zero-padded to
+// width digits.
+func formatRequirementID(prefix, category string, n, width int) string {
+	return fmt.Sprintf("%s-%s-%0*d", prefix, category, width, n)
+}
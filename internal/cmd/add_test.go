@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/markers"
+)
+
+// TestAddAndAssignDueDate adds a requirement with a due date, then reschedules it.
+func TestAddAndAssignDueDate(t *testing.T) {
+	origCategory, origText, origDue := addCategory, addText, addDue
+	origPriority, origAssignDue := addPriority, assignDue
+	defer func() {
+		addCategory, addText, addDue = origCategory, origText, origDue
+		addPriority, assignDue = origPriority, origAssignDue
+	}()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	addCategory = "AUTH"
+	addText = "Users can log in"
+	addPriority = "HIGH"
+	addDue = "2025-06-30"
+
+	var buf bytes.Buffer
+	addCmd.SetOut(&buf)
+	if err := runAdd(addCmd, []string{"REQ-AUTH-001"}); err != nil {
+		t.Fatalf("add failed: %v", err)
+	}
+	if err := runAdd(addCmd, []string{"REQ-AUTH-001"}); err == nil {
+		t.Error("Expected error adding a duplicate requirement")
+	}
+
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	db, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	if got := db.Get("REQ-AUTH-001").DueDate; got != "2025-06-30" {
+		t.Errorf("DueDate after add = %q, want 2025-06-30", got)
+	}
+
+	if err := assignCmd.Flags().Set("due", "2025-07-15"); err != nil {
+		t.Fatalf("Failed to set --due: %v", err)
+	}
+	assignCmd.SetOut(&buf)
+	if err := runAssign(assignCmd, []string{"REQ-AUTH-001", "alice"}); err != nil {
+		t.Fatalf("assign failed: %v", err)
+	}
+
+	db, err = database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	req := db.Get("REQ-AUTH-001")
+	if req.Assignee != "alice" || req.DueDate != "2025-07-15" {
+		t.Errorf("After assign: assignee=%q due=%q, want alice 2025-07-15", req.Assignee, req.DueDate)
+	}
+
+	if err := assignCmd.Flags().Set("due", "next week"); err != nil {
+		t.Fatalf("Failed to set --due: %v", err)
+	}
+	if err := runAssign(assignCmd, []string{"REQ-AUTH-001"}); err == nil {
+		t.Error("Expected error for invalid due date")
+	}
+}
+
+func TestAssignRelease(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	db := database.NewDatabase()
+	_ = db.Add(database.NewRequirement("REQ-REL-001"))
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	dueFlag, releaseFlag := assignCmd.Flags().Lookup("due"), assignCmd.Flags().Lookup("release")
+	origDue, origDueChanged, origRelease := assignDue, dueFlag.Changed, assignRelease
+	defer func() {
+		assignDue, dueFlag.Changed, assignRelease = origDue, origDueChanged, origRelease
+		releaseFlag.Changed = false
+	}()
+	dueFlag.Changed = false
+
+	if err := assignCmd.Flags().Set("release", "v1.1"); err != nil {
+		t.Fatalf("Failed to set --release: %v", err)
+	}
+	var buf bytes.Buffer
+	assignCmd.SetOut(&buf)
+	defer assignCmd.SetOut(nil)
+	if err := runAssign(assignCmd, []string{"REQ-REL-001"}); err != nil {
+		t.Fatalf("assign --release failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "release v1.1") {
+		t.Errorf("Expected release in output, got %q", buf.String())
+	}
+
+	db, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	if got := db.Get("REQ-REL-001").Release; got != "v1.1" {
+		t.Errorf("Release = %q, want v1.1", got)
+	}
+}
+
+// TestAddGeneratesIDFromPrefix checks that the configured marker prefix is used
+// both for generated IDs and for the markers scanned from tests.
+func TestAddGeneratesIDFromPrefix(t *testing.T) {
+	origCategory, origText, origPriority := addCategory, addText, addPriority
+	defer func() {
+		addCategory, addText, addPriority = origCategory, origText, origPriority
+	}()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n  pytest:\n    marker_prefix: proj\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	addCategory = "auth"
+	addPriority = "MEDIUM"
+	var buf bytes.Buffer
+	addCmd.SetOut(&buf)
+	for _, text := range []string{"Users can log in", "Users can log out"} {
+		addText = text
+		if err := runAdd(addCmd, nil); err != nil {
+			t.Fatalf("add failed: %v", err)
+		}
+	}
+
+	db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	for _, id := range []string{"PROJ-AUTH-001", "PROJ-AUTH-002"} {
+		if db.Get(id) == nil {
+			t.Errorf("Expected generated requirement %s", id)
+		}
+	}
+	if !strings.Contains(buf.String(), "Added PROJ-AUTH-002") {
+		t.Errorf("Expected output to name the generated ID, got:\n%s", buf.String())
+	}
+
+	testFile := filepath.Join(tmpDir, "test_auth.py")
+	src := "@pytest.mark.proj(\"PROJ-AUTH-001\")\ndef test_login():\n    pass\n\n@pytest.mark.req(\"REQ-AUTH-002\")\ndef test_logout():\n    pass\n"
+	if err := os.WriteFile(testFile, []byte(src), 0644); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+	cfg, err := config.LoadFromDir(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	extractor, err := markers.ForConfig(cfg)
+	if err != nil {
+		t.Fatalf("ForConfig failed: %v", err)
+	}
+	found, err := extractTestMarkers(testFile, extractor)
+	if err != nil {
+		t.Fatalf("extractTestMarkers failed: %v", err)
+	}
+	if len(found) != 1 || found[0].ReqID != "PROJ-AUTH-001" {
+		t.Errorf("Expected only the PROJ-AUTH-001 marker, got %+v", found)
+	}
+}
+
+func TestNextRequirementIDPadWidth(t *testing.T) {
+	db := database.NewDatabase()
+	for _, id := range []string{"REQ-AUTH-009", "REQ-AUTH-0010", "REQ-API-0999"} {
+		_ = db.Add(database.NewRequirement(id))
+	}
+
+	tests := []struct {
+		category string
+		width    int
+		want     string
+	}{
+		{"auth", 4, "REQ-AUTH-0011"},
+		{"auth", 3, "REQ-AUTH-011"},
+		{"api", 3, "REQ-API-1000"},
+		{"new", 4, "REQ-NEW-0001"},
+	}
+	for _, tt := range tests {
+		if got := nextRequirementID(db, "REQ", tt.category, tt.width); got != tt.want {
+			t.Errorf("nextRequirementID(%q, %d) = %q, want %q", tt.category, tt.width, got, tt.want)
+		}
+	}
+
+	cfg := config.DefaultConfig()
+	if got := cfg.IDPadWidth(); got != config.DefaultIDPadWidth {
+		t.Errorf("IDPadWidth() = %d, want %d", got, config.DefaultIDPadWidth)
+	}
+	cfg.RTMX.IDPadWidth = 4
+	if got := cfg.IDPadWidth(); got != 4 {
+		t.Errorf("IDPadWidth() = %d, want 4", got)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/markers"
 	"github.com/rtmx-ai/rtmx-go/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -118,39 +119,54 @@ func analyzeProject(path string, cfg *config.Config) *AnalysisReport {
 		Recommendations: []string{},
 	}
 
+	extractor, err := markers.ForConfig(cfg)
+	if err != nil {
+		report.Recommendations = append(report.Recommendations, fmt.Sprintf("Fix marker rules in rtmx.yaml: %v", err))
+		extractor = markers.Default()
+	}
+
 	// Find test files
 	testDirs := []string{
 		filepath.Join(path, "tests"),
 		filepath.Join(path, "test"),
 	}
 
+	var testFiles []string
 	for _, testDir := range testDirs {
 		if info, err := os.Stat(testDir); err == nil && info.IsDir() {
 			_ = filepath.Walk(testDir, func(p string, info os.FileInfo, err error) error {
 				if err != nil {
 					return nil
 				}
-				if !info.IsDir() && strings.HasPrefix(filepath.Base(p), "test_") && strings.HasSuffix(p, ".py") {
-					// Analyze test file for markers
-					markers := countMarkersInFile(p)
-					relPath, _ := filepath.Rel(path, p)
-					report.TestFiles = append(report.TestFiles, TestFileInfo{
-						Path:        relPath,
-						HasMarkers:  markers > 0,
-						MarkerCount: markers,
-					})
-					report.TotalTests++
-					if markers > 0 {
-						report.TestsWithMarker++
-					} else {
-						report.UnmarkedTests++
-					}
+				if !info.IsDir() && extractor.IsTestFile(p) {
+					testFiles = append(testFiles, p)
 				}
 				return nil
 			})
 		}
 	}
 
+	// Analyze test files for markers in parallel; results keep walk order
+	// and unreadable files count as having no markers
+	for _, scan := range extractor.ScanFiles(testFiles, 0) {
+		markerCount := 0
+		if scan.Err == nil {
+			markerCount = len(scan.Result.Markers)
+		}
+		relPath, _ := filepath.Rel(path, scan.Path)
+		report.TestFiles = append(report.TestFiles, TestFileInfo{
+			Path:        relPath,
+			HasMarkers:  markerCount > 0,
+			MarkerCount: markerCount,
+		})
+		report.TotalTests++
+		if markerCount > 0 {
+			report.TestsWithMarker++
+		} else {
+			report.UnmarkedTests++
+		}
+	}
+
 	// Check GitHub adapter config
 	if cfg != nil && cfg.RTMX.Adapters.GitHub.Enabled {
 		report.GitHubConfigured = true
@@ -191,20 +207,16 @@ func analyzeProject(path string, cfg *config.Config) *AnalysisReport {
 }
 
 func countMarkersInFile(path string) int {
-	content, err := os.ReadFile(path)
+	return countMarkersWith(markers.Default(), path)
+}
+
+// countMarkersWith counts requirement markers in a file using the extractor's rules.
+func countMarkersWith(extractor *markers.Extractor, path string) int {
+	scan, err := extractor.ExtractFile(path)
 	if err != nil {
 		return 0
 	}
-
-	// Count @pytest.mark.req markers
-	count := 0
-	lines := strings.Split(string(content), "\n")
-	for _, line := range lines {
-		if strings.Contains(line, "@pytest.mark.req") || strings.Contains(line, "pytest.mark.req(") {
-			count++
-		}
-	}
-	return count
+	return len(scan.Markers)
 }
 
 func formatAnalysisTerminal(cmd *cobra.Command, report *AnalysisReport) error {
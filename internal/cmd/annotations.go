@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// validationProblem is one validate finding, located at the line of the
+// requirement's row in the database file.
+type validationProblem struct {
+	ReqID   string
+	Line    int
+	Message string
+}
+
+// annotationPath is the database path as CI expects it: relative to the
+// project root with forward slashes.
+func annotationPath(root, dbPath string) string {
+	if rel, err := filepath.Rel(root, dbPath); err == nil && !strings.HasPrefix(rel, "..") {
+		dbPath = rel
+	}
+	return filepath.ToSlash(dbPath)
+}
+
+// printAnnotations writes problems in the named CI format.
+func printAnnotations(cmd *cobra.Command, format, file string, problems []validationProblem) error {
+	if format == "gitlab" {
+		report, err := gitLabCodeQuality(file, problems)
+		if err != nil {
+			return err
+		}
+		cmd.Println(report)
+		return nil
+	}
+	for _, p := range problems {
+		cmd.Println(gitHubAnnotation(file, p))
+	}
+	return nil
+}
+
+// gitHubAnnotation formats a problem as a GitHub Actions error command.
+func gitHubAnnotation(file string, p validationProblem) string {
+	props := "file=" + escapeAnnotationProperty(file)
+	if p.Line > 0 {
+		props += fmt.Sprintf(",line=%d", p.Line)
+	}
+	props += ",title=" + escapeAnnotationProperty(p.ReqID)
+	return fmt.Sprintf("::error %s::%s", props, escapeAnnotationData(p.ReqID+": "+p.Message))
+}
+
+// escapeAnnotationData escapes a workflow command message.
+func escapeAnnotationData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeAnnotationProperty escapes a workflow command property value.
+func escapeAnnotationProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
+
+// codeQualityIssue is one entry of a GitLab Code Quality report.
+type codeQualityIssue struct {
+	Description string              `json:"description"`
+	CheckName   string              `json:"check_name"`
+	Fingerprint string              `json:"fingerprint"`
+	Severity    string              `json:"severity"`
+	Location    codeQualityLocation `json:"location"`
+}
+
+type codeQualityLocation struct {
+	Path  string           `json:"path"`
+	Lines codeQualityLines `json:"lines"`
+}
+
+type codeQualityLines struct {
+	Begin int `json:"begin"`
+}
+
+// gitLabCodeQuality formats problems as a GitLab Code Quality report.
+func gitLabCodeQuality(file string, problems []validationProblem) (string, error) {
+	issues := make([]codeQualityIssue, 0, len(problems))
+	for _, p := range problems {
+		description := p.ReqID + ": " + p.Message
+		line := p.Line
+		if line == 0 {
+			line = 1
+		}
+		issues = append(issues, codeQualityIssue{
+			Description: description,
+			CheckName:   "rtmx-validate",
+			Fingerprint: fmt.Sprintf("%x", sha256.Sum256([]byte(file+"\x00"+description))),
+			Severity:    "major",
+			Location:    codeQualityLocation{Path: file, Lines: codeQualityLines{Begin: line}},
+		})
+	}
+	data, err := json.MarshalIndent(issues, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize code quality report: %w", err)
+	}
+	return string(data), nil
+}
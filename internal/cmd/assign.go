@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	assignDue     string
+	assignRelease string
+)
+
+var assignCmd = &cobra.Command{
+	Use:   "assign REQ-ID [ASSIGNEE]",
+	Short: "Assign a requirement to someone, a due date, or a release",
+	Long: `Set the assignee, due date and/or release of a requirement.
+
+Use an empty assignee ("") to unassign, --due "" to clear the due date,
+and --release "" to remove the requirement from its release.
+
+Examples:
+    rtmx assign REQ-AUTH-001 alice
+    rtmx assign REQ-AUTH-001 alice --due 2025-06-30
+    rtmx assign REQ-AUTH-001 --due 2025-07-15
+    rtmx assign REQ-AUTH-001 --release v1.1`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runAssign,
+}
+
+func init() {
+	assignCmd.Flags().StringVar(&assignDue, "due", "", "due date (YYYY-MM-DD)")
+	assignCmd.Flags().StringVar(&assignRelease, "release", "", "release or milestone (e.g. v1.1)")
+
+	rootCmd.AddCommand(assignCmd)
+}
+
+func runAssign(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	dueSet := cmd.Flags().Changed("due")
+	releaseSet := cmd.Flags().Changed("release")
+	if len(args) < 2 && !dueSet && !releaseSet {
+		return fmt.Errorf("specify an assignee, --due and/or --release")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	dbPath := cfg.DatabasePath(cwd)
+	db, err := database.Load(dbPath)
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	reqID := args[0]
+	updates := make(map[string]interface{})
+	if len(args) == 2 {
+		updates["assignee"] = args[1]
+	}
+	if dueSet {
+		updates["due_date"] = assignDue
+	}
+	if releaseSet {
+		updates["release"] = strings.TrimSpace(assignRelease)
+	}
+
+	if err := db.Update(reqID, updates); err != nil {
+		return err
+	}
+
+	if err := db.Save(dbPath); err != nil {
+		return databaseSaveError(err)
+	}
+
+	req := db.Get(reqID)
+	cmd.Printf("%s %s", output.Color("✓", output.Green), reqID)
+	if req.Assignee != "" {
+		cmd.Printf(" assigned to %s", req.Assignee)
+	} else {
+		cmd.Printf(" unassigned")
+	}
+	if req.DueDate != "" {
+		cmd.Printf(", due %s", req.DueDate)
+	}
+	if req.Release != "" {
+		cmd.Printf(", release %s", req.Release)
+	}
+	cmd.Println()
+	return nil
+}
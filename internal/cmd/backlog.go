@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/rtmx-ai/rtmx-go/internal/config"
 	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/graph"
 	"github.com/rtmx-ai/rtmx-go/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -17,8 +20,16 @@ var (
 	backlogPhase    int
 	backlogCategory string
 	backlogLimit    int
+	backlogDueIn    string
+	backlogFormat   string
+	backlogRelease  string
+	backlogLinks    bool
+	backlogArchived bool
 )
 
+// backlogNow returns the current time; tests replace it for a fixed clock.
+var backlogNow = time.Now
+
 var backlogCmd = &cobra.Command{
 	Use:   "backlog",
 	Short: "Show prioritized backlog",
@@ -28,16 +39,51 @@ View modes:
   all         All incomplete requirements (default)
   critical    High priority and blocking requirements
   quick-wins  Low effort, high value requirements
-  blockers    Requirements blocking others
-  list        Simple list format`,
+  blockers    Requirements blocking others, then those blocked externally
+  overdue     Incomplete requirements past their due date
+  risk        Ranked by risk score (priority × blocked × effort uncertainty)
+  list        Simple list format
+
+The risk formula's weights are configured under rtmx.risk in the config.
+
+Incomplete means any status but COMPLETE, unless statuses.incomplete in the
+config lists the statuses that count, e.g. to leave IN_REVIEW out.
+
+Requirements whose issue is blocked or flagged in GitHub or Jira are
+marked "blocked externally" by sync import and listed by the blockers view.
+
+Requirements in the categories listed under archived_categories in the
+config are hidden unless --include-archived is given or --category names
+the archived category.
+
+Use --links to list the web address of each shown requirement's linked
+issue, as recorded by sync, after the view.
+
+Use --due-within to show incomplete requirements due soon (e.g. 7d, 2w).
+
+Use --format gantt to print a Mermaid Gantt chart instead: each incomplete
+requirement becomes a task lasting its effort_weeks, scheduled after its
+dependencies and grouped by phase and category. Unestimated requirements
+are given one week and marked "(unestimated)".
+
+Examples:
+    rtmx backlog --view critical
+    rtmx backlog --release v1.1
+    rtmx backlog --view critical --links
+    rtmx backlog --format gantt --phase 2 > schedule.mmd`,
 	RunE: runBacklog,
 }
 
 func init() {
-	backlogCmd.Flags().StringVar(&backlogView, "view", "all", "view mode: all, critical, quick-wins, blockers, list")
+	backlogCmd.Flags().StringVar(&backlogView, "view", "all", "view mode: all, critical, quick-wins, blockers, overdue, risk, list")
 	backlogCmd.Flags().IntVar(&backlogPhase, "phase", 0, "filter by phase number")
 	backlogCmd.Flags().StringVar(&backlogCategory, "category", "", "filter by category")
+	backlogCmd.Flags().StringVar(&backlogRelease, "release", "", "filter by release")
 	backlogCmd.Flags().IntVarP(&backlogLimit, "limit", "n", 0, "limit number of results")
+	backlogCmd.Flags().StringVar(&backlogDueIn, "due-within", "", "show requirements due within a window (e.g. 7d, 2w)")
+	backlogCmd.Flags().StringVar(&backlogFormat, "format", "terminal", "output format: terminal, gantt")
+	backlogCmd.Flags().BoolVar(&backlogLinks, "links", false, "list the linked issue URL of each requirement")
+	backlogCmd.Flags().BoolVar(&backlogArchived, "include-archived", false, "include requirements in archived categories")
 }
 
 func runBacklog(cmd *cobra.Command, args []string) error {
@@ -46,6 +92,10 @@ func runBacklog(cmd *cobra.Command, args []string) error {
 		output.DisableColor()
 	}
 
+	if backlogFormat != "terminal" && backlogFormat != "gantt" {
+		return NewValidationError(fmt.Sprintf("invalid format %q (use terminal or gantt)", backlogFormat))
+	}
+
 	// Find and load config
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -54,16 +104,22 @@ func runBacklog(cmd *cobra.Command, args []string) error {
 
 	cfg, err := config.LoadFromDir(cwd)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return configLoadError(err)
 	}
 
 	// Load database
 	dbPath := cfg.DatabasePath(cwd)
 	db, err := database.Load(dbPath)
 	if err != nil {
-		return fmt.Errorf("failed to load database: %w", err)
+		return databaseLoadError(err)
 	}
 
+	if !backlogArchived && !cfg.CategoryArchived(backlogCategory) {
+		db, _ = withoutArchived(db, cfg)
+	}
+
+	defer startPager(cmd)()
+
 	// Get incomplete requirements
 	reqs := db.Incomplete()
 
@@ -88,18 +144,41 @@ func runBacklog(cmd *cobra.Command, args []string) error {
 		reqs = filtered
 	}
 
+	if backlogRelease != "" {
+		var filtered []*database.Requirement
+		for _, r := range reqs {
+			if strings.EqualFold(r.Release, backlogRelease) {
+				filtered = append(filtered, r)
+			}
+		}
+		reqs = filtered
+	}
+
+	now := backlogNow()
+	if backlogDueIn != "" {
+		window, err := parseDueWindow(backlogDueIn)
+		if err != nil {
+			return err
+		}
+		reqs = filterDueWithin(reqs, now, window)
+	}
+
 	// Apply view-specific filtering and sorting
 	switch backlogView {
+	case "overdue":
+		reqs = filterOverdue(reqs, now)
 	case "critical":
 		reqs = filterCritical(reqs, db)
 	case "quick-wins":
 		reqs = filterQuickWins(reqs)
 	case "blockers":
 		reqs = filterBlockers(reqs, db)
+	case "risk":
+		sortByRisk(reqs, riskScores(db, cfg))
 	case "list":
 		// Simple list, sorted by ID
 		sort.Slice(reqs, func(i, j int) bool {
-			return reqs[i].ReqID < reqs[j].ReqID
+			return database.LessReqID(reqs[i].ReqID, reqs[j].ReqID)
 		})
 	default:
 		// "all" - prioritized order
@@ -112,7 +191,106 @@ func runBacklog(cmd *cobra.Command, args []string) error {
 	}
 
 	// Display
-	return displayBacklog(cmd, reqs, db, cfg)
+	if backlogFormat == "gantt" {
+		return displayBacklogGantt(cmd, reqs)
+	}
+	if err := displayBacklog(cmd, reqs, db, cfg); err != nil {
+		return err
+	}
+	if backlogLinks {
+		displayBacklogLinks(cmd, reqs)
+	}
+	return nil
+}
+
+// displayBacklogLinks lists the external item URLs of reqs.
+func displayBacklogLinks(cmd *cobra.Command, reqs []*database.Requirement) {
+	cmd.Println()
+	cmd.Println("LINKS")
+	cmd.Println()
+	linked := 0
+	for _, r := range reqs {
+		for _, url := range externalURLs(r) {
+			cmd.Printf("  %s  %s\n", output.PadRight(r.ReqID, 16), url)
+			linked++
+		}
+	}
+	if linked == 0 {
+		cmd.Printf("  %s\n", output.Color("(no linked issues)", output.Dim))
+	}
+}
+
+// externalURLs returns the web addresses of req's linked items, in service
+// order.
+func externalURLs(req *database.Requirement) []string {
+	services := make([]string, 0, len(req.ExternalURLs))
+	for service := range req.ExternalURLs {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	urls := make([]string, 0, len(services))
+	for _, service := range services {
+		urls = append(urls, req.ExternalURLs[service])
+	}
+	return urls
+}
+
+// filterOverdue returns incomplete requirements past their due date,
+// most overdue first.
+func filterOverdue(reqs []*database.Requirement, now time.Time) []*database.Requirement {
+	var overdue []*database.Requirement
+	for _, r := range reqs {
+		if r.IsOverdue(now) {
+			overdue = append(overdue, r)
+		}
+	}
+	sortByDueDate(overdue)
+	return overdue
+}
+
+// filterDueWithin returns incomplete requirements due within the window,
+// soonest first.
+func filterDueWithin(reqs []*database.Requirement, now time.Time, window time.Duration) []*database.Requirement {
+	var upcoming []*database.Requirement
+	for _, r := range reqs {
+		if r.IsDueWithin(now, window) {
+			upcoming = append(upcoming, r)
+		}
+	}
+	sortByDueDate(upcoming)
+	return upcoming
+}
+
+func sortByDueDate(reqs []*database.Requirement) {
+	sort.SliceStable(reqs, func(i, j int) bool {
+		if reqs[i].DueDate != reqs[j].DueDate {
+			return reqs[i].DueDate < reqs[j].DueDate
+		}
+		return database.LessReqID(reqs[i].ReqID, reqs[j].ReqID)
+	})
+}
+
+// parseDueWindow parses a window like "7d" or "2w", or a Go duration.
+func parseDueWindow(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if n := len(value); n > 1 {
+		unit := value[n-1]
+		if unit == 'd' || unit == 'w' {
+			count, err := strconv.Atoi(value[:n-1])
+			if err == nil && count >= 0 {
+				days := count
+				if unit == 'w' {
+					days *= 7
+				}
+				return time.Duration(days) * 24 * time.Hour, nil
+			}
+		}
+	}
+	window, err := time.ParseDuration(value)
+	if err != nil || window < 0 {
+		return 0, fmt.Errorf("invalid --due-within %q (expected e.g. 7d, 2w)", value)
+	}
+	return window, nil
 }
 
 func filterCritical(reqs []*database.Requirement, db *database.Database) []*database.Requirement {
@@ -160,12 +338,12 @@ func filterBlockers(reqs []*database.Requirement, db *database.Database) []*data
 	var blockers []blockerInfo
 	for _, r := range reqs {
 		blocked := countBlocked(r, db)
-		if blocked > 0 {
+		if blocked > 0 || r.BlockedExternal != "" {
 			blockers = append(blockers, blockerInfo{r, blocked})
 		}
 	}
 	// Sort by number blocked (descending)
-	sort.Slice(blockers, func(i, j int) bool {
+	sort.SliceStable(blockers, func(i, j int) bool {
 		return blockers[i].blocked > blockers[j].blocked
 	})
 	result := make([]*database.Requirement, len(blockers))
@@ -185,6 +363,39 @@ func countBlocked(req *database.Requirement, db *database.Database) int {
 	return count
 }
 
+// riskScores computes risk scores for incomplete requirements using the
+// configured weights.
+func riskScores(db *database.Database, cfg *config.Config) map[string]graph.Risk {
+	weights := graph.RiskWeights{
+		Priority:           make(map[database.Priority]float64),
+		Blocked:            cfg.RTMX.Risk.BlockedWeight,
+		UncertaintyPerWeek: cfg.RTMX.Risk.UncertaintyPerWeek,
+		Unestimated:        cfg.RTMX.Risk.UnestimatedUncertainty,
+	}
+	for name, weight := range cfg.RTMX.Risk.PriorityWeights {
+		if p, err := database.ParsePriority(name); err == nil {
+			weights.Priority[p] = weight
+		}
+	}
+
+	scores := make(map[string]graph.Risk)
+	for _, risk := range graph.NewGraph(db).RiskScores(weights) {
+		scores[risk.ReqID] = risk
+	}
+	return scores
+}
+
+// sortByRisk orders requirements by risk score, highest first.
+func sortByRisk(reqs []*database.Requirement, scores map[string]graph.Risk) {
+	sort.SliceStable(reqs, func(i, j int) bool {
+		si, sj := scores[reqs[i].ReqID].Score, scores[reqs[j].ReqID].Score
+		if si != sj {
+			return si > sj
+		}
+		return database.LessReqID(reqs[i].ReqID, reqs[j].ReqID)
+	})
+}
+
 func sortByPriority(reqs []*database.Requirement) {
 	sort.Slice(reqs, func(i, j int) bool {
 		// Sort by priority weight (lower = higher priority)
@@ -198,7 +409,7 @@ func sortByPriority(reqs []*database.Requirement) {
 			return reqs[i].Phase < reqs[j].Phase
 		}
 		// Then by ID
-		return reqs[i].ReqID < reqs[j].ReqID
+		return database.LessReqID(reqs[i].ReqID, reqs[j].ReqID)
 	})
 }
 
@@ -228,14 +439,17 @@ func displayBacklog(cmd *cobra.Command, reqs []*database.Requirement, db *databa
 	}
 
 	cmd.Printf("Total Requirements: %d\n", db.Len())
-	cmd.Printf("  %s MISSING: %d (%.1f%%)\n",
-		output.StatusIcon("MISSING"), totalMissing, float64(totalMissing)/float64(db.Len())*100)
-	cmd.Printf("  %s PARTIAL: %d (%.1f%%)\n",
-		output.StatusIcon("PARTIAL"), totalPartial, float64(totalPartial)/float64(db.Len())*100)
-	cmd.Printf("Estimated Effort: %.1f weeks\n", totalEffort)
+	cmd.Printf("  %s MISSING: %d (%s)\n",
+		output.StatusIcon("MISSING"), totalMissing, output.Percent(float64(totalMissing)/float64(db.Len())*100))
+	cmd.Printf("  %s PARTIAL: %d (%s)\n",
+		output.StatusIcon("PARTIAL"), totalPartial, output.Percent(float64(totalPartial)/float64(db.Len())*100))
+	cmd.Printf("Estimated Effort: %s weeks\n", output.Decimal(totalEffort, 1))
 	cmd.Println()
 
 	// Display based on view
+	if backlogView == "overdue" || backlogDueIn != "" {
+		return displayDueTable(cmd, reqs)
+	}
 	switch backlogView {
 	case "list":
 		return displaySimpleList(cmd, reqs)
@@ -245,11 +459,38 @@ func displayBacklog(cmd *cobra.Command, reqs []*database.Requirement, db *databa
 		return displayQuickWinsTable(cmd, reqs, cfg)
 	case "blockers":
 		return displayBlockersTable(cmd, reqs, db, cfg)
+	case "risk":
+		return displayRiskTable(cmd, reqs, db, cfg)
 	default:
 		return displayAllBacklog(cmd, reqs, db, cfg)
 	}
 }
 
+func displayDueTable(cmd *cobra.Command, reqs []*database.Requirement) error {
+	table := output.NewTable("#", "Status", "Requirement", "Description", "Due", "Assignee")
+	now := backlogNow()
+
+	for i, r := range reqs {
+		icon := output.StatusIcon(r.Status.String())
+		due := displayDate(r.DueDate)
+		if r.IsOverdue(now) {
+			due = output.Color(due, output.Red)
+		}
+
+		table.AddRow(
+			fmt.Sprintf("%d", i+1),
+			icon,
+			r.ReqID,
+			output.TruncateCell(r.RequirementText, 35),
+			due,
+			r.Assignee,
+		)
+	}
+
+	cmd.Print(table.Render())
+	return nil
+}
+
 func displaySimpleList(cmd *cobra.Command, reqs []*database.Requirement) error {
 	for _, r := range reqs {
 		icon := output.StatusIcon(r.Status.String())
@@ -365,7 +606,7 @@ func displayQuickWinsTable(cmd *cobra.Command, reqs []*database.Requirement, cfg
 }
 
 func displayBlockersTable(cmd *cobra.Command, reqs []*database.Requirement, db *database.Database, cfg *config.Config) error {
-	table := output.NewTable("#", "Status", "Requirement", "Description", "Blocks", "Phase")
+	table := output.NewTable("#", "Status", "Requirement", "Description", "Blocks", "Phase", "External")
 
 	for i, r := range reqs {
 		icon := output.StatusIcon(r.Status.String())
@@ -374,6 +615,11 @@ func displayBlockersTable(cmd *cobra.Command, reqs []*database.Requirement, db *
 		phaseDesc := cfg.PhaseDescription(r.Phase)
 		phaseStr := fmt.Sprintf("Phase %d (%s)", r.Phase, phaseDesc)
 
+		external := "-"
+		if r.BlockedExternal != "" {
+			external = output.Color("blocked externally: "+output.TruncateCell(r.BlockedExternal, 30), output.Red)
+		}
+
 		table.AddRow(
 			fmt.Sprintf("%d", i+1),
 			icon,
@@ -381,6 +627,7 @@ func displayBlockersTable(cmd *cobra.Command, reqs []*database.Requirement, db *
 			output.TruncateCell(r.RequirementText, 35),
 			fmt.Sprintf("%d", blocked),
 			output.TruncateCell(phaseStr, 20),
+			external,
 		)
 	}
 
@@ -388,6 +635,32 @@ func displayBlockersTable(cmd *cobra.Command, reqs []*database.Requirement, db *
 	return nil
 }
 
+func displayRiskTable(cmd *cobra.Command, reqs []*database.Requirement, db *database.Database, cfg *config.Config) error {
+	table := output.NewTable("#", "Status", "Requirement", "Description", "Risk", "Priority", "Blocks", "Uncertainty")
+	scores := riskScores(db, cfg)
+
+	for i, r := range reqs {
+		icon := output.StatusIcon(r.Status.String())
+		risk := scores[r.ReqID]
+
+		table.AddRow(
+			fmt.Sprintf("%d", i+1),
+			icon,
+			r.ReqID,
+			output.TruncateCell(r.RequirementText, 30),
+			fmt.Sprintf("%.2f", risk.Score),
+			fmt.Sprintf("%s (%g)", r.Priority, risk.PriorityWeight),
+			fmt.Sprintf("%d", risk.Blocked),
+			fmt.Sprintf("×%.2f", risk.Uncertainty),
+		)
+	}
+
+	cmd.Print(table.Render())
+	cmd.Println()
+	cmd.Println("Risk = priority weight × (1 + blocked weight × blocks) × effort uncertainty")
+	return nil
+}
+
 func displayRemainingTable(cmd *cobra.Command, reqs []*database.Requirement, db *database.Database, cfg *config.Config) error {
 	table := output.NewTable("#", "Status", "Requirement", "Description", "Priority", "Blocks", "⊘", "Phase")
 
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// ganttDefaultWeeks is the duration given to unestimated requirements.
+const ganttDefaultWeeks = 1.0
+
+// ganttTask is one requirement scheduled on the Gantt chart.
+type ganttTask struct {
+	Req         *database.Requirement
+	ID          string
+	After       []string
+	Days        int
+	Unestimated bool
+}
+
+// ganttSection names the section a requirement is grouped under.
+func ganttSection(req *database.Requirement) string {
+	category := req.Category
+	if category == "" {
+		category = "UNCATEGORIZED"
+	}
+	if req.Phase == 0 {
+		return "Unphased · " + category
+	}
+	return fmt.Sprintf("Phase %d · %s", req.Phase, category)
+}
+
+// ganttSchedule orders requirements so that each comes after the
+// requirements it depends on, preferring lower phases, then category, then
+// ID. Dependencies outside reqs (complete or filtered out) do not delay a
+// task. Requirements in a dependency cycle are appended in the same order,
+// after only those dependencies already scheduled.
+func ganttSchedule(reqs []*database.Requirement) []ganttTask {
+	inSet := make(map[string]*database.Requirement, len(reqs))
+	for _, r := range reqs {
+		inSet[r.ReqID] = r
+	}
+
+	less := func(a, b *database.Requirement) bool {
+		if a.Phase != b.Phase {
+			return a.Phase < b.Phase
+		}
+		if a.Category != b.Category {
+			return a.Category < b.Category
+		}
+		return database.LessReqID(a.ReqID, b.ReqID)
+	}
+
+	pending := make([]*database.Requirement, len(reqs))
+	copy(pending, reqs)
+	sort.Slice(pending, func(i, j int) bool { return less(pending[i], pending[j]) })
+
+	scheduled := make(map[string]bool, len(reqs))
+	var tasks []ganttTask
+	for len(pending) > 0 {
+		// Take the first pending requirement whose dependencies are all
+		// scheduled; on a cycle, take the first pending requirement
+		next := 0
+		for i, r := range pending {
+			if ganttReady(r, inSet, scheduled) {
+				next = i
+				break
+			}
+		}
+		req := pending[next]
+		pending = append(pending[:next], pending[next+1:]...)
+
+		weeks, unestimated := req.EffortWeeks, false
+		if weeks <= 0 {
+			weeks, unestimated = ganttDefaultWeeks, true
+		}
+		task := ganttTask{
+			Req:         req,
+			ID:          ganttTaskID(req.ReqID),
+			Days:        int(math.Ceil(weeks * 7)),
+			Unestimated: unestimated,
+		}
+		for _, dep := range req.Dependencies.Slice() {
+			if _, ok := inSet[dep]; ok && scheduled[dep] {
+				task.After = append(task.After, ganttTaskID(dep))
+			}
+		}
+		scheduled[req.ReqID] = true
+		tasks = append(tasks, task)
+	}
+	return tasks
+}
+
+func ganttReady(req *database.Requirement, inSet map[string]*database.Requirement, scheduled map[string]bool) bool {
+	for _, dep := range req.Dependencies.Slice() {
+		if _, ok := inSet[dep]; ok && !scheduled[dep] {
+			return false
+		}
+	}
+	return true
+}
+
+// ganttTaskID turns a requirement ID into a Mermaid task ID.
+func ganttTaskID(reqID string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(reqID) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteRune('_')
+		}
+	}
+	return sb.String()
+}
+
+// ganttTaskName is the task label, free of characters Mermaid treats as
+// syntax.
+func ganttTaskName(task ganttTask) string {
+	text := strings.NewReplacer(":", " -", ";", ",", "#", "").Replace(task.Req.RequirementText)
+	name := task.Req.ReqID
+	if text = strings.TrimSpace(output.Truncate(text, 40)); text != "" {
+		name += " " + text
+	}
+	if task.Unestimated {
+		name += " (unestimated)"
+	}
+	return name
+}
+
+// renderGantt writes the schedule as a Mermaid Gantt chart starting at
+// start. A new section begins whenever the phase or category changes.
+func renderGantt(tasks []ganttTask, start time.Time) string {
+	var sb strings.Builder
+	sb.WriteString("gantt\n")
+	sb.WriteString("    title RTMX Backlog Schedule\n")
+	sb.WriteString("    dateFormat YYYY-MM-DD\n")
+
+	section := ""
+	for _, task := range tasks {
+		if s := ganttSection(task.Req); s != section {
+			section = s
+			sb.WriteString("    section " + section + "\n")
+		}
+		when := start.Format("2006-01-02")
+		if len(task.After) > 0 {
+			when = "after " + strings.Join(task.After, " ")
+		}
+		sb.WriteString(fmt.Sprintf("    %s :%s, %s, %dd\n", ganttTaskName(task), task.ID, when, task.Days))
+	}
+	return sb.String()
+}
+
+func displayBacklogGantt(cmd *cobra.Command, reqs []*database.Requirement) error {
+	tasks := ganttSchedule(reqs)
+	cmd.Print(renderGantt(tasks, backlogNow()))
+
+	unestimated := 0
+	for _, task := range tasks {
+		if task.Unestimated {
+			unestimated++
+		}
+	}
+	if unestimated > 0 {
+		cmd.Printf("%%%% %d unestimated requirement(s) scheduled at %.0f week(s) each\n", unestimated, ganttDefaultWeeks)
+	}
+	return nil
+}
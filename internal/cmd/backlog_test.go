@@ -2,10 +2,14 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/rtmx-ai/rtmx-go/internal/database"
 	"github.com/spf13/cobra"
 )
 
@@ -82,7 +86,7 @@ func TestBacklogViewModes(t *testing.T) {
 	_ = os.Chdir(projectRoot)
 	defer func() { _ = os.Chdir(oldWd) }()
 
-	views := []string{"all", "critical", "quick-wins", "blockers", "list"}
+	views := []string{"all", "critical", "quick-wins", "blockers", "risk", "list"}
 
 	for _, view := range views {
 		t.Run(view, func(t *testing.T) {
@@ -159,6 +163,232 @@ func TestBacklogTableFormat(t *testing.T) {
 }
 
 // createBacklogTestCmd creates a root command with real backlog command for testing
+func TestBacklogOverdueView(t *testing.T) {
+	origNow := backlogNow
+	backlogNow = func() time.Time { return time.Date(2025, 6, 15, 9, 0, 0, 0, time.Local) }
+	defer func() { backlogNow = origNow }()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	db := database.NewDatabase()
+	for _, r := range []struct {
+		id, due string
+		status  database.Status
+	}{
+		{"REQ-OLD-001", "2025-06-01", database.StatusMissing},   // overdue
+		{"REQ-OLD-002", "2025-06-10", database.StatusPartial},   // overdue
+		{"REQ-DONE-001", "2025-06-01", database.StatusComplete}, // overdue but complete
+		{"REQ-SOON-001", "2025-06-18", database.StatusMissing},  // upcoming
+		{"REQ-LATE-001", "2025-08-01", database.StatusMissing},  // far out
+		{"REQ-NONE-001", "", database.StatusMissing},            // no due date
+	} {
+		req := database.NewRequirement(r.id)
+		req.Category = "TEST"
+		req.RequirementText = r.id
+		req.Status = r.status
+		req.DueDate = r.due
+		_ = db.Add(req)
+	}
+	if err := db.Save(filepath.Join(tmpDir, ".rtmx", "database.csv")); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "overdue",
+			args:    []string{"backlog", "--view", "overdue"},
+			want:    []string{"REQ-OLD-001", "REQ-OLD-002"},
+			notWant: []string{"REQ-DONE-001", "REQ-SOON-001", "REQ-LATE-001", "REQ-NONE-001"},
+		},
+		{
+			name:    "due within",
+			args:    []string{"backlog", "--due-within", "7d"},
+			want:    []string{"REQ-SOON-001"},
+			notWant: []string{"REQ-OLD-001", "REQ-DONE-001", "REQ-LATE-001", "REQ-NONE-001"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rootCmd := createBacklogTestCmd()
+			buf := new(bytes.Buffer)
+			rootCmd.SetOut(buf)
+			rootCmd.SetArgs(tt.args)
+
+			if err := rootCmd.Execute(); err != nil {
+				t.Fatalf("backlog failed: %v", err)
+			}
+
+			out := buf.String()
+			for _, id := range tt.want {
+				if !strings.Contains(out, id) {
+					t.Errorf("Expected %s in output:\n%s", id, out)
+				}
+			}
+			for _, id := range tt.notWant {
+				if strings.Contains(out, id) {
+					t.Errorf("Did not expect %s in output:\n%s", id, out)
+				}
+			}
+		})
+	}
+}
+
+func TestBacklogRiskView(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	// REQ-CORE-001 is MEDIUM but blocks three others; REQ-LEAF-001 is a HIGH leaf
+	db := database.NewDatabase()
+	for _, r := range []struct {
+		id       string
+		priority database.Priority
+		deps     []string
+	}{
+		{"REQ-LEAF-001", database.PriorityHigh, nil},
+		{"REQ-CORE-001", database.PriorityMedium, nil},
+		{"REQ-DEP-001", database.PriorityLow, []string{"REQ-CORE-001"}},
+		{"REQ-DEP-002", database.PriorityLow, []string{"REQ-CORE-001"}},
+		{"REQ-DEP-003", database.PriorityLow, []string{"REQ-CORE-001"}},
+	} {
+		req := database.NewRequirement(r.id)
+		req.Category = "TEST"
+		req.RequirementText = r.id
+		req.Priority = r.priority
+		req.EffortWeeks = 1
+		for _, dep := range r.deps {
+			req.Dependencies.Add(dep)
+		}
+		_ = db.Add(req)
+	}
+	if err := db.Save(filepath.Join(tmpDir, ".rtmx", "database.csv")); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	rootCmd := createBacklogTestCmd()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"backlog", "--view", "risk"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("backlog --view risk failed: %v", err)
+	}
+
+	out := buf.String()
+	core := strings.Index(out, "REQ-CORE-001")
+	leaf := strings.Index(out, "REQ-LEAF-001")
+	if core < 0 || leaf < 0 {
+		t.Fatalf("Expected both requirements in output:\n%s", out)
+	}
+	if core > leaf {
+		t.Errorf("Expected REQ-CORE-001 to outrank REQ-LEAF-001:\n%s", out)
+	}
+	// Contributing factors: 2 × (1+3) × 1.25
+	for _, want := range []string{"10.00", "MEDIUM (2)", "×1.25"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestParseDueWindow(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"7d", 7 * 24 * time.Hour, false},
+		{"2w", 14 * 24 * time.Hour, false},
+		{"36h", 36 * time.Hour, false},
+		{"soon", 0, true},
+		{"-1d", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseDueWindow(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseDueWindow(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseDueWindow(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestBacklogIncompleteStatuses(t *testing.T) {
+	t.Cleanup(func() {
+		database.SetCustomStatuses(nil)
+		database.SetIncompleteStatuses(nil)
+	})
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	config := "rtmx:\n  database: .rtmx/database.csv\n" +
+		"  statuses:\n    custom: [IN_REVIEW, BLOCKED]\n    incomplete: [MISSING, PARTIAL, BLOCKED]\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte(config), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	csvData := "req_id,category,requirement_text,status\n" +
+		"REQ-ST-001,TEST,Missing,MISSING\n" +
+		"REQ-ST-002,TEST,In review,IN_REVIEW\n" +
+		"REQ-ST-003,TEST,Blocked,BLOCKED\n" +
+		"REQ-ST-004,TEST,Done,COMPLETE\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "database.csv"), []byte(csvData), 0644); err != nil {
+		t.Fatalf("Failed to write database: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	root := createBacklogTestCmd()
+	root.PersistentPreRunE = applyConfigDefaults
+	buf := new(bytes.Buffer)
+	root.SetOut(buf)
+	root.SetArgs([]string{"backlog"})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("backlog failed: %v", err)
+	}
+
+	out := buf.String()
+	for _, id := range []string{"REQ-ST-001", "REQ-ST-003"} {
+		if !strings.Contains(out, id) {
+			t.Errorf("Expected %s in the backlog:\n%s", id, out)
+		}
+	}
+	for _, id := range []string{"REQ-ST-002", "REQ-ST-004"} {
+		if strings.Contains(out, id) {
+			t.Errorf("Did not expect %s in the backlog:\n%s", id, out)
+		}
+	}
+}
+
 func createBacklogTestCmd() *cobra.Command {
 	root := &cobra.Command{
 		Use:           "rtmx",
@@ -170,6 +400,11 @@ func createBacklogTestCmd() *cobra.Command {
 	var phase int
 	var category string
 	var limit int
+	var dueWithin string
+	var format string
+	var release string
+	var links bool
+	var includeArchived bool
 
 	backlogCmd := &cobra.Command{
 		Use:   "backlog",
@@ -179,6 +414,11 @@ func createBacklogTestCmd() *cobra.Command {
 			backlogPhase = phase
 			backlogCategory = category
 			backlogLimit = limit
+			backlogDueIn = dueWithin
+			backlogFormat = format
+			backlogRelease = release
+			backlogLinks = links
+			backlogArchived = includeArchived
 			return runBacklog(cmd, args)
 		},
 	}
@@ -186,7 +426,240 @@ func createBacklogTestCmd() *cobra.Command {
 	backlogCmd.Flags().IntVar(&phase, "phase", 0, "filter by phase")
 	backlogCmd.Flags().StringVar(&category, "category", "", "filter by category")
 	backlogCmd.Flags().IntVarP(&limit, "limit", "n", 0, "limit results")
+	backlogCmd.Flags().StringVar(&dueWithin, "due-within", "", "due window")
+	backlogCmd.Flags().StringVar(&format, "format", "terminal", "output format")
+	backlogCmd.Flags().StringVar(&release, "release", "", "filter by release")
+	backlogCmd.Flags().BoolVar(&links, "links", false, "list linked issue URLs")
+	backlogCmd.Flags().BoolVar(&includeArchived, "include-archived", false, "include archived categories")
 	root.AddCommand(backlogCmd)
 
 	return root
 }
+
+func TestBacklogGanttFormat(t *testing.T) {
+	origNow := backlogNow
+	backlogNow = func() time.Time { return time.Date(2025, 6, 16, 9, 0, 0, 0, time.Local) }
+	defer func() { backlogNow = origNow }()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	// REQ-API-001 sorts first but depends on REQ-DB-002, which depends on
+	// REQ-DB-001; REQ-DONE-001 is complete and is left off the chart
+	db := database.NewDatabase()
+	for _, r := range []struct {
+		id, category string
+		phase        int
+		effort       float64
+		status       database.Status
+		deps         []string
+	}{
+		{"REQ-API-001", "API", 1, 1.5, database.StatusMissing, []string{"REQ-DB-002", "REQ-DONE-001"}},
+		{"REQ-DB-001", "DB", 1, 2, database.StatusPartial, nil},
+		{"REQ-DB-002", "DB", 1, 0, database.StatusMissing, []string{"REQ-DB-001"}},
+		{"REQ-UI-001", "UI", 2, 1, database.StatusMissing, nil},
+		{"REQ-DONE-001", "DB", 1, 1, database.StatusComplete, nil},
+	} {
+		req := database.NewRequirement(r.id)
+		req.Category = r.category
+		req.RequirementText = "Build " + r.id
+		req.Phase = r.phase
+		req.EffortWeeks = r.effort
+		req.Status = r.status
+		for _, dep := range r.deps {
+			req.Dependencies.Add(dep)
+		}
+		_ = db.Add(req)
+	}
+	if err := db.Save(filepath.Join(tmpDir, ".rtmx", "database.csv")); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	rootCmd := createBacklogTestCmd()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"backlog", "--format", "gantt"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("backlog --format gantt failed: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(out, "gantt\n") {
+		t.Errorf("Expected Mermaid gantt header, got:\n%s", out)
+	}
+	want := []string{
+		"    section Phase 1 · DB\n",
+		"    REQ-DB-001 Build REQ-DB-001 :req_db_001, 2025-06-16, 14d\n",
+		"    REQ-DB-002 Build REQ-DB-002 (unestimated) :req_db_002, after req_db_001, 7d\n",
+		"    section Phase 1 · API\n",
+		"    REQ-API-001 Build REQ-API-001 :req_api_001, after req_db_002, 11d\n",
+		"    section Phase 2 · UI\n",
+		"    REQ-UI-001 Build REQ-UI-001 :req_ui_001, 2025-06-16, 7d\n",
+		"%% 1 unestimated requirement(s) scheduled at 1 week(s) each\n",
+	}
+	pos := 0
+	for _, line := range want {
+		i := strings.Index(out[pos:], line)
+		if i < 0 {
+			t.Fatalf("Expected %q after offset %d in:\n%s", line, pos, out)
+		}
+		pos += i + len(line)
+	}
+	if strings.Contains(out, "REQ-DONE-001") {
+		t.Errorf("Complete requirements should not be scheduled:\n%s", out)
+	}
+	if strings.Contains(out, "Prioritized Backlog") {
+		t.Errorf("Gantt output should not include the terminal header:\n%s", out)
+	}
+}
+
+func TestBacklogReleaseFilter(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	db := database.NewDatabase()
+	for _, r := range []struct{ id, release string }{
+		{"REQ-ONE-001", "v1.0"},
+		{"REQ-TWO-001", "v1.1"},
+		{"REQ-TWO-002", "v1.1"},
+		{"REQ-NONE-001", ""},
+	} {
+		req := database.NewRequirement(r.id)
+		req.Category = "TEST"
+		req.RequirementText = r.id
+		req.Release = r.release
+		_ = db.Add(req)
+	}
+	if err := db.Save(filepath.Join(tmpDir, ".rtmx", "database.csv")); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	rootCmd := createBacklogTestCmd()
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"backlog", "--release", "v1.1"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("backlog --release failed: %v", err)
+	}
+
+	out := buf.String()
+	for _, id := range []string{"REQ-TWO-001", "REQ-TWO-002"} {
+		if !strings.Contains(out, id) {
+			t.Errorf("Expected %s in output:\n%s", id, out)
+		}
+	}
+	for _, id := range []string{"REQ-ONE-001", "REQ-NONE-001"} {
+		if strings.Contains(out, id) {
+			t.Errorf("Did not expect %s in output:\n%s", id, out)
+		}
+	}
+}
+
+func TestBacklogArchivedCategories(t *testing.T) {
+	origFormat, origArchived := statusFormat, statusArchived
+	defer func() { statusFormat, statusArchived = origFormat, origArchived }()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	config := "rtmx:\n  database: .rtmx/database.csv\n  archived_categories: [legacy]\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte(config), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	db := database.NewDatabase()
+	for _, r := range []struct {
+		id, category string
+		status       database.Status
+	}{
+		{"REQ-AUTH-001", "AUTH", database.StatusMissing},
+		{"REQ-AUTH-002", "AUTH", database.StatusComplete},
+		{"REQ-LEGACY-001", "LEGACY", database.StatusComplete},
+		{"REQ-LEGACY-002", "LEGACY", database.StatusPartial},
+	} {
+		req := database.NewRequirement(r.id)
+		req.Category = r.category
+		req.RequirementText = r.id
+		req.Status = r.status
+		_ = db.Add(req)
+	}
+	if err := db.Save(filepath.Join(tmpDir, ".rtmx", "database.csv")); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantLegacy bool
+	}{
+		{"hidden by default", []string{"backlog", "--view", "list"}, false},
+		{"shown with --include-archived", []string{"backlog", "--view", "list", "--include-archived"}, true},
+		{"shown when its category is asked for", []string{"backlog", "--view", "list", "--category", "LEGACY"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := executeCommand(createBacklogTestCmd(), tt.args...)
+			if err != nil {
+				t.Fatalf("backlog failed: %v", err)
+			}
+			if got := strings.Contains(out, "REQ-LEGACY-002"); got != tt.wantLegacy {
+				t.Errorf("REQ-LEGACY-002 listed = %v, want %v:\n%s", got, tt.wantLegacy, out)
+			}
+		})
+	}
+
+	// status reports the scoped completion and the full one
+	statusFormat, statusArchived = "json", false
+	var buf bytes.Buffer
+	statusCmd.SetOut(&buf)
+	defer statusCmd.SetOut(nil)
+	if err := runStatus(statusCmd, nil); err != nil {
+		t.Fatalf("status failed: %v", err)
+	}
+	var report statusReport
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("Failed to parse status: %v\n%s", err, buf.String())
+	}
+	if report.Total != 2 || report.Completion != 50 {
+		t.Errorf("scoped status = %d requirements at %.1f%%, want 2 at 50%%", report.Total, report.Completion)
+	}
+	if a := report.Archived; a == nil || a.Hidden != 2 || a.Total != 4 || a.Completion != 62.5 {
+		t.Errorf("archived rollup = %+v, want 2 of 4 hidden at 62.5%% overall", a)
+	}
+
+	statusArchived = true
+	buf.Reset()
+	if err := runStatus(statusCmd, nil); err != nil {
+		t.Fatalf("status failed: %v", err)
+	}
+	report = statusReport{}
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("Failed to parse status: %v", err)
+	}
+	if report.Total != 4 || report.Archived != nil {
+		t.Errorf("status --include-archived = %+v, want all 4 requirements", report)
+	}
+}
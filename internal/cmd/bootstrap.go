@@ -1,13 +1,16 @@
 package cmd
 
 import (
+	"bufio"
 	"fmt"
 	"os"
 	"path/filepath"
-	"regexp"
 	"strings"
 
+	"github.com/rtmx-ai/rtmx-go/internal/adapters"
 	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/markers"
 	"github.com/rtmx-ai/rtmx-go/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -16,21 +19,50 @@ var (
 	bootstrapFromTests  bool
 	bootstrapFromGitHub bool
 	bootstrapFromJira   bool
+	bootstrapFromMD     string
 	bootstrapMerge      bool
 	bootstrapDryRun     bool
 	bootstrapPrefix     string
+	bootstrapOnDup      string
+	bootstrapScaffold   bool
 )
 
+// bootstrapAdapter connects to the service imported from; tests replace it.
+var bootstrapAdapter = getAdapter
+
 var bootstrapCmd = &cobra.Command{
 	Use:   "bootstrap",
 	Short: "Generate initial RTM from project artifacts",
-	Long: `Bootstrap requirements from existing tests, GitHub issues, or Jira tickets.
+	Long: `Bootstrap requirements from existing tests, GitHub issues, Jira tickets,
+or Markdown checklists.
+
+With --from-markdown, each heading becomes a category and each checklist
+item under it becomes a requirement: "- [x]" items are COMPLETE and
+"- [ ]" items are MISSING. IDs are numbered per category, e.g.
+REQ-USER_ACCOUNTS-001. They start with --prefix, or the upper-cased
+pytest.marker_prefix in rtmx.yaml when it is not given.
+
+With --from-github and --from-jira, each issue not yet linked to a
+requirement becomes one in a GITHUB or JIRA category, linked to the issue.
+--scaffold-specs also writes each issue's body, without its RTMX marker,
+to the new requirement's spec file.
+
+With --merge, new requirements are numbered after the existing ones in
+their category, and tests, issues and checklist items an earlier run
+already added are skipped, so bootstrap can be re-run. Each discovered
+requirement is also compared by text with the existing ones. Near-duplicates, such as a test-derived requirement that
+restates an imported issue, are reported and handled by --on-duplicate:
+skip leaves them out, link adds them noted as a near-duplicate of the
+existing requirement, and add adds them unchanged.
 
 Examples:
     rtmx bootstrap --from-tests        # Generate from test markers
     rtmx bootstrap --from-github       # Import from GitHub issues
     rtmx bootstrap --from-jira         # Import from Jira tickets
-    rtmx bootstrap --from-tests --merge  # Merge with existing RTM`,
+    rtmx bootstrap --from-github --scaffold-specs  # Keep issue bodies as specs
+    rtmx bootstrap --from-markdown spec.md  # Import Markdown checklists
+    rtmx bootstrap --from-tests --merge  # Merge with existing RTM
+    rtmx bootstrap --from-tests --merge --on-duplicate link`,
 	RunE: runBootstrap,
 }
 
@@ -38,9 +70,12 @@ func init() {
 	bootstrapCmd.Flags().BoolVar(&bootstrapFromTests, "from-tests", false, "generate requirements from test functions")
 	bootstrapCmd.Flags().BoolVar(&bootstrapFromGitHub, "from-github", false, "import from GitHub issues")
 	bootstrapCmd.Flags().BoolVar(&bootstrapFromJira, "from-jira", false, "import from Jira tickets")
+	bootstrapCmd.Flags().StringVar(&bootstrapFromMD, "from-markdown", "", "import checklist items from a Markdown file")
 	bootstrapCmd.Flags().BoolVar(&bootstrapMerge, "merge", false, "merge with existing RTM (default: replace)")
 	bootstrapCmd.Flags().BoolVar(&bootstrapDryRun, "dry-run", false, "preview without writing files")
-	bootstrapCmd.Flags().StringVar(&bootstrapPrefix, "prefix", "REQ", "requirement ID prefix")
+	bootstrapCmd.Flags().StringVar(&bootstrapPrefix, "prefix", "", "requirement ID prefix (default: pytest.marker_prefix from config, upper-cased)")
+	bootstrapCmd.Flags().StringVar(&bootstrapOnDup, "on-duplicate", "skip", "with --merge, handle near-duplicates of existing requirements: skip, link, add")
+	bootstrapCmd.Flags().BoolVar(&bootstrapScaffold, "scaffold-specs", false, "write imported issues' bodies to requirement spec files that do not exist yet")
 
 	rootCmd.AddCommand(bootstrapCmd)
 }
@@ -53,8 +88,19 @@ type BootstrapRequirement struct {
 	Text        string
 	TestModule  string
 	TestFunc    string
-	Source      string // "test", "github", "jira"
-	ExternalID  string // GitHub issue number or Jira ticket key
+	Source      string                 // "test", "github", "jira", "markdown"
+	ExternalID  string                 // GitHub issue number or Jira ticket key
+	Status      string                 // Initial status; MISSING when empty
+	DuplicateOf string                 // Existing requirement this one closely matches
+	Item        *adapters.ExternalItem // Imported issue, for GitHub and Jira
+}
+
+// bootstrapOverlap is a discovered requirement whose text closely matches
+// an existing requirement.
+type bootstrapOverlap struct {
+	Req        BootstrapRequirement
+	ExistingID string
+	Similarity float64
 }
 
 func runBootstrap(cmd *cobra.Command, args []string) error {
@@ -62,11 +108,17 @@ func runBootstrap(cmd *cobra.Command, args []string) error {
 		output.DisableColor()
 	}
 
-	if !bootstrapFromTests && !bootstrapFromGitHub && !bootstrapFromJira {
-		cmd.Printf("%s\n", output.Color("No source specified. Use --from-tests, --from-github, or --from-jira", output.Yellow))
+	if !bootstrapFromTests && !bootstrapFromGitHub && !bootstrapFromJira && bootstrapFromMD == "" {
+		cmd.Printf("%s\n", output.Color("No source specified. Use --from-tests, --from-github, --from-jira, or --from-markdown", output.Yellow))
 		return NewExitError(1, "no source specified")
 	}
 
+	switch bootstrapOnDup {
+	case "skip", "link", "add":
+	default:
+		return NewValidationError(fmt.Sprintf("invalid --on-duplicate %q", bootstrapOnDup), "use skip, link or add")
+	}
+
 	cmd.Println("=== RTMX Bootstrap ===")
 	cmd.Println()
 
@@ -83,8 +135,12 @@ func runBootstrap(cmd *cobra.Command, args []string) error {
 	cfg, err := config.LoadFromDir(cwd)
 	if err != nil {
 		// Use defaults if no config
-		cfg = &config.Config{}
-		cfg.RTMX.Database = ".rtmx/database.csv"
+		cfg = config.DefaultConfig()
+	}
+
+	prefix := bootstrapPrefix
+	if prefix == "" {
+		prefix = cfg.IDPrefix()
 	}
 
 	var requirements []BootstrapRequirement
@@ -92,7 +148,11 @@ func runBootstrap(cmd *cobra.Command, args []string) error {
 	// Bootstrap from tests
 	if bootstrapFromTests {
 		cmd.Printf("%s\n", output.Color("Scanning tests...", output.Bold))
-		testReqs := bootstrapFromTestFiles(cwd, bootstrapPrefix)
+		extractor, err := markers.ForConfig(cfg)
+		if err != nil {
+			return fmt.Errorf("invalid marker rules: %w", err)
+		}
+		testReqs := bootstrapFromTestFilesWith(cwd, prefix, cfg.IDPadWidth(), extractor)
 		requirements = append(requirements, testReqs...)
 		cmd.Printf("  Found %d test functions with markers\n", len(testReqs))
 		cmd.Println()
@@ -101,14 +161,10 @@ func runBootstrap(cmd *cobra.Command, args []string) error {
 	// Bootstrap from GitHub
 	if bootstrapFromGitHub {
 		cmd.Printf("%s\n", output.Color("Fetching GitHub issues...", output.Bold))
-		if !cfg.RTMX.Adapters.GitHub.Enabled {
-			cmd.Printf("  %s\n", output.Color("GitHub adapter not enabled in rtmx.yaml", output.Red))
-		} else if cfg.RTMX.Adapters.GitHub.Repo == "" {
+		if cfg.RTMX.Adapters.GitHub.Enabled && cfg.RTMX.Adapters.GitHub.Repo == "" {
 			cmd.Printf("  %s\n", output.Color("GitHub repo not configured in rtmx.yaml", output.Red))
 		} else {
-			cmd.Printf("  Repository: %s\n", cfg.RTMX.Adapters.GitHub.Repo)
-			// TODO: Implement actual GitHub API integration
-			cmd.Printf("  %s\n", output.Color("GitHub bootstrap coming in future release", output.Dim))
+			requirements = append(requirements, bootstrapFromServiceItems(cmd, "github", cfg, prefix)...)
 		}
 		cmd.Println()
 	}
@@ -116,16 +172,67 @@ func runBootstrap(cmd *cobra.Command, args []string) error {
 	// Bootstrap from Jira
 	if bootstrapFromJira {
 		cmd.Printf("%s\n", output.Color("Fetching Jira tickets...", output.Bold))
-		if !cfg.RTMX.Adapters.Jira.Enabled {
-			cmd.Printf("  %s\n", output.Color("Jira adapter not enabled in rtmx.yaml", output.Red))
-		} else if cfg.RTMX.Adapters.Jira.Project == "" {
+		if cfg.RTMX.Adapters.Jira.Enabled && cfg.RTMX.Adapters.Jira.Project == "" {
 			cmd.Printf("  %s\n", output.Color("Jira project not configured in rtmx.yaml", output.Red))
 		} else {
-			cmd.Printf("  Server: %s\n", cfg.RTMX.Adapters.Jira.Server)
-			cmd.Printf("  Project: %s\n", cfg.RTMX.Adapters.Jira.Project)
-			// TODO: Implement actual Jira API integration
-			cmd.Printf("  %s\n", output.Color("Jira bootstrap coming in future release", output.Dim))
+			requirements = append(requirements, bootstrapFromServiceItems(cmd, "jira", cfg, prefix)...)
+		}
+		cmd.Println()
+	}
+
+	// Bootstrap from Markdown checklists
+	if bootstrapFromMD != "" {
+		cmd.Printf("%s\n", output.Color("Parsing Markdown checklists...", output.Bold))
+		mdReqs, err := bootstrapFromMarkdownFile(bootstrapFromMD, prefix, cfg.IDPadWidth())
+		if err != nil {
+			return err
+		}
+		requirements = append(requirements, mdReqs...)
+		cmd.Printf("  Found %d checklist items in %s\n", len(mdReqs), bootstrapFromMD)
+		cmd.Println()
+	}
+
+	// Number requirements after those already in the database, leaving out
+	// the ones an earlier run created
+	base := database.NewDatabase()
+	if bootstrapMerge {
+		if existing, err := database.Load(cfg.DatabasePath(cwd)); err == nil {
+			base = existing
+		}
+	}
+	var present []BootstrapRequirement
+	requirements, present = splitExistingBootstrap(base, requirements)
+	if len(present) > 0 {
+		cmd.Printf("%s\n", output.Color(fmt.Sprintf("Already in the RTM (%d), skipped:", len(present)), output.Dim))
+		for _, req := range present {
+			cmd.Printf("  %s\n", truncateString(req.Text, 60))
+		}
+		cmd.Println()
+	}
+	numberBootstrapRequirements(base, requirements, prefix, cfg.IDPadWidth())
+
+	// Check for near-duplicates of the requirements being merged into
+	if bootstrapMerge && len(requirements) > 0 && base.Len() > 0 {
+		var overlaps []bootstrapOverlap
+		requirements, overlaps = filterBootstrapDuplicates(base, requirements, bootstrapOnDup)
+		if len(overlaps) > 0 {
+			cmd.Printf("%s\n", output.Color(fmt.Sprintf("Near-duplicates of existing requirements (%d):", len(overlaps)), output.Yellow))
+			for _, o := range overlaps {
+				cmd.Printf("  %s ≈ %s (%.0f%% similar): %s\n", o.Req.ID, o.ExistingID, o.Similarity*100, overlapAction(bootstrapOnDup))
+			}
+			cmd.Println()
+		}
+	}
+
+	// Inferred categories may not be in the allow-list; warn once each
+	warned := make(map[string]bool)
+	for _, req := range requirements {
+		if problem := categoryProblem(cfg, req.Category); problem != "" && !warned[req.Category] {
+			warned[req.Category] = true
+			cmd.Printf("%s %s\n", output.Color("⚠", output.Yellow), problem)
 		}
+	}
+	if len(warned) > 0 {
 		cmd.Println()
 	}
 
@@ -145,6 +252,11 @@ func runBootstrap(cmd *cobra.Command, args []string) error {
 			}
 			cmd.Printf("%s Created %d requirements\n", output.Color("✓", output.Green), len(requirements))
 		}
+		if bootstrapScaffold {
+			if err := scaffoldBootstrapSpecs(cmd, cfg, requirements, bootstrapDryRun); err != nil {
+				return err
+			}
+		}
 	} else {
 		cmd.Printf("%s\n", output.Color("No requirements to create", output.Dim))
 	}
@@ -152,7 +264,168 @@ func runBootstrap(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// bootstrapFromServiceItems creates requirements from the items in a
+// service that are not linked to a requirement yet, reporting what it
+// found. A service that is not configured or cannot be reached is
+// reported and yields none.
+func bootstrapFromServiceItems(cmd *cobra.Command, service string, cfg *config.Config, prefix string) []BootstrapRequirement {
+	adapter, err := bootstrapAdapter(service, cfg)
+	if err != nil {
+		cmd.Printf("  %s\n", output.Color(err.Error(), output.Red))
+		return nil
+	}
+	items, err := adapter.FetchItems(nil)
+	if err != nil {
+		cmd.Printf("  %s\n", output.Color(fmt.Sprintf("Failed to fetch from %s: %v", service, err), output.Red))
+		return nil
+	}
+	reqs := bootstrapFromItems(adapter, items, prefix, cfg.IDPadWidth())
+	cmd.Printf("  Found %d unlinked items of %d\n", len(reqs), len(items))
+	return reqs
+}
+
+// bootstrapFromItems turns items not yet linked to a requirement into
+// requirements in the service's category, linked back to their items.
+func bootstrapFromItems(adapter adapters.ServiceAdapter, items []adapters.ExternalItem, prefix string, width int) []BootstrapRequirement {
+	category := strings.ToUpper(adapter.Name())
+	var requirements []BootstrapRequirement
+	for i := range items {
+		item := items[i]
+		if item.RequirementID != "" {
+			continue
+		}
+		requirements = append(requirements, BootstrapRequirement{
+			ID:         formatRequirementID(prefix, category, len(requirements)+1, width),
+			Category:   category,
+			Text:       item.Title,
+			Source:     adapter.Name(),
+			ExternalID: item.ExternalID,
+			Status:     importedStatus(adapter, item).String(),
+			Item:       &item,
+		})
+	}
+	return requirements
+}
+
+// scaffoldBootstrapSpecs writes the bodies of the imported items behind
+// requirements to their spec files, as sync --scaffold-specs does.
+func scaffoldBootstrapSpecs(cmd *cobra.Command, cfg *config.Config, requirements []BootstrapRequirement, dryRun bool) error {
+	result := &SyncResult{}
+	written := 0
+	for _, r := range requirements {
+		if r.Item == nil {
+			continue
+		}
+		req, err := newBootstrapRequirement(cfg, r)
+		if err != nil {
+			return err
+		}
+		if importSpec(cfg, *r.Item, req, dryRun, result) {
+			written++
+		}
+	}
+	for _, e := range result.Errors {
+		cmd.Printf("  %s %s: %s\n", output.Color("✗", output.Red), e.ID, e.Error)
+	}
+	if written > 0 && !dryRun {
+		cmd.Printf("%s Wrote %d spec files\n", output.Color("✓", output.Green), written)
+	}
+	return nil
+}
+
+// filterBootstrapDuplicates compares requirements by text with those in db
+// and returns the ones to create along with the near-duplicates found. With
+// mode "skip" near-duplicates are left out, with "link" they are kept and
+// marked as duplicates of their match, and with "add" they are kept as is.
+func filterBootstrapDuplicates(db *database.Database, requirements []BootstrapRequirement, mode string) ([]BootstrapRequirement, []bootstrapOverlap) {
+	var kept []BootstrapRequirement
+	var overlaps []bootstrapOverlap
+	for _, req := range requirements {
+		match, score := db.MostSimilar(req.Text, database.DefaultSimilarityThreshold)
+		if match == nil {
+			kept = append(kept, req)
+			continue
+		}
+		overlaps = append(overlaps, bootstrapOverlap{Req: req, ExistingID: match.ReqID, Similarity: score})
+		switch mode {
+		case "skip":
+			continue
+		case "link":
+			req.DuplicateOf = match.ReqID
+		}
+		kept = append(kept, req)
+	}
+	return kept, overlaps
+}
+
+// splitExistingBootstrap separates the requirements db already holds, as
+// created by an earlier bootstrap, from the new ones: tests by module and
+// function, imported items by their link, and checklist items by category
+// and text.
+func splitExistingBootstrap(db *database.Database, requirements []BootstrapRequirement) (fresh, present []BootstrapRequirement) {
+	for _, req := range requirements {
+		if bootstrapRequirementExists(db, req) {
+			present = append(present, req)
+		} else {
+			fresh = append(fresh, req)
+		}
+	}
+	return fresh, present
+}
+
+func bootstrapRequirementExists(db *database.Database, req BootstrapRequirement) bool {
+	for _, existing := range db.All() {
+		switch req.Source {
+		case "test":
+			if existing.TestModule == req.TestModule && existing.TestFunction == req.TestFunc {
+				return true
+			}
+		case "markdown":
+			if existing.Category == req.Category && strings.TrimSpace(existing.RequirementText) == strings.TrimSpace(req.Text) {
+				return true
+			}
+		default:
+			if req.ExternalID != "" && adapters.ExternalIDFor(existing, req.Source) == req.ExternalID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// numberBootstrapRequirements gives requirements IDs numbered per
+// category after the highest ID db already has in that category, so that
+// they never collide with existing requirements or with each other.
+func numberBootstrapRequirements(db *database.Database, requirements []BootstrapRequirement, prefix string, width int) {
+	next := make(map[string]int)
+	for i := range requirements {
+		category := requirements[i].Category
+		if _, ok := next[category]; !ok {
+			next[category] = highestRequirementNumber(db, prefix, category)
+		}
+		next[category]++
+		requirements[i].ID = formatRequirementID(prefix, category, next[category], width)
+	}
+}
+
+// overlapAction describes what --on-duplicate does with a near-duplicate.
+func overlapAction(mode string) string {
+	switch mode {
+	case "link":
+		return "added as a linked duplicate"
+	case "add":
+		return "added"
+	}
+	return "skipped"
+}
+
 func bootstrapFromTestFiles(cwd string, prefix string) []BootstrapRequirement {
+	return bootstrapFromTestFilesWith(cwd, prefix, config.DefaultIDPadWidth, markers.Default())
+}
+
+// bootstrapFromTestFilesWith creates requirements for test functions that
+// have no requirement marker, using the extractor's rules to find tests.
+func bootstrapFromTestFilesWith(cwd string, prefix string, width int, extractor *markers.Extractor) []BootstrapRequirement {
 	var requirements []BootstrapRequirement
 
 	testDirs := []string{
@@ -160,12 +433,7 @@ func bootstrapFromTestFiles(cwd string, prefix string) []BootstrapRequirement {
 		filepath.Join(cwd, "test"),
 	}
 
-	// Regex to match @pytest.mark.req("REQ-XXX-NNN") or similar
-	reqMarkerRe := regexp.MustCompile(`@pytest\.mark\.req\s*\(\s*["']([^"']+)["']\s*\)`)
-	funcNameRe := regexp.MustCompile(`def\s+(test_\w+)\s*\(`)
-
-	reqCounter := make(map[string]int)
-
+	var testFiles []string
 	for _, testDir := range testDirs {
 		if _, err := os.Stat(testDir); os.IsNotExist(err) {
 			continue
@@ -175,61 +443,157 @@ func bootstrapFromTestFiles(cwd string, prefix string) []BootstrapRequirement {
 			if err != nil || info.IsDir() {
 				return nil
 			}
-
-			if !strings.HasPrefix(filepath.Base(path), "test_") || !strings.HasSuffix(path, ".py") {
-				return nil
+			if extractor.IsTestFile(path) {
+				testFiles = append(testFiles, path)
 			}
+			return nil
+		})
+	}
 
-			content, err := os.ReadFile(path)
-			if err != nil {
-				return nil
+	// Files are scanned in parallel but numbered in walk order, so IDs do
+	// not depend on scheduling. Unreadable files are skipped.
+	reqCounter := make(map[string]int)
+	for _, file := range extractor.ScanFiles(testFiles, 0) {
+		if file.Err != nil {
+			continue
+		}
+
+		relPath, _ := filepath.Rel(cwd, file.Path)
+
+		marked := make(map[int]bool)
+		for _, m := range file.Result.Markers {
+			marked[m.FunctionLine] = true
+		}
+
+		for _, fn := range file.Result.Functions {
+			if marked[fn.Line] {
+				continue
 			}
 
-			relPath, _ := filepath.Rel(cwd, path)
-			lines := strings.Split(string(content), "\n")
-
-			var currentMarkers []string
-			for i, line := range lines {
-				// Check for requirement marker
-				if matches := reqMarkerRe.FindStringSubmatch(line); len(matches) > 1 {
-					currentMarkers = append(currentMarkers, matches[1])
-				}
-
-				// Check for function definition
-				if matches := funcNameRe.FindStringSubmatch(line); len(matches) > 1 {
-					funcName := matches[1]
-
-					if len(currentMarkers) == 0 {
-						// Test without markers - create a new requirement
-						category := inferCategoryFromPath(relPath)
-						reqCounter[category]++
-						reqID := fmt.Sprintf("%s-%s-%03d", prefix, category, reqCounter[category])
-
-						// Try to extract docstring for requirement text
-						text := inferRequirementText(lines, i, funcName)
-
-						requirements = append(requirements, BootstrapRequirement{
-							ID:          reqID,
-							Category:    category,
-							Subcategory: "",
-							Text:        text,
-							TestModule:  relPath,
-							TestFunc:    funcName,
-							Source:      "test",
-						})
-					}
-
-					currentMarkers = nil // Reset markers for next function
-				}
+			// Test without markers - create a new requirement
+			category := inferCategoryFromPath(relPath)
+			reqCounter[category]++
+			reqID := formatRequirementID(prefix, category, reqCounter[category], width)
+
+			// Prefer the test's docstring for requirement text
+			text := inferRequirementText(fn.Doc, fn.Name)
+
+			requirements = append(requirements, BootstrapRequirement{
+				ID:          reqID,
+				Category:    category,
+				Subcategory: "",
+				Text:        text,
+				TestModule:  relPath,
+				TestFunc:    fn.Name,
+				Source:      "test",
+			})
+		}
+	}
+
+	return requirements
+}
+
+// bootstrapFromMarkdownFile creates requirements from the checklist items
+// in a Markdown file.
+func bootstrapFromMarkdownFile(path string, prefix string, width int) ([]BootstrapRequirement, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return parseMarkdownChecklist(string(content), prefix, width), nil
+}
+
+// parseMarkdownChecklist turns "- [ ]" and "- [x]" items into requirements,
+// categorized by the nearest heading above them. Fenced code is ignored.
+func parseMarkdownChecklist(content string, prefix string, width int) []BootstrapRequirement {
+	var requirements []BootstrapRequirement
+	reqCounter := make(map[string]int)
+	category := "GENERAL"
+	inFence := false
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if strings.HasPrefix(line, "```") || strings.HasPrefix(line, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			if heading := categoryFromHeading(strings.TrimLeft(line, "#")); heading != "" {
+				category = heading
 			}
+			continue
+		}
 
-			return nil
+		text, checked, ok := parseChecklistItem(line)
+		if !ok {
+			continue
+		}
+
+		reqCounter[category]++
+		status := "MISSING"
+		if checked {
+			status = "COMPLETE"
+		}
+		requirements = append(requirements, BootstrapRequirement{
+			ID:       formatRequirementID(prefix, category, reqCounter[category], width),
+			Category: category,
+			Text:     text,
+			Source:   "markdown",
+			Status:   status,
 		})
 	}
 
 	return requirements
 }
 
+// parseChecklistItem parses a task list item such as "- [x] Text".
+func parseChecklistItem(line string) (text string, checked bool, ok bool) {
+	if len(line) < 2 || !strings.ContainsRune("-*+", rune(line[0])) || line[1] != ' ' {
+		return "", false, false
+	}
+	rest := strings.TrimSpace(line[2:])
+	if len(rest) < 3 || rest[0] != '[' || rest[2] != ']' {
+		return "", false, false
+	}
+	switch rest[1] {
+	case ' ':
+	case 'x', 'X':
+		checked = true
+	default:
+		return "", false, false
+	}
+	text = strings.TrimSpace(rest[3:])
+	if text == "" {
+		return "", false, false
+	}
+	return text, checked, true
+}
+
+// categoryFromHeading converts a heading to a category name,
+// e.g. "User Accounts" -> USER_ACCOUNTS.
+func categoryFromHeading(heading string) string {
+	var sb strings.Builder
+	pendingSep := false
+	for _, r := range strings.ToUpper(strings.TrimSpace(heading)) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			if pendingSep && sb.Len() > 0 {
+				sb.WriteByte('_')
+			}
+			sb.WriteRune(r)
+			pendingSep = false
+		} else {
+			pendingSep = true
+		}
+	}
+	return sb.String()
+}
+
 func inferCategoryFromPath(path string) string {
 	// Extract category from test file path
 	// e.g., tests/test_models.py -> MODELS
@@ -238,9 +602,12 @@ func inferCategoryFromPath(path string) string {
 	dir := filepath.Dir(path)
 	base := filepath.Base(path)
 
-	// Remove "test_" prefix and ".py" suffix
+	// Remove "test_" prefix, test suffixes and the extension
 	name := strings.TrimPrefix(base, "test_")
-	name = strings.TrimSuffix(name, ".py")
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	for _, suffix := range []string{"_test", ".test", ".spec", "_spec"} {
+		name = strings.TrimSuffix(name, suffix)
+	}
 
 	// Check if in subdirectory
 	parts := strings.Split(dir, string(filepath.Separator))
@@ -253,20 +620,9 @@ func inferCategoryFromPath(path string) string {
 	return strings.ToUpper(name)
 }
 
-func inferRequirementText(lines []string, funcLine int, funcName string) string {
-	// Try to extract docstring from the function
-	if funcLine+1 < len(lines) {
-		nextLine := strings.TrimSpace(lines[funcLine+1])
-		if strings.HasPrefix(nextLine, `"""`) || strings.HasPrefix(nextLine, `'''`) {
-			// Found docstring
-			doc := strings.TrimPrefix(nextLine, `"""`)
-			doc = strings.TrimPrefix(doc, `'''`)
-			doc = strings.TrimSuffix(doc, `"""`)
-			doc = strings.TrimSuffix(doc, `'''`)
-			if doc != "" {
-				return doc
-			}
-		}
+func inferRequirementText(doc string, funcName string) string {
+	if doc != "" {
+		return doc
 	}
 
 	// Convert function name to requirement text
@@ -279,6 +635,8 @@ func inferRequirementText(lines []string, funcLine int, funcName string) string
 	return text
 }
 
+// writeBootstrapRequirements adds requirements to the RTM database, or
+// replaces it unless merge is set, saving it through the atomic writer.
 func writeBootstrapRequirements(cwd string, cfg *config.Config, requirements []BootstrapRequirement, merge bool) error {
 	dbPath := cfg.DatabasePath(cwd)
 
@@ -287,40 +645,70 @@ func writeBootstrapRequirements(cwd string, cfg *config.Config, requirements []B
 		return fmt.Errorf("failed to create directory: %w", err)
 	}
 
-	var existingContent string
+	db := database.NewDatabase()
 	if merge {
-		if content, err := os.ReadFile(dbPath); err == nil {
-			existingContent = string(content)
+		if _, err := os.Stat(dbPath); err == nil {
+			existing, err := database.Load(dbPath)
+			if err != nil {
+				return databaseLoadError(err)
+			}
+			db = existing
 		}
 	}
 
-	var sb strings.Builder
-
-	// Write header if new file or not merging
-	if existingContent == "" {
-		sb.WriteString("req_id,category,subcategory,requirement_text,target_value,test_module,test_function,validation_method,status,priority,phase,notes,effort_weeks,dependencies,blocks,assignee,sprint,started_date,completed_date,requirement_file,external_id\n")
-	} else {
-		sb.WriteString(existingContent)
-		if !strings.HasSuffix(existingContent, "\n") {
-			sb.WriteString("\n")
+	for _, r := range requirements {
+		// Rows an earlier run created are left as they are
+		if db.Exists(r.ID) {
+			continue
+		}
+		req, err := newBootstrapRequirement(cfg, r)
+		if err != nil {
+			return err
+		}
+		if err := db.Add(req); err != nil {
+			return err
 		}
 	}
 
-	// Write new requirements
-	for _, req := range requirements {
-		// CSV escape values
-		text := strings.ReplaceAll(req.Text, `"`, `""`)
-		if strings.Contains(text, ",") || strings.Contains(text, `"`) || strings.Contains(text, "\n") {
-			text = `"` + text + `"`
-		}
+	return db.Save(dbPath)
+}
+
+// newBootstrapRequirement builds the RTM row for a discovered requirement.
+func newBootstrapRequirement(cfg *config.Config, r BootstrapRequirement) (*database.Requirement, error) {
+	req := database.NewRequirement(r.ID)
+	req.Category = r.Category
+	req.Subcategory = r.Subcategory
+	req.RequirementText = r.Text
+	req.TestModule = r.TestModule
+	req.TestFunction = r.TestFunc
+	req.ValidationMethod = "Unit Test"
+	req.Phase = 1
+	req.EffortWeeks = 0.5
+
+	status, err := database.ParseStatus(r.Status)
+	if err != nil {
+		return nil, err
+	}
+	req.Status = status
 
-		reqFile := fmt.Sprintf(".rtmx/requirements/%s/%s.md", req.Category, req.ID)
+	reqFile, err := cfg.RequirementFile(r.Category, r.ID, 1)
+	if err != nil {
+		return nil, err
+	}
+	req.RequirementFile = reqFile
 
-		sb.WriteString(fmt.Sprintf("%s,%s,%s,%s,,%s,%s,Unit Test,MISSING,MEDIUM,1,Bootstrap generated,0.5,,,,,,,%s,%s\n",
-			req.ID, req.Category, req.Subcategory, text, req.TestModule, req.TestFunc, reqFile, req.ExternalID))
+	// Link imported items under their service (e.g. github:42)
+	if r.Source != "test" {
+		req.SetExternalIDFor(r.Source, r.ExternalID)
+	} else {
+		req.ExternalID = r.ExternalID
 	}
 
-	return os.WriteFile(dbPath, []byte(sb.String()), 0644)
+	req.Notes = "Bootstrap generated"
+	if r.DuplicateOf != "" {
+		req.Notes += "; near-duplicate of " + r.DuplicateOf
+	}
+	return req, nil
 }
 
 func truncateString(s string, maxLen int) string {
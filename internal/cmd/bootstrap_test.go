@@ -7,7 +7,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/rtmx-ai/rtmx-go/internal/adapters"
 	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
 )
 
 // TestBootstrapCommand tests the bootstrap command.
@@ -198,48 +200,26 @@ func TestInferCategoryFromPath(t *testing.T) {
 func TestInferRequirementText(t *testing.T) {
 	tests := []struct {
 		name     string
-		lines    []string
-		funcLine int
+		doc      string
 		funcName string
 		expected string
 	}{
 		{
-			name: "from docstring",
-			lines: []string{
-				"def test_user_login():",
-				`    """User can log in with valid credentials."""`,
-				"    pass",
-			},
-			funcLine: 0,
+			name:     "from docstring",
+			doc:      "User can log in with valid credentials.",
 			funcName: "test_user_login",
 			expected: "User can log in with valid credentials.",
 		},
 		{
-			name: "from function name",
-			lines: []string{
-				"def test_user_can_login():",
-				"    pass",
-			},
-			funcLine: 0,
+			name:     "from function name",
 			funcName: "test_user_can_login",
 			expected: "User can login",
 		},
-		{
-			name: "single quote docstring",
-			lines: []string{
-				"def test_feature():",
-				`    '''Feature works correctly.'''`,
-				"    pass",
-			},
-			funcLine: 0,
-			funcName: "test_feature",
-			expected: "Feature works correctly.",
-		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := inferRequirementText(tt.lines, tt.funcLine, tt.funcName)
+			result := inferRequirementText(tt.doc, tt.funcName)
 			if result != tt.expected {
 				t.Errorf("inferRequirementText() = %q, want %q", result, tt.expected)
 			}
@@ -401,6 +381,7 @@ func TestBootstrapCommandFlags(t *testing.T) {
 		"from-tests",
 		"from-github",
 		"from-jira",
+		"from-markdown",
 		"merge",
 		"dry-run",
 		"prefix",
@@ -468,3 +449,283 @@ func TestBootstrapSubdirectory(t *testing.T) {
 		t.Errorf("Expected category 'CLI', got %q", reqs[0].Category)
 	}
 }
+
+// TestBootstrapFromMarkdown tests importing checklist items as requirements.
+func TestBootstrapFromMarkdown(t *testing.T) {
+	origFromTests, origFromMD := bootstrapFromTests, bootstrapFromMD
+	origMerge, origDryRun, origPrefix := bootstrapMerge, bootstrapDryRun, bootstrapPrefix
+	defer func() {
+		bootstrapFromTests, bootstrapFromMD = origFromTests, origFromMD
+		bootstrapMerge, bootstrapDryRun, bootstrapPrefix = origMerge, origDryRun, origPrefix
+	}()
+
+	tmpDir := t.TempDir()
+	spec := `# User Accounts
+
+Intro text is ignored.
+
+- [x] Users can sign up with email
+- [ ] Users can reset their password
+* [X] Users can log out
+
+## Billing
+
+- [ ] Invoices are emailed monthly
+- [x] Cards are charged on renewal
+- not a checklist item
+
+` + "```" + `
+- [ ] inside a code block
+` + "```" + `
+`
+	specPath := filepath.Join(tmpDir, "spec.md")
+	if err := os.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatalf("Failed to write spec: %v", err)
+	}
+
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	bootstrapFromTests = false
+	bootstrapFromMD = "spec.md"
+	bootstrapMerge = false
+	bootstrapPrefix = "REQ"
+
+	t.Run("dry run", func(t *testing.T) {
+		bootstrapDryRun = true
+		var buf bytes.Buffer
+		bootstrapCmd.SetOut(&buf)
+
+		if err := runBootstrap(bootstrapCmd, nil); err != nil {
+			t.Fatalf("runBootstrap failed: %v", err)
+		}
+		if !strings.Contains(buf.String(), "Found 5 checklist items") {
+			t.Errorf("unexpected output:\n%s", buf.String())
+		}
+		if _, err := os.Stat(filepath.Join(tmpDir, ".rtmx", "database.csv")); !os.IsNotExist(err) {
+			t.Error("dry run should not write the database")
+		}
+	})
+
+	t.Run("write", func(t *testing.T) {
+		bootstrapDryRun = false
+		var buf bytes.Buffer
+		bootstrapCmd.SetOut(&buf)
+
+		if err := runBootstrap(bootstrapCmd, nil); err != nil {
+			t.Fatalf("runBootstrap failed: %v", err)
+		}
+
+		db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+		if err != nil {
+			t.Fatalf("Failed to load database: %v", err)
+		}
+		if db.Len() != 5 {
+			t.Fatalf("Expected 5 requirements, got %d", db.Len())
+		}
+
+		want := []struct {
+			id, category, text string
+			status             database.Status
+		}{
+			{"REQ-USER_ACCOUNTS-001", "USER_ACCOUNTS", "Users can sign up with email", database.StatusComplete},
+			{"REQ-USER_ACCOUNTS-002", "USER_ACCOUNTS", "Users can reset their password", database.StatusMissing},
+			{"REQ-USER_ACCOUNTS-003", "USER_ACCOUNTS", "Users can log out", database.StatusComplete},
+			{"REQ-BILLING-001", "BILLING", "Invoices are emailed monthly", database.StatusMissing},
+			{"REQ-BILLING-002", "BILLING", "Cards are charged on renewal", database.StatusComplete},
+		}
+		for _, w := range want {
+			req := db.Get(w.id)
+			if req == nil {
+				t.Errorf("missing requirement %s", w.id)
+				continue
+			}
+			if req.Category != w.category || req.RequirementText != w.text || req.Status != w.status {
+				t.Errorf("%s = {%s, %q, %s}, want {%s, %q, %s}",
+					w.id, req.Category, req.RequirementText, req.Status, w.category, w.text, w.status)
+			}
+		}
+	})
+}
+
+func TestBootstrapFromMarkdownMissingFile(t *testing.T) {
+	if _, err := bootstrapFromMarkdownFile(filepath.Join(t.TempDir(), "missing.md"), "REQ", config.DefaultIDPadWidth); err == nil {
+		t.Error("Expected error for missing Markdown file")
+	}
+}
+
+func TestParseMarkdownChecklistPadWidth(t *testing.T) {
+	reqs := parseMarkdownChecklist("## Billing\n\n- [ ] Invoices are emailed\n- [x] Cards are charged\n", "REQ", 4)
+	if len(reqs) != 2 || reqs[0].ID != "REQ-BILLING-0001" || reqs[1].ID != "REQ-BILLING-0002" {
+		t.Errorf("Expected four-digit IDs, got %+v", reqs)
+	}
+}
+
+func TestBootstrapMergeNearDuplicates(t *testing.T) {
+	origFromTests, origMerge, origDryRun, origPrefix, origOnDup := bootstrapFromTests, bootstrapMerge, bootstrapDryRun, bootstrapPrefix, bootstrapOnDup
+	defer func() {
+		bootstrapFromTests, bootstrapMerge, bootstrapDryRun, bootstrapPrefix, bootstrapOnDup = origFromTests, origMerge, origDryRun, origPrefix, origOnDup
+	}()
+
+	tests := []struct {
+		mode      string
+		wantAdded bool
+		wantNote  string
+	}{
+		{mode: "skip", wantAdded: false},
+		{mode: "link", wantAdded: true, wantNote: "near-duplicate of REQ-GH-001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			tmpDir := setupTestProject(t)
+			dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+			db := database.NewDatabase()
+			imported := database.NewRequirement("REQ-GH-001")
+			imported.Category = "AUTH"
+			imported.RequirementText = "User can login with a password"
+			imported.SetExternalIDFor("github", "12")
+			_ = db.Add(imported)
+			if err := db.Save(dbPath); err != nil {
+				t.Fatalf("Failed to save database: %v", err)
+			}
+
+			if err := os.MkdirAll(filepath.Join(tmpDir, "tests"), 0755); err != nil {
+				t.Fatalf("Failed to create tests dir: %v", err)
+			}
+			testFile := "def test_user_can_login_with_password():\n    pass\n\ndef test_session_expires_after_timeout():\n    pass\n"
+			if err := os.WriteFile(filepath.Join(tmpDir, "tests", "test_auth.py"), []byte(testFile), 0644); err != nil {
+				t.Fatalf("Failed to write test file: %v", err)
+			}
+
+			bootstrapFromTests, bootstrapMerge, bootstrapDryRun, bootstrapPrefix, bootstrapOnDup = true, true, false, "REQ", tt.mode
+
+			var buf bytes.Buffer
+			bootstrapCmd.SetOut(&buf)
+			if err := runBootstrap(bootstrapCmd, nil); err != nil {
+				t.Fatalf("bootstrap failed: %v\n%s", err, buf.String())
+			}
+			out := buf.String()
+			if !strings.Contains(out, "Near-duplicates of existing requirements (1)") || !strings.Contains(out, "REQ-AUTH-001 ≈ REQ-GH-001") {
+				t.Errorf("Expected the overlap to be reported, got:\n%s", out)
+			}
+
+			merged, err := database.Load(dbPath)
+			if err != nil {
+				t.Fatalf("Failed to load merged database: %v", err)
+			}
+			if merged.Get("REQ-AUTH-002") == nil {
+				t.Error("Expected the unrelated test requirement to be added")
+			}
+			dup := merged.Get("REQ-AUTH-001")
+			if (dup != nil) != tt.wantAdded {
+				t.Fatalf("near-duplicate added = %v, want %v", dup != nil, tt.wantAdded)
+			}
+			if dup != nil && !strings.Contains(dup.Notes, tt.wantNote) {
+				t.Errorf("notes = %q, want them to contain %q", dup.Notes, tt.wantNote)
+			}
+		})
+	}
+}
+
+func TestBootstrapFromGitHubScaffoldsSpecs(t *testing.T) {
+	origFromTests, origFromGitHub, origScaffold := bootstrapFromTests, bootstrapFromGitHub, bootstrapScaffold
+	origMerge, origDryRun, origPrefix := bootstrapMerge, bootstrapDryRun, bootstrapPrefix
+	origAdapter := bootstrapAdapter
+	defer func() {
+		bootstrapFromTests, bootstrapFromGitHub, bootstrapScaffold = origFromTests, origFromGitHub, origScaffold
+		bootstrapMerge, bootstrapDryRun, bootstrapPrefix = origMerge, origDryRun, origPrefix
+		bootstrapAdapter = origAdapter
+	}()
+
+	adapter := newMockSyncAdapter("github")
+	adapter.addItem("7", "open", "")
+	body := "Users sign in with a passkey.\n\nPasswords stay available as a fallback\nfor older devices."
+	adapter.items["7"].Title = "Passkey sign-in"
+	adapter.items["7"].Description = body
+	adapter.addItem("8", "open", "REQ-AUTH-001")
+	bootstrapAdapter = func(service string, cfg *config.Config, opts ...adapters.AdapterOption) (adapters.ServiceAdapter, error) {
+		return adapter, nil
+	}
+
+	tmpDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	bootstrapFromTests, bootstrapFromGitHub, bootstrapScaffold = false, true, true
+	bootstrapMerge, bootstrapDryRun, bootstrapPrefix = false, false, "REQ"
+	var buf bytes.Buffer
+	bootstrapCmd.SetOut(&buf)
+	defer bootstrapCmd.SetOut(nil)
+	if err := runBootstrap(bootstrapCmd, nil); err != nil {
+		t.Fatalf("runBootstrap failed: %v", err)
+	}
+
+	db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	// The item already linked to a requirement is left to sync
+	if db.Len() != 1 {
+		t.Fatalf("Expected 1 requirement, got %d:\n%s", db.Len(), buf.String())
+	}
+	req := db.Get("REQ-GITHUB-001")
+	if req == nil || req.RequirementText != "Passkey sign-in" || req.ExternalIDFor("github") != "7" {
+		t.Fatalf("Expected REQ-GITHUB-001 linked to github:7, got %+v", req)
+	}
+
+	wantFile := ".rtmx/requirements/GITHUB/REQ-GITHUB-001.md"
+	if req.RequirementFile != wantFile {
+		t.Errorf("RequirementFile = %q, want %q", req.RequirementFile, wantFile)
+	}
+	spec, err := os.ReadFile(filepath.Join(tmpDir, filepath.FromSlash(wantFile)))
+	if err != nil {
+		t.Fatalf("Expected a spec file: %v", err)
+	}
+	if !strings.Contains(string(spec), body+"\n") {
+		t.Errorf("Expected the spec to hold the issue body, got:\n%s", spec)
+	}
+}
+
+func TestBootstrapMergeTwice(t *testing.T) {
+	origFromTests, origFromMD := bootstrapFromTests, bootstrapFromMD
+	origMerge, origDryRun, origPrefix := bootstrapMerge, bootstrapDryRun, bootstrapPrefix
+	defer func() {
+		bootstrapFromTests, bootstrapFromMD = origFromTests, origFromMD
+		bootstrapMerge, bootstrapDryRun, bootstrapPrefix = origMerge, origDryRun, origPrefix
+	}()
+
+	tmpDir := setupTestProject(t, "REQ-AUTH-001")
+	spec := "# Auth\n\n- [x] Requirement REQ-AUTH-001\n- [ ] Users can reset their password\n- [ ] Sessions expire after an hour\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, "spec.md"), []byte(spec), 0644); err != nil {
+		t.Fatalf("Failed to write spec: %v", err)
+	}
+
+	bootstrapFromTests, bootstrapFromMD = false, "spec.md"
+	bootstrapMerge, bootstrapDryRun, bootstrapPrefix = true, false, "REQ"
+	bootstrapCmd.SetOut(new(bytes.Buffer))
+	defer bootstrapCmd.SetOut(nil)
+
+	for run := 1; run <= 2; run++ {
+		if err := runBootstrap(bootstrapCmd, nil); err != nil {
+			t.Fatalf("run %d: runBootstrap failed: %v", run, err)
+		}
+		db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+		if err != nil {
+			t.Fatalf("Failed to load database: %v", err)
+		}
+		// The checklist item matching REQ-AUTH-001 is not added again, and
+		// new items are numbered after it
+		if db.Len() != 3 {
+			t.Fatalf("run %d: expected 3 requirements, got %d", run, db.Len())
+		}
+		if got := db.Get("REQ-AUTH-002"); got == nil || got.RequirementText != "Users can reset their password" {
+			t.Errorf("run %d: REQ-AUTH-002 = %+v, want the password reset item", run, got)
+		}
+		if got := db.Get("REQ-AUTH-003"); got == nil || got.RequirementText != "Sessions expire after an hour" {
+			t.Errorf("run %d: REQ-AUTH-003 = %+v, want the session item", run, got)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	changelogSinceTag bool
+	changelogSince    string
+	changelogOutput   string
+)
+
+var changelogCmd = &cobra.Command{
+	Use:   "changelog",
+	Short: "Summarize requirement changes since a release tag",
+	Long: `Write a Markdown changelog section of the requirement changes between a
+git tag and HEAD.
+
+The RTM database is read as committed at the tag and at HEAD, so
+uncommitted changes are not included. Added, completed, changed and
+removed requirements are listed by category.
+
+Use --since-tag for the most recent tag reachable from HEAD, or --since
+to name the tag (or any revision).
+
+Examples:
+    rtmx changelog --since-tag
+    rtmx changelog --since v1.2.0
+    rtmx changelog --since-tag -o CHANGELOG-rtm.md`,
+	Args: cobra.NoArgs,
+	RunE: runChangelog,
+}
+
+func init() {
+	changelogCmd.Flags().BoolVar(&changelogSinceTag, "since-tag", false, "compare with the most recent tag")
+	changelogCmd.Flags().StringVar(&changelogSince, "since", "", "compare with this tag or revision")
+	changelogCmd.Flags().StringVarP(&changelogOutput, "output", "o", "", "output file")
+
+	rootCmd.AddCommand(changelogCmd)
+}
+
+func runChangelog(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	if changelogSinceTag == (changelogSince != "") {
+		return NewValidationError("specify one of --since-tag or --since TAG")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	since := changelogSince
+	if changelogSinceTag {
+		if since, err = lastGitTag(cwd); err != nil {
+			return err
+		}
+	}
+
+	relPath, err := filepath.Rel(cwd, cfg.DatabasePath(cwd))
+	if err != nil {
+		return fmt.Errorf("failed to resolve database path: %w", err)
+	}
+	baseline, err := loadDatabaseAt(cwd, since, relPath)
+	if err != nil {
+		return err
+	}
+	current, err := loadDatabaseAt(cwd, "HEAD", relPath)
+	if err != nil {
+		return err
+	}
+
+	content := formatChangelog(since, baseline, current, compareDatabases(baseline, current))
+
+	if changelogOutput != "" {
+		if err := os.WriteFile(changelogOutput, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+		cmd.Printf("%s Written to %s\n", output.Color("✓", output.Green), changelogOutput)
+		return nil
+	}
+	cmd.Print(content)
+	return nil
+}
+
+// lastGitTag returns the most recent tag reachable from HEAD in dir.
+func lastGitTag(dir string) (string, error) {
+	gitCmd := exec.Command("git", "describe", "--tags", "--abbrev=0", "HEAD")
+	gitCmd.Dir = dir
+	out, err := gitCmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the last tag (is HEAD tagged or preceded by a tag?): %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// loadDatabaseAt reads the database at path, relative to dir, as committed
+// at rev.
+func loadDatabaseAt(dir, rev, path string) (*database.Database, error) {
+	gitCmd := exec.Command("git", "show", rev+":./"+filepath.ToSlash(path))
+	gitCmd.Dir = dir
+	out, err := gitCmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s at %s: %w", path, rev, err)
+	}
+	db, err := database.ReadCSV(bytes.NewReader(out))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s at %s: %w", path, rev, err)
+	}
+	return db, nil
+}
+
+// changelogEntry is one line of a category's changelog.
+type changelogEntry struct {
+	order int
+	line  string
+}
+
+// formatChangelog renders the changes since rev as a Markdown section with
+// a subsection per category. Within a category, completed requirements come
+// first, then added, changed and removed ones.
+func formatChangelog(rev string, baseline, current *database.Database, result *DiffResult) string {
+	byCategory := make(map[string][]changelogEntry)
+	var categories []string
+	add := func(req *database.Requirement, order int, line string) {
+		cat := req.Category
+		if cat == "" {
+			cat = "Uncategorized"
+		}
+		if _, ok := byCategory[cat]; !ok {
+			categories = append(categories, cat)
+		}
+		byCategory[cat] = append(byCategory[cat], changelogEntry{order, line})
+	}
+
+	completed := 0
+	for _, c := range result.Changed {
+		req := current.Get(c.ReqID)
+		if c.Field == "status" && c.NewValue == database.StatusComplete.String() {
+			completed++
+			add(req, 0, fmt.Sprintf("Completed %s: %s", c.ReqID, req.RequirementText))
+			continue
+		}
+		add(req, 2, fmt.Sprintf("%s: %s %s → %s", c.ReqID, c.Field, c.OldValue, c.NewValue))
+	}
+	for _, id := range result.Added {
+		req := current.Get(id)
+		add(req, 1, fmt.Sprintf("Added %s: %s", id, req.RequirementText))
+	}
+	for _, id := range result.Removed {
+		req := baseline.Get(id)
+		add(req, 3, fmt.Sprintf("Removed %s: %s", id, req.RequirementText))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("## Requirements since %s\n\n", rev))
+	if len(categories) == 0 {
+		sb.WriteString("No requirement changes.\n")
+		return sb.String()
+	}
+
+	sort.Strings(categories)
+	for _, cat := range categories {
+		entries := byCategory[cat]
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].order < entries[j].order })
+		sb.WriteString(fmt.Sprintf("### %s\n\n", cat))
+		for _, e := range entries {
+			sb.WriteString("- " + e.line + "\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("Completion: %.1f%% → %.1f%% (%d completed, %d added, %d removed)\n",
+		result.Baseline.Completion, result.Current.Completion,
+		completed, len(result.Added), len(result.Removed)))
+	return sb.String()
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+func TestChangelogSinceTag(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	origSinceTag, origSince, origOutput := changelogSinceTag, changelogSince, changelogOutput
+	defer func() { changelogSinceTag, changelogSince, changelogOutput = origSinceTag, origSince, origOutput }()
+
+	tmpDir := setupTestProject(t, "REQ-AUTH-001", "REQ-AUTH-002")
+	git := func(args ...string) {
+		c := exec.Command("git", args...)
+		c.Dir = tmpDir
+		c.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com")
+		if out, err := c.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+	git("init", "-q")
+	git("add", "-A")
+	git("commit", "-q", "-m", "Baseline")
+	git("tag", "v1.0.0")
+
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	db, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	db.Get("REQ-AUTH-001").Status = database.StatusComplete
+	db.Get("REQ-AUTH-002").Status = database.StatusPartial
+	added := database.NewRequirement("REQ-API-001")
+	added.Category = "API"
+	added.RequirementText = "Expose a REST API"
+	_ = db.Add(added)
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+	git("commit", "-q", "-am", "Progress")
+
+	tests := []struct {
+		name     string
+		sinceTag bool
+		since    string
+	}{
+		{name: "last tag", sinceTag: true},
+		{name: "explicit tag", since: "v1.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changelogSinceTag, changelogSince, changelogOutput = tt.sinceTag, tt.since, ""
+
+			var buf bytes.Buffer
+			changelogCmd.SetOut(&buf)
+			if err := runChangelog(changelogCmd, nil); err != nil {
+				t.Fatalf("changelog failed: %v", err)
+			}
+			out := buf.String()
+
+			for _, want := range []string{
+				"## Requirements since v1.0.0",
+				"### AUTH\n\n- Completed REQ-AUTH-001: Requirement REQ-AUTH-001\n- REQ-AUTH-002: status MISSING → PARTIAL\n",
+				"### API\n\n- Added REQ-API-001: Expose a REST API\n",
+				"(1 completed, 1 added, 0 removed)",
+			} {
+				if !strings.Contains(out, want) {
+					t.Errorf("Expected changelog to contain %q, got:\n%s", want, out)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var commandsFormat string
+
+var commandsCmd = &cobra.Command{
+	Use:   "commands",
+	Short: "List every command and its flags",
+	Long: `List every rtmx command with its description, arguments and flags.
+
+With --format json the command tree is printed as a manifest for tools
+that generate wrappers or agent bindings: each command's full name,
+description, argument spec (from its usage line) and flags with their
+type, default and help text. Flags available on every command are listed
+once under global_flags.
+
+Examples:
+    rtmx commands
+    rtmx commands --format json`,
+	Args: cobra.NoArgs,
+	RunE: runCommands,
+}
+
+func init() {
+	commandsCmd.Flags().StringVar(&commandsFormat, "format", "terminal", "output format: terminal, json")
+	rootCmd.AddCommand(commandsCmd)
+}
+
+// CommandManifest describes the rtmx command tree.
+type CommandManifest struct {
+	Name        string        `json:"name"`
+	Version     string        `json:"version"`
+	GlobalFlags []FlagInfo    `json:"global_flags"`
+	Commands    []CommandInfo `json:"commands"`
+}
+
+// CommandInfo describes one command. Name is the full command path
+// below rtmx, e.g. "docs schema".
+type CommandInfo struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Args        string     `json:"args"`
+	Aliases     []string   `json:"aliases,omitempty"`
+	Flags       []FlagInfo `json:"flags"`
+}
+
+// FlagInfo describes one flag.
+type FlagInfo struct {
+	Name      string `json:"name"`
+	Shorthand string `json:"shorthand,omitempty"`
+	Type      string `json:"type"`
+	Default   string `json:"default"`
+	Help      string `json:"help"`
+}
+
+func runCommands(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	manifest := buildCommandManifest(cmd.Root())
+
+	switch commandsFormat {
+	case "json":
+		data, err := json.MarshalIndent(manifest, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal manifest: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	case "terminal":
+	default:
+		return NewValidationError(fmt.Sprintf("invalid format %q (use terminal or json)", commandsFormat))
+	}
+
+	defer startPager(cmd)()
+	width := 0
+	for _, c := range manifest.Commands {
+		if n := len(c.Name) + len(c.Args) + 1; n > width {
+			width = n
+		}
+	}
+	for _, c := range manifest.Commands {
+		usage := strings.TrimSpace(c.Name + " " + c.Args)
+		cmd.Printf("  %s%s  %s\n", output.Color(usage, output.Cyan), strings.Repeat(" ", width-len(usage)), c.Description)
+	}
+	return nil
+}
+
+// buildCommandManifest walks the command tree under root, skipping hidden
+// commands and help. Commands are in the order cobra lists them.
+func buildCommandManifest(root *cobra.Command) CommandManifest {
+	manifest := CommandManifest{
+		Name:        root.Name(),
+		Version:     root.Version,
+		GlobalFlags: flagInfos(root.PersistentFlags()),
+		Commands:    []CommandInfo{},
+	}
+
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		for _, sub := range c.Commands() {
+			if sub.Hidden || sub.Name() == "help" {
+				continue
+			}
+			manifest.Commands = append(manifest.Commands, CommandInfo{
+				Name:        strings.TrimPrefix(sub.CommandPath(), root.Name()+" "),
+				Description: sub.Short,
+				Args:        commandArgs(sub),
+				Aliases:     sub.Aliases,
+				Flags:       flagInfos(sub.LocalFlags()),
+			})
+			walk(sub)
+		}
+	}
+	walk(root)
+	return manifest
+}
+
+// commandArgs returns the argument part of a command's usage line, e.g.
+// "REQ-ID" for "trace REQ-ID".
+func commandArgs(c *cobra.Command) string {
+	fields := strings.Fields(c.Use)
+	if len(fields) < 2 {
+		return ""
+	}
+	return strings.Join(fields[1:], " ")
+}
+
+// flagInfos describes the visible flags in fs, sorted by name.
+func flagInfos(fs *pflag.FlagSet) []FlagInfo {
+	flags := []FlagInfo{}
+	fs.VisitAll(func(f *pflag.Flag) {
+		if f.Hidden {
+			return
+		}
+		flags = append(flags, FlagInfo{
+			Name:      f.Name,
+			Shorthand: f.Shorthand,
+			Type:      f.Value.Type(),
+			Default:   f.DefValue,
+			Help:      f.Usage,
+		})
+	})
+	return flags
+}
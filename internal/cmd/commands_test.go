@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestCommandsJSON(t *testing.T) {
+	origFormat := commandsFormat
+	defer func() { commandsFormat = origFormat }()
+	commandsFormat = "json"
+
+	var buf bytes.Buffer
+	commandsCmd.SetOut(&buf)
+	defer commandsCmd.SetOut(nil)
+	if err := runCommands(commandsCmd, nil); err != nil {
+		t.Fatalf("commands failed: %v", err)
+	}
+
+	var manifest CommandManifest
+	if err := json.Unmarshal(buf.Bytes(), &manifest); err != nil {
+		t.Fatalf("Failed to parse manifest: %v\n%s", err, buf.String())
+	}
+
+	commands := make(map[string]CommandInfo)
+	for _, c := range manifest.Commands {
+		commands[c.Name] = c
+	}
+	flagsOf := func(name string) map[string]FlagInfo {
+		c, ok := commands[name]
+		if !ok {
+			t.Fatalf("Expected command %q in manifest", name)
+		}
+		flags := make(map[string]FlagInfo)
+		for _, f := range c.Flags {
+			flags[f.Name] = f
+		}
+		return flags
+	}
+
+	sync := flagsOf("sync")
+	if f := sync["service"]; f.Type != "string" || f.Default != "github" || f.Shorthand != "s" || f.Help == "" {
+		t.Errorf("sync --service = %+v", f)
+	}
+	if f := sync["dry-run"]; f.Type != "bool" || f.Default != "false" {
+		t.Errorf("sync --dry-run = %+v", f)
+	}
+
+	backlog := flagsOf("backlog")
+	if _, ok := backlog["view"]; !ok {
+		t.Errorf("Expected backlog --view, got %v", commands["backlog"].Flags)
+	}
+
+	if commands["trace"].Args != "REQ-ID" {
+		t.Errorf("trace args = %q, want REQ-ID", commands["trace"].Args)
+	}
+	if _, ok := commands["docs schema"]; !ok {
+		t.Error("Expected nested command \"docs schema\" in manifest")
+	}
+
+	global := make(map[string]bool)
+	for _, f := range manifest.GlobalFlags {
+		global[f.Name] = true
+	}
+	if !global["no-color"] || !global["config"] {
+		t.Errorf("global flags = %v, want no-color and config", manifest.GlobalFlags)
+	}
+}
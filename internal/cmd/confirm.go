@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+var (
+	// assumeYes skips confirmation prompts (--yes).
+	assumeYes bool
+
+	// confirmReader supplies answers to confirmation prompts.
+	confirmReader io.Reader = os.Stdin
+
+	// confirmInteractive reports whether the user can answer a prompt.
+	confirmInteractive = stdinIsTerminal
+)
+
+// errAborted is returned when the user declines, or cannot be asked to
+// confirm, a change.
+var errAborted = NewExitError(1, "aborted, no changes made")
+
+// confirmChanges prints the planned changes and asks whether to apply them.
+//
+// With --yes it returns true without prompting. When stdin is not a
+// terminal and --yes was not given it refuses, so scripts never apply
+// changes they did not explicitly approve.
+func confirmChanges(w io.Writer, summary []string) bool {
+	if assumeYes {
+		return true
+	}
+
+	if len(summary) > 0 {
+		fmt.Fprintln(w, "Planned changes:")
+		for _, line := range summary {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if !confirmInteractive() {
+		fmt.Fprintln(w, "Not running interactively; use --yes to apply these changes.")
+		return false
+	}
+
+	fmt.Fprint(w, "Proceed? [y/N] ")
+	answer, _ := bufio.NewReader(confirmReader).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// stdinIsTerminal reports whether stdin is attached to a terminal.
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+func withConfirmInput(t *testing.T, input string, interactive, yes bool) {
+	t.Helper()
+	origReader, origInteractive, origYes := confirmReader, confirmInteractive, assumeYes
+	t.Cleanup(func() {
+		confirmReader, confirmInteractive, assumeYes = origReader, origInteractive, origYes
+	})
+	confirmReader = strings.NewReader(input)
+	confirmInteractive = func() bool { return interactive }
+	assumeYes = yes
+}
+
+func TestRmConfirmation(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		interactive bool
+		yes         bool
+		wantRemoved bool
+		wantPrompt  bool
+	}{
+		{"declined", "n\n", true, false, false, true},
+		{"empty answer defaults to no", "\n", true, false, false, true},
+		{"accepted", "y\n", true, false, true, true},
+		{"--yes skips prompt", "", true, true, true, false},
+		{"non-interactive aborts", "y\n", false, false, false, false},
+		{"non-interactive with --yes", "", false, true, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := setupTestProject(t, "REQ-OLD-001", "REQ-KEEP-001")
+			updateTestDatabase(t, tmpDir, func(db *database.Database) {
+				db.Get("REQ-KEEP-001").Dependencies.Add("REQ-OLD-001")
+			})
+
+			withConfirmInput(t, tt.input, tt.interactive, tt.yes)
+
+			var buf bytes.Buffer
+			rmCmd.SetOut(&buf)
+			err := runRm(rmCmd, []string{"REQ-OLD-001"})
+			if tt.wantRemoved && err != nil {
+				t.Fatalf("rm failed: %v", err)
+			}
+			if !tt.wantRemoved && err == nil {
+				t.Fatal("Expected rm to abort")
+			}
+
+			out := buf.String()
+			if got := strings.Contains(out, "Proceed?"); got != tt.wantPrompt {
+				t.Errorf("Prompted = %v, want %v; output:\n%s", got, tt.wantPrompt, out)
+			}
+			if !tt.yes && !strings.Contains(out, "drop reference to REQ-OLD-001 from REQ-KEEP-001") {
+				t.Errorf("Expected planned changes in output:\n%s", out)
+			}
+
+			db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+			if err != nil {
+				t.Fatalf("Failed to load database: %v", err)
+			}
+			if removed := !db.Exists("REQ-OLD-001"); removed != tt.wantRemoved {
+				t.Errorf("Removed = %v, want %v", removed, tt.wantRemoved)
+			}
+			if hasDep := db.Get("REQ-KEEP-001").Dependencies.Contains("REQ-OLD-001"); hasDep == tt.wantRemoved {
+				t.Errorf("Dependency on removed requirement kept = %v, want %v", hasDep, !tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestSyncResultPlannedChanges(t *testing.T) {
+	result := &SyncResult{Created: []string{"REQ-A"}, Updated: []string{"REQ-B"}, Skipped: []string{"REQ-C"}}
+	got := strings.Join(result.PlannedChanges(), ",")
+	if got != "create REQ-A,update REQ-B" {
+		t.Errorf("PlannedChanges = %q", got)
+	}
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	convertFrom string
+	convertTo   string
+)
+
+var convertCmd = &cobra.Command{
+	Use:   "convert INPUT OUTPUT",
+	Short: "Convert an RTM between CSV, JSON and YAML",
+	Long: `Convert an RTM database from one file format to another in one step.
+
+The formats are inferred from the file extensions (.csv, .json, .yaml or
+.yml). Use --from and --to when an extension is missing or ambiguous.
+
+JSON and YAML files hold a list with one object per requirement, keyed by
+the CSV column names; empty fields are left out. The input must parse as a
+valid RTM before anything is written.
+
+Examples:
+    rtmx convert docs/rtm_database.csv rtm.json
+    rtmx convert rtm.yaml docs/rtm_database.csv
+    rtmx convert export.txt rtm.json --from csv`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConvert,
+}
+
+func init() {
+	convertCmd.Flags().StringVar(&convertFrom, "from", "", "input format: csv, json, yaml (default: from the extension)")
+	convertCmd.Flags().StringVar(&convertTo, "to", "", "output format: csv, json, yaml (default: from the extension)")
+	rootCmd.AddCommand(convertCmd)
+}
+
+func runConvert(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	input, out := args[0], args[1]
+	from, err := convertFormat(convertFrom, input)
+	if err != nil {
+		return NewValidationError(err.Error())
+	}
+	to, err := convertFormat(convertTo, out)
+	if err != nil {
+		return NewValidationError(err.Error())
+	}
+
+	db, err := database.LoadFormat(input, from)
+	if err != nil {
+		return databaseLoadError(err)
+	}
+	if err := db.SaveFormat(out, to); err != nil {
+		return databaseSaveError(err)
+	}
+
+	cmd.Printf("%s Converted %d requirements from %s to %s: %s\n",
+		output.Color("✓", output.Green), db.Len(), from, to, out)
+	return nil
+}
+
+// convertFormat returns the format named by flag, or the one inferred from
+// path's extension when flag is empty.
+func convertFormat(flag, path string) (database.Format, error) {
+	if flag != "" {
+		return database.ParseFormat(flag)
+	}
+	return database.FormatFromPath(path)
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+func TestConvertCSVJSONRoundTrip(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	db := database.NewDatabase()
+	req := database.NewRequirement("REQ-CONV-001")
+	req.Category = "CONV"
+	req.RequirementText = "Convert, with \"quotes\"\nand a newline"
+	req.Status = database.StatusPartial
+	req.Priority = database.PriorityHigh
+	req.Phase = 2
+	req.EffortWeeks = 1.5
+	req.Dependencies = database.NewStringSet("REQ-CONV-002")
+	req.Release = "v1.0"
+	req.SetExternalIDFor("github", "42")
+	req.Extra = map[string]string{"owner_team": "platform"}
+	_ = db.Add(req)
+	other := database.NewRequirement("REQ-CONV-002")
+	other.Category = "CONV"
+	other.RequirementText = "Second"
+	other.Blocks = database.NewStringSet("REQ-CONV-001")
+	_ = db.Add(other)
+
+	csvPath := filepath.Join(tmpDir, "rtm.csv")
+	if err := db.Save(csvPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+	want, _ := os.ReadFile(csvPath)
+
+	run := func(args ...string) error {
+		var buf bytes.Buffer
+		rootCmd.SetOut(&buf)
+		rootCmd.SetArgs(append([]string{"convert"}, args...))
+		defer func() {
+			rootCmd.SetOut(nil)
+			convertFrom, convertTo = "", ""
+		}()
+		return rootCmd.Execute()
+	}
+
+	jsonPath := filepath.Join(tmpDir, "rtm.json")
+	if err := run(csvPath, jsonPath); err != nil {
+		t.Fatalf("convert CSV to JSON failed: %v", err)
+	}
+	converted, err := database.LoadFormat(jsonPath, database.FormatJSON)
+	if err != nil {
+		t.Fatalf("Failed to load converted JSON: %v", err)
+	}
+	got := converted.Get("REQ-CONV-001")
+	if got == nil {
+		t.Fatal("Expected REQ-CONV-001 in the JSON output")
+	}
+	if got.RequirementText != req.RequirementText || got.Status != req.Status || got.Phase != 2 ||
+		got.EffortWeeks != 1.5 || got.ExternalIDFor("github") != "42" || got.Extra["owner_team"] != "platform" ||
+		!got.Dependencies.Contains("REQ-CONV-002") {
+		t.Errorf("JSON requirement = %+v, want the fields of %+v", got, req)
+	}
+
+	backPath := filepath.Join(tmpDir, "back.txt")
+	if err := run(jsonPath, backPath, "--to", "csv"); err != nil {
+		t.Fatalf("convert JSON to CSV failed: %v", err)
+	}
+	back, _ := os.ReadFile(backPath)
+	if string(back) != string(want) {
+		t.Errorf("CSV after round trip differs:\n got: %s\nwant: %s", back, want)
+	}
+}
+
+func TestConvertUnsupportedFormat(t *testing.T) {
+	tmpDir := t.TempDir()
+	csvPath := filepath.Join(tmpDir, "rtm.csv")
+	db := database.NewDatabase()
+	_ = db.Add(database.NewRequirement("REQ-CONV-001"))
+	if err := db.Save(csvPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	var buf bytes.Buffer
+	rootCmd.SetOut(&buf)
+	rootCmd.SetErr(&buf)
+	rootCmd.SetArgs([]string{"convert", csvPath, filepath.Join(tmpDir, "rtm.xlsx")})
+	defer func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+	}()
+
+	err := rootCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "unsupported format") {
+		t.Fatalf("Expected an unsupported format error, got %v", err)
+	}
+	if _, statErr := os.Stat(filepath.Join(tmpDir, "rtm.xlsx")); !os.IsNotExist(statErr) {
+		t.Error("Expected no output file for an unsupported format")
+	}
+}
@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/markers"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	coverageImpl   bool
+	coverageFormat string
+)
+
+var coverageCmd = &cobra.Command{
+	Use:   "coverage",
+	Short: "Report which requirements are tested and implemented",
+	Long: `Report the requirements that have no linked test.
+
+With --impl, non-test source is also scanned for implementation markers
+such as "// implements REQ-AUTH-001", and the report lists requirements
+that are tested but have no implementation marker, requirements with an
+implementation marker but no test, and markers naming requirements that
+are not in the RTM.
+
+The marker pattern and the file extensions scanned are set under
+markers.implementation in rtmx.yaml.
+
+Examples:
+    rtmx coverage
+    rtmx coverage --impl
+    rtmx coverage --impl --format json`,
+	RunE: runCoverage,
+}
+
+func init() {
+	coverageCmd.Flags().BoolVar(&coverageImpl, "impl", false, "compare tests with implementation markers in source")
+	coverageCmd.Flags().StringVar(&coverageFormat, "format", "terminal", "output format: terminal, json")
+	rootCmd.AddCommand(coverageCmd)
+}
+
+// CoverageReport is the result of rtmx coverage.
+type CoverageReport struct {
+	Total    int      `json:"total"`
+	Tested   int      `json:"tested"`
+	Untested []string `json:"untested"`
+
+	// Implementation is set with --impl.
+	Implementation *ImplementationCoverage `json:"implementation,omitempty"`
+}
+
+// ImplementationCoverage compares tests with implementation markers.
+type ImplementationCoverage struct {
+	// Implemented maps each requirement with implementation markers to
+	// their "file:line" locations.
+	Implemented          map[string][]string `json:"implemented"`
+	TestedNotImplemented []string            `json:"tested_not_implemented"`
+	ImplementedNotTested []string            `json:"implemented_not_tested"`
+	UnknownRequirements  []string            `json:"unknown_requirements"`
+}
+
+func runCoverage(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	if coverageFormat != "terminal" && coverageFormat != "json" {
+		return NewValidationError(fmt.Sprintf("invalid format %q (use terminal or json)", coverageFormat))
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	db, err := database.Load(cfg.DatabasePath(cwd))
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	var impl map[string][]markers.Marker
+	if coverageImpl {
+		impl, err = implementationMarkers(cwd, cfg)
+		if err != nil {
+			return err
+		}
+	}
+	report := buildCoverageReport(db, impl, coverageImpl)
+
+	if coverageFormat == "json" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal report: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	}
+
+	defer startPager(cmd)()
+	formatCoverageTerminal(cmd, report)
+	return nil
+}
+
+// implementationMarkers scans the non-test source under root, or under
+// the configured source directories within it, for the implementation
+// markers configured in cfg, grouped by requirement.
+func implementationMarkers(root string, cfg *config.Config) (map[string][]markers.Marker, error) {
+	extractor, err := markers.ForConfig(cfg)
+	if err != nil {
+		return nil, NewTypedError(ErrorTypeConfig, "invalid marker rules", err)
+	}
+	scanner, err := markers.NewImplementationScanner(cfg.RTMX.Markers.Implementation, cfg.IDPrefix())
+	if err != nil {
+		return nil, NewTypedError(ErrorTypeConfig, "invalid implementation markers", err)
+	}
+
+	dirs := cfg.RTMX.Markers.Implementation.Dirs
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+	var found []markers.Marker
+	for _, dir := range dirs {
+		dirPath := filepath.Join(root, dir)
+		if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+			continue
+		}
+		dirMarkers, err := scanner.ScanDir(dirPath, extractor.IsTestFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan source: %w", err)
+		}
+		// Marker paths are relative to the project root
+		for i := range dirMarkers {
+			dirMarkers[i].File = path.Join(filepath.ToSlash(filepath.Clean(dir)), dirMarkers[i].File)
+		}
+		found = append(found, dirMarkers...)
+	}
+	return markers.ByRequirement(found), nil
+}
+
+// buildCoverageReport reports the requirements in db without tests and,
+// when withImpl is set, compares them with the implementation markers in
+// impl.
+func buildCoverageReport(db *database.Database, impl map[string][]markers.Marker, withImpl bool) *CoverageReport {
+	report := &CoverageReport{Total: db.Len(), Untested: []string{}}
+	for _, req := range db.All() {
+		if req.HasTest() {
+			report.Tested++
+		} else {
+			report.Untested = append(report.Untested, req.ReqID)
+		}
+	}
+	if !withImpl {
+		return report
+	}
+
+	ic := &ImplementationCoverage{
+		Implemented:          make(map[string][]string),
+		TestedNotImplemented: []string{},
+		ImplementedNotTested: []string{},
+		UnknownRequirements:  []string{},
+	}
+	for _, req := range db.All() {
+		found := impl[req.ReqID]
+		if len(found) > 0 {
+			ic.Implemented[req.ReqID] = markers.Locations(found)
+		}
+		switch {
+		case req.HasTest() && len(found) == 0:
+			ic.TestedNotImplemented = append(ic.TestedNotImplemented, req.ReqID)
+		case !req.HasTest() && len(found) > 0:
+			ic.ImplementedNotTested = append(ic.ImplementedNotTested, req.ReqID)
+		}
+	}
+	for id := range impl {
+		if !db.Exists(id) {
+			ic.UnknownRequirements = append(ic.UnknownRequirements, id)
+		}
+	}
+	database.SortReqIDs(ic.UnknownRequirements)
+
+	report.Implementation = ic
+	return report
+}
+
+func formatCoverageTerminal(cmd *cobra.Command, report *CoverageReport) {
+	width := 80
+	cmd.Println(output.Header("Requirement Coverage", width))
+	cmd.Println()
+
+	pct := 0.0
+	if report.Total > 0 {
+		pct = float64(report.Tested) / float64(report.Total) * 100
+	}
+	cmd.Printf("Tested: %d/%d requirements (%.1f%%)\n", report.Tested, report.Total, pct)
+
+	section := func(title string, ids []string, detail func(string) string) {
+		cmd.Println()
+		cmd.Printf("%s (%d)\n", output.Color(title, output.Bold), len(ids))
+		if len(ids) == 0 {
+			cmd.Printf("  %s\n", output.Color("(none)", output.Dim))
+		}
+		for _, id := range ids {
+			if detail != nil {
+				cmd.Printf("  %s  %s\n", output.Color(id, output.Cyan), output.Color(detail(id), output.Dim))
+			} else {
+				cmd.Printf("  %s\n", output.Color(id, output.Cyan))
+			}
+		}
+	}
+
+	section("No Test", report.Untested, nil)
+
+	ic := report.Implementation
+	if ic == nil {
+		return
+	}
+	cmd.Println()
+	cmd.Printf("Implemented: %d/%d requirements\n", len(ic.Implemented), report.Total)
+	section("Tested, No Implementation Marker", ic.TestedNotImplemented, nil)
+	section("Implemented, No Test", ic.ImplementedNotTested, func(id string) string {
+		return ic.Implemented[id][0]
+	})
+	if len(ic.UnknownRequirements) > 0 {
+		section("Markers For Unknown Requirements", ic.UnknownRequirements, nil)
+	}
+}
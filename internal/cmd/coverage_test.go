@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/markers"
+)
+
+// setupCoverageProject creates requirements that are tested, implemented,
+// both or neither, with implementation markers in source files.
+func setupCoverageProject(t *testing.T) string {
+	t.Helper()
+	tmpDir := setupTestProject(t, "REQ-AUTH-001", "REQ-AUTH-002", "REQ-AUTH-003", "REQ-AUTH-004")
+	updateTestDatabase(t, tmpDir, func(db *database.Database) {
+		// REQ-AUTH-001 is tested and implemented, REQ-AUTH-002 tested only,
+		// REQ-AUTH-003 implemented only and REQ-AUTH-004 neither
+		for _, id := range []string{"REQ-AUTH-001", "REQ-AUTH-002"} {
+			db.Get(id).TestModule = "auth/login_test.go"
+			db.Get(id).TestFunction = "TestLogin"
+		}
+	})
+
+	writeTestFile(t, tmpDir, "auth/login.go", "package auth\n\n// implements REQ-AUTH-001\nfunc Login() {}\n")
+	writeTestFile(t, tmpDir, "auth/session.go", "package auth\n\n// implements REQ-AUTH-003\n// implements REQ-GONE-001\nfunc Session() {}\n")
+	writeTestFile(t, tmpDir, "auth/login_test.go", "package auth\n\n// implements REQ-AUTH-002\nfunc TestLogin(t *testing.T) {}\n")
+	return tmpDir
+}
+
+func TestCoverageImpl(t *testing.T) {
+	origImpl, origFormat := coverageImpl, coverageFormat
+	defer func() { coverageImpl, coverageFormat = origImpl, origFormat }()
+
+	setupCoverageProject(t)
+
+	coverageImpl, coverageFormat = true, "json"
+
+	var buf bytes.Buffer
+	coverageCmd.SetOut(&buf)
+	if err := runCoverage(coverageCmd, nil); err != nil {
+		t.Fatalf("coverage failed: %v", err)
+	}
+
+	var report CoverageReport
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("Failed to parse report: %v\n%s", err, buf.String())
+	}
+	ic := report.Implementation
+	if ic == nil {
+		t.Fatal("Expected an implementation section with --impl")
+	}
+
+	wantImplemented := map[string][]string{
+		"REQ-AUTH-001": {"auth/login.go:3"},
+		"REQ-AUTH-003": {"auth/session.go:3"},
+	}
+	if !reflect.DeepEqual(ic.Implemented, wantImplemented) {
+		t.Errorf("implemented = %v, want %v", ic.Implemented, wantImplemented)
+	}
+	if want := []string{"REQ-AUTH-002"}; !reflect.DeepEqual(ic.TestedNotImplemented, want) {
+		t.Errorf("tested_not_implemented = %v, want %v", ic.TestedNotImplemented, want)
+	}
+	if want := []string{"REQ-AUTH-003"}; !reflect.DeepEqual(ic.ImplementedNotTested, want) {
+		t.Errorf("implemented_not_tested = %v, want %v", ic.ImplementedNotTested, want)
+	}
+	if want := []string{"REQ-GONE-001"}; !reflect.DeepEqual(ic.UnknownRequirements, want) {
+		t.Errorf("unknown_requirements = %v, want %v", ic.UnknownRequirements, want)
+	}
+	if want := []string{"REQ-AUTH-003", "REQ-AUTH-004"}; !reflect.DeepEqual(report.Untested, want) {
+		t.Errorf("untested = %v, want %v", report.Untested, want)
+	}
+
+	// trace lists the implementation files
+	buf.Reset()
+	traceCmd.SetOut(&buf)
+	defer traceCmd.SetOut(nil)
+	if err := runTrace(traceCmd, []string{"REQ-AUTH-003"}); err != nil {
+		t.Fatalf("trace failed: %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "Implementation") || !strings.Contains(out, "auth/session.go:3") {
+		t.Errorf("Expected trace to list the implementation file:\n%s", out)
+	}
+}
+
+func TestImplementationMarkersDirs(t *testing.T) {
+	tmpDir := setupCoverageProject(t)
+	if err := os.WriteFile(filepath.Join(tmpDir, "scratch.go"), []byte("// implements REQ-AUTH-004\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the configured directories are scanned
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Markers.Implementation.Dirs = []string{"auth", "missing"}
+	impl, err := implementationMarkers(tmpDir, cfg)
+	if err != nil {
+		t.Fatalf("implementationMarkers failed: %v", err)
+	}
+	if got := markers.Locations(impl["REQ-AUTH-001"]); !reflect.DeepEqual(got, []string{"auth/login.go:3"}) {
+		t.Errorf("REQ-AUTH-001 markers = %v, want paths relative to the project root", got)
+	}
+	if _, ok := impl["REQ-AUTH-004"]; ok {
+		t.Error("Expected files outside the configured directories to be skipped")
+	}
+
+	// An unreadable directory does not fail the scan
+	if os.Geteuid() == 0 {
+		t.Skip("root can read any directory")
+	}
+	locked := filepath.Join(tmpDir, "locked")
+	if err := os.Mkdir(locked, 0); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Chmod(locked, 0755) }()
+	impl, err = implementationMarkers(tmpDir, config.DefaultConfig())
+	if err != nil {
+		t.Fatalf("Expected the unreadable directory to be skipped: %v", err)
+	}
+	if _, ok := impl["REQ-AUTH-004"]; !ok {
+		t.Error("Expected the rest of the project to be scanned")
+	}
+}
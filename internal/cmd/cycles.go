@@ -49,13 +49,13 @@ func runCycles(cmd *cobra.Command, args []string) error {
 
 	cfg, err := config.LoadFromDir(cwd)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return configLoadError(err)
 	}
 
 	dbPath := cfg.DatabasePath(cwd)
 	db, err := database.Load(dbPath)
 	if err != nil {
-		return fmt.Errorf("failed to load database: %w", err)
+		return databaseLoadError(err)
 	}
 
 	g := graph.NewGraph(db)
@@ -15,8 +15,14 @@ import (
 var (
 	diffFormat string
 	diffOutput string
+	diffLayout string
 )
 
+// diffSideBySideMinWidth is the narrowest terminal the side-by-side layout
+// is used on; narrower terminals get the list layout. Output whose width
+// is unknown is laid out at this width.
+const diffSideBySideMinWidth = 100
+
 var diffCmd = &cobra.Command{
 	Use:   "diff BASELINE [CURRENT]",
 	Short: "Compare RTM databases before and after changes",
@@ -24,6 +30,14 @@ var diffCmd = &cobra.Command{
 
 If CURRENT is not specified, uses the default database path.
 
+Changes to requirements on the watch list (rtmx watch-list) are
+highlighted.
+
+With --layout side-by-side, changed requirements are shown as a table with
+the baseline and current values in two columns. Terminals narrower than
+100 columns (from COLUMNS or the terminal itself) fall back to the list
+layout; output whose width is unknown, such as a pipe, keeps the table.
+
 Exit codes:
   0  Stable or improved
   1  Regressed or degraded
@@ -32,7 +46,8 @@ Exit codes:
 Examples:
     rtmx diff backup.csv                    # Compare with backup
     rtmx diff v1.csv v2.csv                 # Compare two versions
-    rtmx diff baseline.csv --format json    # JSON output`,
+    rtmx diff baseline.csv --format json    # JSON output
+    rtmx diff v1.csv v2.csv --layout side-by-side`,
 	Args: cobra.RangeArgs(1, 2),
 	RunE: runDiff,
 }
@@ -40,6 +55,7 @@ Examples:
 func init() {
 	diffCmd.Flags().StringVar(&diffFormat, "format", "terminal", "output format: terminal, markdown, json")
 	diffCmd.Flags().StringVarP(&diffOutput, "output", "o", "", "output file")
+	diffCmd.Flags().StringVar(&diffLayout, "layout", "list", "terminal layout of changes: list, side-by-side")
 
 	rootCmd.AddCommand(diffCmd)
 }
@@ -70,6 +86,7 @@ type ChangedReq struct {
 	Field     string `json:"field"`
 	OldValue  string `json:"old_value"`
 	NewValue  string `json:"new_value"`
+	Watched   bool   `json:"watched,omitempty"`
 }
 
 func runDiff(cmd *cobra.Command, args []string) error {
@@ -77,6 +94,12 @@ func runDiff(cmd *cobra.Command, args []string) error {
 		output.DisableColor()
 	}
 
+	switch diffLayout {
+	case "", "list", "side-by-side":
+	default:
+		return NewValidationError(fmt.Sprintf("invalid layout %q", diffLayout), "use list or side-by-side")
+	}
+
 	baselinePath := args[0]
 
 	// Determine current path
@@ -90,7 +113,7 @@ func runDiff(cmd *cobra.Command, args []string) error {
 		}
 		cfg, err := config.LoadFromDir(cwd)
 		if err != nil {
-			return fmt.Errorf("failed to load config: %w", err)
+			return configLoadError(err)
 		}
 		currentPath = cfg.DatabasePath(cwd)
 	}
@@ -109,6 +132,16 @@ func runDiff(cmd *cobra.Command, args []string) error {
 	// Compare
 	result := compareDatabases(baselineDB, currentDB)
 
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+	watched, err := loadWatchList(cwd)
+	if err != nil {
+		return err
+	}
+	markWatchedChanges(result, watched)
+
 	// Output
 	var outputContent string
 	switch diffFormat {
@@ -233,6 +266,13 @@ func compareDatabases(baseline, current *database.Database) *DiffResult {
 	return result
 }
 
+// markWatchedChanges flags the changes to watched requirements.
+func markWatchedChanges(result *DiffResult, watched watchList) {
+	for i := range result.Changed {
+		result.Changed[i].Watched = watched[result.Changed[i].ReqID]
+	}
+}
+
 func formatDiffTerminal(cmd *cobra.Command, result *DiffResult) error {
 	width := 80
 	cmd.Println(output.Header("RTM Database Comparison", width))
@@ -243,7 +283,7 @@ func formatDiffTerminal(cmd *cobra.Command, result *DiffResult) error {
 	cmd.Printf("  %-20s %10s  →  %-10s\n", "", "Baseline", "Current")
 	cmd.Printf("  %-20s %10d  →  %-10d\n", "Total requirements:", result.Baseline.Total, result.Current.Total)
 	cmd.Printf("  %-20s %10d  →  %-10d\n", "Complete:", result.Baseline.Complete, result.Current.Complete)
-	cmd.Printf("  %-20s %9.1f%%  →  %-.1f%%\n", "Completion:", result.Baseline.Completion, result.Current.Completion)
+	cmd.Printf("  %-20s %10s  →  %s\n", "Completion:", output.Percent(result.Baseline.Completion), output.Percent(result.Current.Completion))
 	cmd.Println()
 
 	// Changes
@@ -263,7 +303,16 @@ func formatDiffTerminal(cmd *cobra.Command, result *DiffResult) error {
 		cmd.Println()
 	}
 
-	if len(result.Changed) > 0 {
+	// An unknown width, e.g. when piped, does not override the layout asked for
+	termWidth, known := output.DetectTerminalWidth()
+	if !known {
+		termWidth = diffSideBySideMinWidth
+	}
+	if len(result.Changed) > 0 && diffLayout == "side-by-side" && termWidth >= diffSideBySideMinWidth {
+		cmd.Printf("%s Changed (%d):\n", output.Color("~", output.Yellow), len(result.Changed))
+		cmd.Print(formatChangesSideBySide(result.Changed, termWidth))
+		cmd.Println()
+	} else if len(result.Changed) > 0 {
 		cmd.Printf("%s Changed (%d):\n", output.Color("~", output.Yellow), len(result.Changed))
 		for _, c := range result.Changed {
 			var arrow string
@@ -272,8 +321,12 @@ func formatDiffTerminal(cmd *cobra.Command, result *DiffResult) error {
 			} else {
 				arrow = "→"
 			}
+			id := c.ReqID
+			if c.Watched {
+				id = watchedLabel(c.ReqID)
+			}
 			cmd.Printf("    %s %s.%s: %s %s %s\n",
-				output.Color("~", output.Yellow), c.ReqID, c.Field, c.OldValue, arrow, c.NewValue)
+				output.Color("~", output.Yellow), id, c.Field, c.OldValue, arrow, c.NewValue)
 		}
 		cmd.Println()
 	}
@@ -303,6 +356,45 @@ func formatDiffTerminal(cmd *cobra.Command, result *DiffResult) error {
 	return nil
 }
 
+// formatChangesSideBySide renders changes as a table with the baseline and
+// current values in adjacent columns, truncating values to fit width.
+func formatChangesSideBySide(changes []ChangedReq, width int) string {
+	idWidth, fieldWidth := len("Requirement"), len("Field")
+	for _, c := range changes {
+		id := c.ReqID
+		if c.Watched {
+			id = watchedLabel(c.ReqID)
+		}
+		if w := output.VisibleWidth(id); w > idWidth {
+			idWidth = w
+		}
+		if len(c.Field) > fieldWidth {
+			fieldWidth = len(c.Field)
+		}
+	}
+	// Four columns take 13 characters of borders and padding
+	valueWidth := (width - idWidth - fieldWidth - 13) / 2
+	if valueWidth < 10 {
+		valueWidth = 10
+	}
+
+	table := output.NewTable("Requirement", "Field", "Baseline", "Current")
+	for _, c := range changes {
+		id := c.ReqID
+		if c.Watched {
+			id = watchedLabel(c.ReqID)
+		}
+		newValue := c.NewValue
+		if c.Field == "status" {
+			newValue = output.Color(output.TruncateCell(newValue, valueWidth), output.Yellow)
+		} else {
+			newValue = output.TruncateCell(newValue, valueWidth)
+		}
+		table.AddRow(id, c.Field, output.TruncateCell(c.OldValue, valueWidth), newValue)
+	}
+	return table.Render()
+}
+
 func formatDiffMarkdown(result *DiffResult) string {
 	var sb strings.Builder
 
@@ -313,7 +405,7 @@ func formatDiffMarkdown(result *DiffResult) string {
 	sb.WriteString("|--------|----------|--------|\n")
 	sb.WriteString(fmt.Sprintf("| Total | %d | %d |\n", result.Baseline.Total, result.Current.Total))
 	sb.WriteString(fmt.Sprintf("| Complete | %d | %d |\n", result.Baseline.Complete, result.Current.Complete))
-	sb.WriteString(fmt.Sprintf("| Completion | %.1f%% | %.1f%% |\n", result.Baseline.Completion, result.Current.Completion))
+	sb.WriteString(fmt.Sprintf("| Completion | %s | %s |\n", output.Percent(result.Baseline.Completion), output.Percent(result.Current.Completion)))
 	sb.WriteString("\n")
 
 	if len(result.Added) > 0 {
@@ -337,7 +429,11 @@ func formatDiffMarkdown(result *DiffResult) string {
 		sb.WriteString("| Requirement | Field | Old | New |\n")
 		sb.WriteString("|-------------|-------|-----|-----|\n")
 		for _, c := range result.Changed {
-			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n", c.ReqID, c.Field, c.OldValue, c.NewValue))
+			id := c.ReqID
+			if c.Watched {
+				id = "★ " + id
+			}
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n", id, c.Field, c.OldValue, c.NewValue))
 		}
 		sb.WriteString("\n")
 	}
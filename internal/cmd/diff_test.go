@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"bytes"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+func TestDiffSideBySideLayout(t *testing.T) {
+	tmpDir := setupTestProject(t, "REQ-DIFF-001", "REQ-DIFF-002")
+	baseline := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	db, err := database.Load(baseline)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	db.Get("REQ-DIFF-001").Status = database.StatusComplete
+	db.Get("REQ-DIFF-001").Priority = database.PriorityHigh
+	current := filepath.Join(tmpDir, "current.csv")
+	if err := db.Save(current); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	run := func() string {
+		var buf bytes.Buffer
+		rootCmd.SetOut(&buf)
+		rootCmd.SetArgs([]string{"diff", baseline, current, "--layout", "side-by-side"})
+		defer func() {
+			rootCmd.SetOut(nil)
+			diffLayout = "list"
+		}()
+		if err := rootCmd.Execute(); err != nil {
+			t.Fatalf("diff command failed: %v", err)
+		}
+		return buf.String()
+	}
+
+	tests := []struct {
+		name    string
+		columns string
+		table   bool
+	}{
+		{"wide terminal", "120", true},
+		{"narrow terminal", "60", false},
+		{"unknown width", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("COLUMNS", tt.columns)
+			out := run()
+
+			if !tt.table {
+				if !strings.Contains(out, "REQ-DIFF-001.status: MISSING → COMPLETE") {
+					t.Errorf("Expected the list layout on a narrow terminal, got:\n%s", out)
+				}
+				return
+			}
+
+			var header, status, priority string
+			for _, line := range strings.Split(out, "\n") {
+				switch {
+				case strings.Contains(line, "Baseline") && strings.Contains(line, "Current") && strings.HasPrefix(line, "|"):
+					header = line
+				case strings.Contains(line, "REQ-DIFF-001") && strings.Contains(line, "status"):
+					status = line
+				case strings.Contains(line, "REQ-DIFF-001") && strings.Contains(line, "priority"):
+					priority = line
+				}
+			}
+			if header == "" || status == "" || priority == "" {
+				t.Fatalf("Expected a table with a row per changed field, got:\n%s", out)
+			}
+			for _, row := range []struct{ line, old, new string }{
+				{status, "MISSING", "COMPLETE"},
+				{priority, "MEDIUM", "HIGH"},
+			} {
+				cells := strings.Split(row.line, "|")
+				if len(cells) != 6 || strings.TrimSpace(cells[3]) != row.old || strings.TrimSpace(cells[4]) != row.new {
+					t.Errorf("row = %q, want %s and %s in the Baseline and Current columns", row.line, row.old, row.new)
+				}
+				if pipes(row.line) != pipes(header) {
+					t.Errorf("row %q is not aligned with the header %q", row.line, header)
+				}
+			}
+			if strings.Contains(out, "REQ-DIFF-001.status:") {
+				t.Errorf("Expected no list layout on a wide terminal, got:\n%s", out)
+			}
+		})
+	}
+}
+
+// pipes returns the positions of the column separators in a table line.
+func pipes(line string) string {
+	var pos []string
+	for i, r := range line {
+		if r == '|' {
+			pos = append(pos, strconv.Itoa(i))
+		}
+	}
+	return strings.Join(pos, ",")
+}
@@ -109,8 +109,14 @@ func generateSchemaDoc() string {
 	sb.WriteString("| sprint | string | No | Sprint identifier |\n")
 	sb.WriteString("| started_date | date | No | Date work started |\n")
 	sb.WriteString("| completed_date | date | No | Date work completed |\n")
+	sb.WriteString("| due_date | date | No | Target completion date (written only when used) |\n")
+	sb.WriteString("| release | string | No | Release or milestone, e.g. v1.1 (written only when used) |\n")
+	sb.WriteString("| blocked_external | string | No | What blocks the requirement outside the RTM, set by sync from flagged issues (written only when used) |\n")
+	sb.WriteString("| commits | string | No | Pipe-separated commit SHAs that implemented the requirement (written only when used) |\n")
+	sb.WriteString("| pull_requests | string | No | Pipe-separated pull request URLs (written only when used) |\n")
 	sb.WriteString("| requirement_file | string | No | Path to detailed requirement spec |\n")
-	sb.WriteString("| external_id | string | No | External tracker ID (GitHub, Jira) |\n")
+	sb.WriteString("| external_id | string | No | External tracker IDs per service (e.g. `github:42\\|jira:PROJ-7`) |\n")
+	sb.WriteString("| external_url | string | No | Web addresses of linked items per service (e.g. `github:https://...\\|jira:https://...`), set by sync (written only when used) |\n")
 	sb.WriteString("\n")
 
 	sb.WriteString("## Status Values\n\n")
@@ -146,6 +152,11 @@ func generateConfigDoc() string {
 	sb.WriteString("2. `rtmx.yaml`\n")
 	sb.WriteString("3. `.rtmx.yaml`\n")
 	sb.WriteString("\n")
+	sb.WriteString("### Environment Overlays\n\n")
+	sb.WriteString("With `--env ci` or `RTMX_ENV=ci`, the overlay next to the config file\n")
+	sb.WriteString("(`rtmx.ci.yaml` for `rtmx.yaml`, `.rtmx/config.ci.yaml` for\n")
+	sb.WriteString("`.rtmx/config.yaml`) is merged over it. Mappings merge key by key and the\n")
+	sb.WriteString("overlay wins; lists and values in the overlay replace the base's.\n\n")
 
 	sb.WriteString("## Configuration Structure\n\n")
 	sb.WriteString("```yaml\n")
@@ -156,19 +167,72 @@ func generateConfigDoc() string {
 	sb.WriteString("  # Directory containing requirement specification files\n")
 	sb.WriteString("  requirements_dir: .rtmx/requirements\n")
 	sb.WriteString("\n")
+	sb.WriteString("  # Spec file layout under requirements_dir (.Category, .ID, .Phase)\n")
+	sb.WriteString("  requirement_file_template: \"{{.Category}}/{{.ID}}.md\"\n")
+	sb.WriteString("\n")
 	sb.WriteString("  # Schema type: core, phoenix, or custom\n")
 	sb.WriteString("  schema: core\n")
 	sb.WriteString("\n")
+	sb.WriteString("  # Digits generated requirement IDs are zero-padded to\n")
+	sb.WriteString("  id_pad_width: 3\n")
+	sb.WriteString("\n")
+	sb.WriteString("  # Allowed categories (validate, add, bootstrap); empty allows any\n")
+	sb.WriteString("  categories: [AUTH, API, DOCS]\n")
+	sb.WriteString("  # Delivered categories hidden by status and backlog\n")
+	sb.WriteString("  archived_categories: [LEGACY]\n")
+	sb.WriteString("\n")
 	sb.WriteString("  # Phase definitions with descriptions\n")
 	sb.WriteString("  phases:\n")
 	sb.WriteString("    1: \"Foundation\"\n")
 	sb.WriteString("    2: \"Core Features\"\n")
 	sb.WriteString("    3: \"Integration\"\n")
 	sb.WriteString("\n")
-	sb.WriteString("  # Pytest plugin configuration\n")
+	sb.WriteString("  # Pytest plugin configuration; marker_prefix upper-cased starts requirement IDs\n")
 	sb.WriteString("  pytest:\n")
 	sb.WriteString("    marker_prefix: \"req\"\n")
 	sb.WriteString("    register_markers: true\n")
+	sb.WriteString("\n")
+	sb.WriteString("  # Risk score weights for backlog --view risk\n")
+	sb.WriteString("  risk:\n")
+	sb.WriteString("    priority_weights: {P0: 4, HIGH: 3, MEDIUM: 2, LOW: 1}\n")
+	sb.WriteString("    blocked_weight: 1\n")
+	sb.WriteString("    uncertainty_per_week: 0.25\n")
+	sb.WriteString("    unestimated_uncertainty: 2\n")
+	sb.WriteString("\n")
+	sb.WriteString("  # Checks a COMPLETE requirement must pass (rtmx validate)\n")
+	sb.WriteString("  definition_of_done:\n")
+	sb.WriteString("    checks: [spec_file, acceptance_criteria, test_linked, \"field:reviewer\"]\n")
+	sb.WriteString("    enforce_in_verify: false\n")
+	sb.WriteString("\n")
+	sb.WriteString("  # Minimum completion per category and phase (health, gate)\n")
+	sb.WriteString("  completion_thresholds:\n")
+	sb.WriteString("    categories: {AUTH: 100, DOCS: 50}\n")
+	sb.WriteString("    phases: {1: 90}\n")
+	sb.WriteString("\n")
+	sb.WriteString("  # Custom statuses and which statuses are backlog (default: all but COMPLETE)\n")
+	sb.WriteString("  statuses:\n")
+	sb.WriteString("    custom: [IN_REVIEW, BLOCKED]\n")
+	sb.WriteString("    incomplete: [MISSING, NOT_STARTED, PARTIAL, BLOCKED]\n")
+	sb.WriteString("\n")
+	sb.WriteString("  # Notify on status changes to requirements on the watch list\n")
+	sb.WriteString("  watch:\n")
+	sb.WriteString("    webhook: https://hooks.example.com/rtmx\n")
+	sb.WriteString("\n")
+	sb.WriteString("  # Remaining-work forecast for status --velocity\n")
+	sb.WriteString("  forecast:\n")
+	sb.WriteString("    partial_fraction: 0.5\n")
+	sb.WriteString("    sprint_weeks: 2\n")
+	sb.WriteString("\n")
+	sb.WriteString("  # Date layout (Go time layout) and decimal separator\n")
+	sb.WriteString("  locale:\n")
+	sb.WriteString("    date_format: \"02.01.2006\"\n")
+	sb.WriteString("    decimal_separator: \",\"\n")
+	sb.WriteString("\n")
+	sb.WriteString("  # Default flag values per command\n")
+	sb.WriteString("  defaults:\n")
+	sb.WriteString("    backlog:\n")
+	sb.WriteString("      view: critical\n")
+	sb.WriteString("      category: AUTH\n")
 	sb.WriteString("```\n\n")
 
 	sb.WriteString("## Fields Reference\n\n")
@@ -176,12 +240,37 @@ func generateConfigDoc() string {
 	sb.WriteString("|-------|------|---------|-------------|\n")
 	sb.WriteString("| database | string | .rtmx/database.csv | Path to RTM database |\n")
 	sb.WriteString("| requirements_dir | string | .rtmx/requirements | Path to requirement specs |\n")
+	sb.WriteString("| requirement_file_template | string | {{.Category}}/{{.ID}}.md | Spec file path under requirements_dir; fields .Category, .ID, .Phase |\n")
 	sb.WriteString("| schema | string | core | Schema type |\n")
+	sb.WriteString("| id_pad_width | int | 3 | Digits generated requirement IDs are zero-padded to |\n")
+	sb.WriteString("| categories | []string | [] | Categories requirements may use; empty allows any |\n")
+	sb.WriteString("| archived_categories | []string | [] | Categories status and backlog hide unless --include-archived |\n")
 	sb.WriteString("| phases | map[int]string | {} | Phase number to name mapping |\n")
-	sb.WriteString("| pytest.marker_prefix | string | req | Pytest marker prefix |\n")
+	sb.WriteString("| pytest.marker_prefix | string | req | Pytest marker prefix; upper-cased, the requirement ID prefix for generated IDs and test and issue markers |\n")
 	sb.WriteString("| pytest.register_markers | bool | true | Auto-register pytest markers |\n")
+	sb.WriteString("| risk.priority_weights | map[string]float | {P0: 4, HIGH: 3, MEDIUM: 2, LOW: 1} | Risk weight per priority |\n")
+	sb.WriteString("| risk.blocked_weight | float | 1 | Risk added per downstream blocked requirement |\n")
+	sb.WriteString("| risk.uncertainty_per_week | float | 0.25 | Uncertainty added per week of estimated effort |\n")
+	sb.WriteString("| risk.unestimated_uncertainty | float | 2 | Uncertainty of requirements without an estimate |\n")
+	sb.WriteString("| definition_of_done.checks | []string | [] | Checks for COMPLETE: spec_file, acceptance_criteria, test_linked, field:<column> |\n")
+	sb.WriteString("| definition_of_done.enforce_in_verify | bool | false | Keep requirements failing a check PARTIAL in verify |\n")
+	sb.WriteString("| completion_thresholds.categories | map[string]float | {} | Minimum completion percentage per category |\n")
+	sb.WriteString("| completion_thresholds.phases | map[int]float | {} | Minimum completion percentage per phase |\n")
+	sb.WriteString("| statuses.custom | []string | [] | Statuses accepted besides COMPLETE, PARTIAL, MISSING, NOT_STARTED |\n")
+	sb.WriteString("| statuses.incomplete | []string | [] | Statuses that count as incomplete in the backlog; empty means all but COMPLETE |\n")
+	sb.WriteString("| watch.webhook | string | \"\" | URL that verify and sync POST watched status changes to |\n")
+	sb.WriteString("| forecast.partial_fraction | float | 0.5 | Fraction of a PARTIAL requirement's effort still remaining |\n")
+	sb.WriteString("| forecast.sprint_weeks | float | 2 | Sprint length in weeks for the completion forecast |\n")
+	sb.WriteString("| locale.date_format | string | 2006-01-02 | Go time layout for written started/completed dates and displayed dates; --date-format overrides |\n")
+	sb.WriteString("| locale.decimal_separator | string | . | Decimal separator of displayed percentages and decimals |\n")
+	sb.WriteString("| defaults.<command>.<flag> | string | {} | Default flag value for a command |\n")
 	sb.WriteString("\n")
 
+	sb.WriteString("## Command Defaults\n\n")
+	sb.WriteString("Values under `defaults` apply to a command's flags when they are not given on the command line.\n")
+	sb.WriteString("Precedence is: command-line flag, then config default, then built-in default.\n")
+	sb.WriteString("Nested commands use their full name (e.g. `docs schema`).\n\n")
+
 	// Load current config to show example
 	if cwd, err := os.Getwd(); err == nil {
 		if cfg, err := config.LoadFromDir(cwd); err == nil {
@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	editCategory string
+	editFormat   string
+)
+
+// defaultEditor is used when neither VISUAL nor EDITOR is set.
+const defaultEditor = "vi"
+
+// runEditor opens path in the editor command and waits for it to exit.
+// Tests replace it.
+var runEditor = func(command, path string) error {
+	parts := strings.Fields(command)
+	c := exec.Command(parts[0], append(parts[1:], path)...)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	return c.Run()
+}
+
+var editCmd = &cobra.Command{
+	Use:   "edit [REQ-ID...]",
+	Short: "Edit requirements in your editor",
+	Long: `Open the selected requirements in $VISUAL or $EDITOR as a temporary
+YAML (or CSV) file, then write the edits back to the RTM.
+
+Select requirements by ID, with --category, or both. The edited file is
+read back as an RTM and checked before anything is saved: it must hold
+the same requirement IDs, every value must parse, and dependencies and
+blocks must name requirements that exist. A rejected edit leaves the
+database untouched.
+
+Examples:
+    rtmx edit REQ-AUTH-001
+    rtmx edit REQ-AUTH-001 REQ-AUTH-002
+    rtmx edit --category AUTH
+    rtmx edit --category AUTH --format csv`,
+	RunE: runEdit,
+}
+
+func init() {
+	editCmd.Flags().StringVar(&editCategory, "category", "", "edit every requirement in this category")
+	editCmd.Flags().StringVar(&editFormat, "format", "yaml", "format of the file to edit: yaml, csv")
+	rootCmd.AddCommand(editCmd)
+}
+
+func runEdit(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	if len(args) == 0 && editCategory == "" {
+		return NewValidationError("no requirements selected", "pass requirement IDs or --category")
+	}
+	format, err := database.ParseFormat(editFormat)
+	if err != nil || format == database.FormatJSON {
+		return NewValidationError(fmt.Sprintf("invalid format %q (use yaml or csv)", editFormat))
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	dbPath := cfg.DatabasePath(cwd)
+	db, err := database.Load(dbPath)
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	selected, err := selectEditRequirements(db, args, editCategory)
+	if err != nil {
+		return err
+	}
+
+	subset := database.NewDatabase()
+	for _, req := range selected {
+		_ = subset.Add(req)
+	}
+	var before bytes.Buffer
+	if err := subset.WriteFormat(&before, format); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp("", "rtmx-edit-*."+string(format))
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer func() { _ = os.Remove(tmpPath) }()
+	if _, err := tmp.Write(before.Bytes()); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := runEditor(editorCommand(os.Getenv), tmpPath); err != nil {
+		return fmt.Errorf("editor failed: %w", err)
+	}
+
+	after, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to read edited file: %w", err)
+	}
+	if bytes.Equal(after, before.Bytes()) {
+		cmd.Println("No changes")
+		return nil
+	}
+
+	edited, err := database.ReadFormat(bytes.NewReader(after), format)
+	if err != nil {
+		return NewValidationError("edited file is not a valid RTM", err.Error())
+	}
+	if problems := checkEditedRequirements(db, selected, edited); len(problems) > 0 {
+		return NewValidationError("edit rejected, no changes written", problems...)
+	}
+
+	changed := 0
+	for _, req := range selected {
+		updated := edited.Get(req.ReqID)
+		if requirementRow(updated) == requirementRow(req) {
+			continue
+		}
+		*req = *updated
+		changed++
+		cmd.Printf("  %s %s\n", output.Color("~", output.Yellow), req.ReqID)
+	}
+	if changed == 0 {
+		cmd.Println("No changes")
+		return nil
+	}
+
+	if err := db.Save(dbPath); err != nil {
+		return databaseSaveError(err)
+	}
+
+	cmd.Printf("%s Updated %d requirement(s)\n", output.Color("✓", output.Green), changed)
+	return nil
+}
+
+// editorCommand returns the editor to use: VISUAL, then EDITOR, then vi.
+func editorCommand(getenv func(string) string) string {
+	for _, key := range []string{"VISUAL", "EDITOR"} {
+		if command := strings.TrimSpace(getenv(key)); command != "" {
+			return command
+		}
+	}
+	return defaultEditor
+}
+
+// selectEditRequirements returns the requirements named by ids or in
+// category, in database order.
+func selectEditRequirements(db *database.Database, ids []string, category string) ([]*database.Requirement, error) {
+	want := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if !db.Exists(id) {
+			return nil, fmt.Errorf("requirement %q not found", id)
+		}
+		want[id] = true
+	}
+
+	var selected []*database.Requirement
+	for _, req := range db.All() {
+		if want[req.ReqID] || (category != "" && req.Category == category) {
+			selected = append(selected, req)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no requirements in category %q", category)
+	}
+	return selected, nil
+}
+
+// checkEditedRequirements describes why the edited requirements cannot
+// replace the selected ones: IDs added or removed, values that do not
+// parse, and references to requirements that do not exist. Problems the
+// requirements already had are not reported.
+func checkEditedRequirements(db *database.Database, selected []*database.Requirement, edited *database.Database) []string {
+	var problems []string
+
+	original := make(map[string]bool, len(selected))
+	for _, req := range selected {
+		original[req.ReqID] = true
+		if !edited.Exists(req.ReqID) {
+			problems = append(problems, fmt.Sprintf("%s: removed (edit cannot add, remove or rename requirements)", req.ReqID))
+		}
+	}
+
+	for _, req := range edited.All() {
+		if !original[req.ReqID] {
+			problems = append(problems, fmt.Sprintf("%s: added (edit cannot add, remove or rename requirements)", req.ReqID))
+			continue
+		}
+
+		// Only report problems the edit introduced, and fill in what has a
+		// safe correction, such as the completed_date of a COMPLETE one
+		orig := db.Get(req.ReqID)
+		existing := make(map[string]bool)
+		for _, problem := range orig.Inconsistencies() {
+			existing[problem.Message] = true
+		}
+		for _, ref := range danglingRefs(db, orig) {
+			existing[ref] = true
+		}
+		for _, problem := range req.Inconsistencies() {
+			if problem.Fix != nil {
+				problem.Fix()
+				continue
+			}
+			if !existing[problem.Message] {
+				problems = append(problems, fmt.Sprintf("%s: %s", req.ReqID, problem.Message))
+			}
+		}
+		for _, ref := range danglingRefs(db, req) {
+			if !existing[ref] {
+				problems = append(problems, fmt.Sprintf("%s: %s", req.ReqID, ref))
+			}
+		}
+	}
+	return problems
+}
+
+// danglingRefs describes the dependencies and blocks of req that name
+// requirements missing from db.
+func danglingRefs(db *database.Database, req *database.Requirement) []string {
+	var refs []string
+	for _, field := range []struct {
+		name string
+		ids  database.StringSet
+	}{
+		{"dependency", req.Dependencies},
+		{"blocks", req.Blocks},
+	} {
+		for _, id := range field.ids.Slice() {
+			if !db.Exists(id) {
+				refs = append(refs, fmt.Sprintf("%s references unknown requirement %s", field.name, id))
+			}
+		}
+	}
+	return refs
+}
+
+// requirementRow renders req as a CSV record, for telling whether an edit
+// changed it.
+func requirementRow(req *database.Requirement) string {
+	single := database.NewDatabase()
+	_ = single.Add(req)
+	var buf bytes.Buffer
+	_ = single.WriteCSV(&buf)
+	return buf.String()
+}
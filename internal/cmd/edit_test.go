@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// fakeEditor returns an editor that replaces old with new in the file.
+func fakeEditor(t *testing.T, old, new string) func(command, path string) error {
+	return func(command, path string) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(string(data), old) {
+			t.Fatalf("edited file does not contain %q:\n%s", old, data)
+		}
+		return os.WriteFile(path, []byte(strings.Replace(string(data), old, new, 1)), 0644)
+	}
+}
+
+func TestEdit(t *testing.T) {
+	origEditor, origCategory, origFormat := runEditor, editCategory, editFormat
+	defer func() { runEditor, editCategory, editFormat = origEditor, origCategory, origFormat }()
+
+	tmpDir := setupRenameProject(t)
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+
+	editCategory, editFormat = "", "yaml"
+
+	t.Run("valid edit persists", func(t *testing.T) {
+		runEditor = fakeEditor(t, "requirement_text: Requirement REQ-AUTH-001", "requirement_text: Users sign in with SSO")
+
+		var buf bytes.Buffer
+		editCmd.SetOut(&buf)
+		if err := runEdit(editCmd, []string{"REQ-AUTH-001"}); err != nil {
+			t.Fatalf("edit failed: %v\n%s", err, buf.String())
+		}
+
+		db, err := database.Load(dbPath)
+		if err != nil {
+			t.Fatalf("Failed to load database: %v", err)
+		}
+		if got := db.Get("REQ-AUTH-001").RequirementText; got != "Users sign in with SSO" {
+			t.Errorf("requirement_text = %q, want the edited text", got)
+		}
+		if !strings.Contains(buf.String(), "Updated 1 requirement(s)") {
+			t.Errorf("Expected update summary, got:\n%s", buf.String())
+		}
+	})
+
+	t.Run("invalid edit is rejected", func(t *testing.T) {
+		before, _ := os.ReadFile(dbPath)
+
+		tests := []struct {
+			name     string
+			old, new string
+			want     string
+		}{
+			{"bad status", "status: MISSING", "status: DONE-ISH", "invalid status"},
+			{"dangling dependency", "category: AUTH", "category: AUTH\n  dependencies: REQ-NOPE-001", "unknown requirement REQ-NOPE-001"},
+			{"renamed id", "req_id: REQ-AUTH-002", "req_id: REQ-AUTH-003", "cannot add, remove or rename"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				editCategory = "AUTH"
+				defer func() { editCategory = "" }()
+				runEditor = fakeEditor(t, tt.old, tt.new)
+
+				var buf bytes.Buffer
+				editCmd.SetOut(&buf)
+				err := runEdit(editCmd, nil)
+				var exitErr *ExitError
+				if !errors.As(err, &exitErr) || exitErr.Type != ErrorTypeValidation {
+					t.Fatalf("expected validation error, got %v", err)
+				}
+				if !strings.Contains(strings.Join(exitErr.Details, "|"), tt.want) {
+					t.Errorf("Details = %v, want one containing %q", exitErr.Details, tt.want)
+				}
+
+				after, _ := os.ReadFile(dbPath)
+				if !bytes.Equal(before, after) {
+					t.Error("Database changed after a rejected edit")
+				}
+			})
+		}
+	})
+}
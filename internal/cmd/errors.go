@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"net/url"
+
+	"github.com/spf13/cobra"
+)
+
+// ErrorType classifies a CLI error so automation can tell failures apart.
+type ErrorType string
+
+const (
+	// ErrorTypeValidation is invalid input or a failed check (exit code 1).
+	ErrorTypeValidation ErrorType = "validation"
+	// ErrorTypeConfig is a missing or malformed configuration (exit code 5,
+	// since health and diff already exit 2 for blocking findings).
+	ErrorTypeConfig ErrorType = "config"
+	// ErrorTypeIO is a failure reading or writing files (exit code 3).
+	ErrorTypeIO ErrorType = "io"
+	// ErrorTypeNetwork is a failure talking to a remote service (exit code 4).
+	ErrorTypeNetwork ErrorType = "network"
+	// ErrorTypeGeneral is any other error (exit code 1).
+	ErrorTypeGeneral ErrorType = "error"
+)
+
+// ExitCode returns the process exit code for errors of this type.
+func (t ErrorType) ExitCode() int {
+	switch t {
+	case ErrorTypeConfig:
+		return 5
+	case ErrorTypeIO:
+		return 3
+	case ErrorTypeNetwork:
+		return 4
+	default:
+		return 1
+	}
+}
+
+// NewTypedError creates an ExitError of the given type, wrapping err when
+// non-nil. The exit code follows the type.
+func NewTypedError(typ ErrorType, message string, err error, details ...string) *ExitError {
+	return &ExitError{
+		Code:    typ.ExitCode(),
+		Message: message,
+		Type:    typ,
+		Details: details,
+		Err:     err,
+	}
+}
+
+// NewValidationError creates a validation error with optional details.
+func NewValidationError(message string, details ...string) *ExitError {
+	return NewTypedError(ErrorTypeValidation, message, nil, details...)
+}
+
+// configLoadError reports a failure to load the project configuration.
+func configLoadError(err error) error {
+	return NewTypedError(ErrorTypeConfig, "failed to load config", err)
+}
+
+// databaseLoadError reports a failure to read the RTM database.
+func databaseLoadError(err error) error {
+	return NewTypedError(ErrorTypeIO, "failed to load database", err)
+}
+
+// databaseSaveError reports a failure to write the RTM database.
+func databaseSaveError(err error) error {
+	return NewTypedError(ErrorTypeIO, "failed to save database", err)
+}
+
+// classifyError returns the type of err. Untyped errors are classified
+// from what they wrap, so filesystem and network errors are still told apart.
+func classifyError(err error) ErrorType {
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) && exitErr.Type != "" {
+		return exitErr.Type
+	}
+
+	var netErr net.Error
+	var urlErr *url.Error
+	if errors.As(err, &netErr) || errors.As(err, &urlErr) {
+		return ErrorTypeNetwork
+	}
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return ErrorTypeIO
+	}
+	return ErrorTypeGeneral
+}
+
+// ExitCode returns the process exit code for an error returned by Execute.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return classifyError(err).ExitCode()
+}
+
+// errorEnvelope is the JSON shape of an error under --format json.
+type errorEnvelope struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Type    ErrorType `json:"type"`
+	Message string    `json:"message"`
+	Details []string  `json:"details"`
+}
+
+// wantsJSON reports whether cmd was asked for JSON output, via
+// --format json or a --json flag.
+func wantsJSON(cmd *cobra.Command) bool {
+	if cmd == nil {
+		return false
+	}
+	if f := cmd.Flags().Lookup("format"); f != nil && f.Value.String() == "json" {
+		return true
+	}
+	if f := cmd.Flags().Lookup("json"); f != nil && f.Value.String() == "true" {
+		return true
+	}
+	return false
+}
+
+// reportError writes err for the user: a JSON envelope on stdout when the
+// command was asked for JSON output, otherwise a plain message on stderr.
+// Bare exit codes (an ExitError with no message) carry no error to
+// report in JSON; the command has already written its output.
+func reportError(cmd *cobra.Command, err error, stderr io.Writer) {
+	if wantsJSON(cmd) {
+		var exitErr *ExitError
+		if errors.As(err, &exitErr) && exitErr.Message == "" && exitErr.Err == nil {
+			return
+		}
+		body := errorBody{Type: classifyError(err), Message: err.Error(), Details: []string{}}
+		if errors.As(err, &exitErr) && len(exitErr.Details) > 0 {
+			body.Details = exitErr.Details
+		}
+		data, _ := json.MarshalIndent(errorEnvelope{Error: body}, "", "  ")
+		fmt.Fprintln(cmd.OutOrStdout(), string(data))
+		return
+	}
+	fmt.Fprintf(stderr, "Error: %s\n", err.Error())
+}
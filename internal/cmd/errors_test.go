@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// runConfigValidate runs `rtmx config` through executeRoot with args and
+// returns stdout, stderr and the error.
+func runConfigValidate(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+
+	origValidate, origFormat := configValidate, configFormat
+	t.Cleanup(func() {
+		configValidate, configFormat = origValidate, origFormat
+		for _, name := range []string{"validate", "format"} {
+			configCmd.Flags().Lookup(name).Changed = false
+		}
+		rootCmd.SetArgs(nil)
+		rootCmd.SetOut(nil)
+	})
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	// The database is never created, so validation fails
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+	var stdout, stderr bytes.Buffer
+	rootCmd.SetOut(&stdout)
+	rootCmd.SetArgs(append([]string{"config"}, args...))
+	err := executeRoot(rootCmd, &stderr)
+	return stdout.String(), stderr.String(), err
+}
+
+func TestJSONErrorEnvelope(t *testing.T) {
+	stdout, stderr, err := runConfigValidate(t, "--validate", "--format", "json")
+	if err == nil {
+		t.Fatal("Expected validation to fail")
+	}
+	if code := ExitCode(err); code != 1 {
+		t.Errorf("ExitCode = %d, want 1", code)
+	}
+	if stderr != "" {
+		t.Errorf("Expected no stderr output in JSON mode, got %q", stderr)
+	}
+
+	var envelope struct {
+		Error struct {
+			Type    string   `json:"type"`
+			Message string   `json:"message"`
+			Details []string `json:"details"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &envelope); err != nil {
+		t.Fatalf("Expected JSON envelope on stdout, got %q: %v", stdout, err)
+	}
+	if envelope.Error.Type != "validation" {
+		t.Errorf("type = %q, want validation", envelope.Error.Type)
+	}
+	if envelope.Error.Message != "configuration validation failed" {
+		t.Errorf("message = %q", envelope.Error.Message)
+	}
+	if len(envelope.Error.Details) != 1 || !strings.Contains(envelope.Error.Details[0], "Database not found") {
+		t.Errorf("details = %v", envelope.Error.Details)
+	}
+}
+
+func TestHumanErrorOutput(t *testing.T) {
+	stdout, stderr, err := runConfigValidate(t, "--validate")
+	if err == nil {
+		t.Fatal("Expected validation to fail")
+	}
+	if stderr != "Error: configuration validation failed\n" {
+		t.Errorf("stderr = %q", stderr)
+	}
+	if strings.Contains(stdout, `"error"`) {
+		t.Errorf("Did not expect a JSON envelope in human mode:\n%s", stdout)
+	}
+}
+
+func TestJSONErrorEnvelopeFlagError(t *testing.T) {
+	stdout, _, err := runConfigValidate(t, "--format", "json", "--bogus")
+	if err == nil {
+		t.Fatal("Expected unknown flag to fail")
+	}
+	if !strings.Contains(stdout, `"type": "validation"`) {
+		t.Errorf("Expected validation envelope for a bad flag, got:\n%s", stdout)
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantType ErrorType
+		wantCode int
+	}{
+		{"validation", NewValidationError("bad input"), ErrorTypeValidation, 1},
+		{"config", configLoadError(errors.New("bad yaml")), ErrorTypeConfig, 5},
+		{"database load", databaseLoadError(errors.New("bad csv")), ErrorTypeIO, 3},
+		{"wrapped path error", fmt.Errorf("reading: %w", &os.PathError{Op: "open", Path: "x", Err: os.ErrNotExist}), ErrorTypeIO, 3},
+		{"url error", &url.Error{Op: "Get", URL: "https://example.com", Err: errors.New("refused")}, ErrorTypeNetwork, 4},
+		{"network", NewTypedError(ErrorTypeNetwork, "connection failed", nil), ErrorTypeNetwork, 4},
+		{"bare exit code", NewExitError(2, ""), ErrorTypeGeneral, 2},
+		{"plain", errors.New("boom"), ErrorTypeGeneral, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyError(tt.err); got != tt.wantType {
+				t.Errorf("classifyError = %q, want %q", got, tt.wantType)
+			}
+			if got := ExitCode(tt.err); got != tt.wantCode {
+				t.Errorf("ExitCode = %d, want %d", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestExitErrorMessage(t *testing.T) {
+	err := configLoadError(errors.New("bad yaml"))
+	if err.Error() != "failed to load config: bad yaml" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+	if !strings.Contains(NewExitError(3, "").Error(), "exit code 3") {
+		t.Errorf("Expected bare exit code message")
+	}
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var explainStatusFormat string
+
+var explainStatusCmd = &cobra.Command{
+	Use:   "explain-status REQ-ID",
+	Short: "Explain how a requirement's status was derived",
+	Long: `Show the evidence behind a requirement's status: its linked tests, their
+results from the last verify run that covered it, the strategy that
+derived the status and when.
+
+A status with no verification evidence, or one that differs from what
+verify last derived, is flagged as set manually. The evidence is kept in
+.rtmx/cache, which is not committed: in a checkout where verify has not
+run, no verification record is available and nothing is flagged.
+
+Examples:
+    rtmx explain-status REQ-AUTH-001
+    rtmx explain-status REQ-AUTH-001 --format json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExplainStatus,
+}
+
+func init() {
+	explainStatusCmd.Flags().StringVar(&explainStatusFormat, "format", "terminal", "output format: terminal, json")
+	rootCmd.AddCommand(explainStatusCmd)
+}
+
+// statusExplanation is why a requirement has its status.
+type statusExplanation struct {
+	ReqID      string          `json:"req_id"`
+	Status     database.Status `json:"status"`
+	LinkedTest string          `json:"linked_test,omitempty"`
+	Evidence   *verifyEvidence `json:"evidence,omitempty"`
+	Manual     bool            `json:"manual"`
+	NoRecord   bool            `json:"no_record,omitempty"`
+	Reason     string          `json:"reason"`
+}
+
+func runExplainStatus(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	if explainStatusFormat != "terminal" && explainStatusFormat != "json" {
+		return NewValidationError(fmt.Sprintf("invalid format %q (use terminal or json)", explainStatusFormat))
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	db, err := database.Load(cfg.DatabasePath(cwd))
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	req := db.Get(args[0])
+	if req == nil {
+		return fmt.Errorf("requirement %q not found", args[0])
+	}
+
+	history, err := loadVerifyHistory(cwd)
+	if err != nil {
+		return err
+	}
+
+	explanation := explainStatus(req, history)
+	if explainStatusFormat == "json" {
+		data, err := json.MarshalIndent(explanation, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize explanation: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	}
+	displayStatusExplanation(cmd, explanation)
+	return nil
+}
+
+// explainStatus compares req's status with the evidence verify recorded
+// for it. history is nil when no verify run was recorded in this checkout,
+// in which case the status cannot be judged.
+func explainStatus(req *database.Requirement, history *verifyHistory) *statusExplanation {
+	e := &statusExplanation{ReqID: req.ReqID, Status: req.Status}
+	if req.HasTest() {
+		e.LinkedTest = req.TestModule + "::" + req.TestFunction
+	}
+	if history != nil {
+		e.Evidence = history.Evidence[req.ReqID]
+	}
+
+	switch {
+	case history == nil:
+		e.NoRecord = true
+		e.Reason = "No verification record available: run rtmx verify to record evidence"
+	case e.Evidence == nil && req.Status == database.StatusMissing:
+		e.Reason = "Not verified yet"
+	case e.Evidence == nil:
+		e.Manual = true
+		e.Reason = fmt.Sprintf("No verification evidence: %s was set manually", req.Status)
+	case e.Evidence.Status != req.Status:
+		e.Manual = true
+		e.Reason = fmt.Sprintf("Status differs from the %s verify last derived: it was changed manually or verify ran without --update", e.Evidence.Status)
+	default:
+		e.Reason = fmt.Sprintf("Derived by verify (%s) from %d passing and %d failing test(s)",
+			e.Evidence.Strategy, e.Evidence.Passed, e.Evidence.Failed)
+	}
+	return e
+}
+
+func displayStatusExplanation(cmd *cobra.Command, e *statusExplanation) {
+	cmd.Println(output.Header("Status: "+e.ReqID, 60))
+	cmd.Println()
+	cmd.Printf("Status:        %s %s\n", output.StatusIcon(e.Status.String()), e.Status)
+	linked := e.LinkedTest
+	if linked == "" {
+		linked = output.Color("(none)", output.Dim)
+	}
+	cmd.Printf("Linked test:   %s\n", linked)
+
+	if e.Evidence != nil {
+		cmd.Printf("Last verified: %s (strategy %s)\n", e.Evidence.Time, e.Evidence.Strategy)
+		cmd.Printf("Tests:         %d passed, %d failed, %d skipped\n", e.Evidence.Passed, e.Evidence.Failed, e.Evidence.Skipped)
+		for _, t := range e.Evidence.Tests {
+			icon := output.Color("✓", output.Green)
+			switch t.Result {
+			case "fail":
+				icon = output.Color("✗", output.Red)
+			case "skip":
+				icon = output.Color("-", output.Dim)
+			}
+			name := t.Test
+			if t.Package != "" {
+				name = t.Package + " " + t.Test
+			}
+			cmd.Printf("  %s %s\n", icon, name)
+		}
+	} else if e.NoRecord {
+		cmd.Printf("Last verified: %s\n", output.Color("unknown", output.Dim))
+	} else {
+		cmd.Printf("Last verified: %s\n", output.Color("never", output.Dim))
+	}
+	cmd.Println()
+
+	icon := output.Color("✓", output.Green)
+	switch {
+	case e.Manual:
+		icon = output.Color("!", output.Yellow)
+	case e.NoRecord:
+		icon = output.Color("?", output.Dim)
+	}
+	cmd.Printf("%s %s\n", icon, e.Reason)
+}
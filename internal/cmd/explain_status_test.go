@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+func TestExplainStatus(t *testing.T) {
+	origFormat := explainStatusFormat
+	defer func() { explainStatusFormat = origFormat }()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	db := database.NewDatabase()
+	for _, id := range []string{"REQ-EXP-001", "REQ-EXP-002"} {
+		req := database.NewRequirement(id)
+		req.Category = "EXP"
+		req.RequirementText = id
+		req.Status = database.StatusComplete
+		_ = db.Add(req)
+	}
+	db.Get("REQ-EXP-001").TestModule = "exp/exp_test.go"
+	db.Get("REQ-EXP-001").TestFunction = "TestExplain"
+	if err := db.Save(filepath.Join(tmpDir, ".rtmx", "database.csv")); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	// Only REQ-EXP-001 was verified, by a passing test
+	history := recordVerifyRun(nil, []VerificationResult{{
+		ReqID:       "REQ-EXP-001",
+		TestsTotal:  1,
+		TestsPassed: 1,
+		NewStatus:   database.StatusComplete,
+		Strategy:    "all-pass",
+		Tests:       []*TestResult{{Package: "example/exp", Test: "TestExplain", Passed: true}},
+	}}, true, time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+	if err := saveVerifyHistory(tmpDir, history); err != nil {
+		t.Fatalf("saveVerifyHistory failed: %v", err)
+	}
+
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	explain := func(id string) string {
+		var buf bytes.Buffer
+		explainStatusCmd.SetOut(&buf)
+		defer explainStatusCmd.SetOut(nil)
+		if err := runExplainStatus(explainStatusCmd, []string{id}); err != nil {
+			t.Fatalf("explain-status %s failed: %v", id, err)
+		}
+		return buf.String()
+	}
+
+	explainStatusFormat = "terminal"
+	out := explain("REQ-EXP-001")
+	for _, want := range []string{"exp/exp_test.go::TestExplain", "2025-06-01T12:00:00Z (strategy all-pass)", "1 passed, 0 failed", "example/exp TestExplain", "Derived by verify (all-pass)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in explanation:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "manually") {
+		t.Errorf("Verified COMPLETE should not be flagged as manual:\n%s", out)
+	}
+
+	out = explain("REQ-EXP-002")
+	if !strings.Contains(out, "No verification evidence: COMPLETE was set manually") || !strings.Contains(out, "never") {
+		t.Errorf("Expected REQ-EXP-002 to be flagged as set manually:\n%s", out)
+	}
+
+	explainStatusFormat = "json"
+	var parsed statusExplanation
+	if err := json.Unmarshal([]byte(explain("REQ-EXP-002")), &parsed); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if !parsed.Manual || parsed.Evidence != nil {
+		t.Errorf("JSON explanation = %+v, want manual without evidence", parsed)
+	}
+}
+
+func TestExplainStatusStaleEvidence(t *testing.T) {
+	req := database.NewRequirement("REQ-EXP-003")
+	req.Status = database.StatusComplete
+	history := &verifyHistory{Evidence: map[string]*verifyEvidence{
+		"REQ-EXP-003": {Status: database.StatusPartial, Strategy: "all-pass", Failed: 1},
+	}}
+	e := explainStatus(req, history)
+	if !e.Manual || !strings.Contains(e.Reason, "PARTIAL verify last derived") {
+		t.Errorf("explainStatus = %+v, want a manual flag naming the derived PARTIAL", e)
+	}
+
+	if e := explainStatus(database.NewRequirement("REQ-EXP-004"), &verifyHistory{}); e.Manual {
+		t.Errorf("An unverified MISSING requirement should not be flagged as manual: %+v", e)
+	}
+}
+
+func TestExplainStatusWithoutRecord(t *testing.T) {
+	req := database.NewRequirement("REQ-EXP-005")
+	req.Status = database.StatusComplete
+
+	// The verify record is not committed, so a fresh checkout has none
+	e := explainStatus(req, nil)
+	if e.Manual || !e.NoRecord || !strings.Contains(e.Reason, "No verification record available") {
+		t.Errorf("explainStatus = %+v, want no record rather than a manual flag", e)
+	}
+}
@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportFormat   string
+	exportOutput   string
+	exportCategory string
+	exportPhase    int
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export RTM metrics and views for other tools",
+	Long: `Export the RTM in a format consumed by other tools.
+
+Formats:
+  prometheus  Gauges in the Prometheus text exposition format:
+              rtmx_requirements_total{category,phase,status},
+              rtmx_requirements_complete{category,phase},
+              rtmx_completion_percent and
+              rtmx_category_completion_percent{category}.
+              Requirements without a phase have an empty phase label.
+  kanban      CSV with a column per status and a "REQ-ID: text" cell per
+              requirement, for pasting into a spreadsheet board. Every
+              built-in status has a column, even an empty one. --category
+              and --phase limit the requirements exported.
+
+Examples:
+    rtmx export --format prometheus
+    rtmx export --format prometheus -o /var/lib/node_exporter/rtmx.prom
+    rtmx export --format kanban --phase 2 -o board.csv`,
+	Args: cobra.NoArgs,
+	RunE: runExportCmd,
+}
+
+func init() {
+	exportCmd.Flags().StringVar(&exportFormat, "format", "", "export format: prometheus, kanban (required)")
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "output file")
+	exportCmd.Flags().StringVar(&exportCategory, "category", "", "only export requirements in this category (kanban)")
+	exportCmd.Flags().IntVar(&exportPhase, "phase", 0, "only export requirements in this phase (kanban)")
+
+	rootCmd.AddCommand(exportCmd)
+}
+
+func runExportCmd(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	switch exportFormat {
+	case "prometheus", "kanban":
+	case "":
+		return NewValidationError("specify --format", "use prometheus or kanban")
+	default:
+		return NewValidationError(fmt.Sprintf("unsupported export format %q", exportFormat), "use prometheus or kanban")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	db, err := database.Load(cfg.DatabasePath(cwd))
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	var content string
+	switch exportFormat {
+	case "kanban":
+		content, err = formatKanban(exportRequirements(db, exportCategory, exportPhase))
+		if err != nil {
+			return err
+		}
+	default:
+		content = formatPrometheus(db)
+	}
+
+	if exportOutput != "" {
+		if err := os.WriteFile(exportOutput, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+		cmd.Printf("%s Written to %s\n", output.Color("✓", output.Green), exportOutput)
+		return nil
+	}
+	cmd.Print(content)
+	return nil
+}
+
+// formatPrometheus renders RTM gauges in the Prometheus text exposition
+// format. Series are sorted by label so the output is stable.
+func formatPrometheus(db *database.Database) string {
+	// Counts keyed by their rendered label set
+	totals := make(map[string]int)
+	complete := make(map[string]int)
+	for _, req := range db.All() {
+		phase := ""
+		if req.Phase > 0 {
+			phase = strconv.Itoa(req.Phase)
+		}
+		labels := fmt.Sprintf("category=%s,phase=%s", promLabel(req.Category), promLabel(phase))
+		totals[labels+",status="+promLabel(req.Status.String())]++
+		if req.Status.IsComplete() {
+			complete[labels]++
+		}
+	}
+
+	var sb strings.Builder
+	writeGauges := func(name, help string, counts map[string]int) {
+		fmt.Fprintf(&sb, "# HELP %s %s\n# TYPE %s gauge\n", name, help, name)
+		labels := make([]string, 0, len(counts))
+		for l := range counts {
+			labels = append(labels, l)
+		}
+		sort.Strings(labels)
+		for _, l := range labels {
+			fmt.Fprintf(&sb, "%s{%s} %d\n", name, l, counts[l])
+		}
+	}
+
+	writeGauges("rtmx_requirements_total", "Number of requirements by category, phase and status.", totals)
+	writeGauges("rtmx_requirements_complete", "Number of complete requirements by category and phase.", complete)
+
+	sb.WriteString("# HELP rtmx_completion_percent Overall completion percentage.\n")
+	sb.WriteString("# TYPE rtmx_completion_percent gauge\n")
+	fmt.Fprintf(&sb, "rtmx_completion_percent %s\n", promValue(db.CompletionPercentage()))
+
+	sb.WriteString("# HELP rtmx_category_completion_percent Completion percentage by category.\n")
+	sb.WriteString("# TYPE rtmx_category_completion_percent gauge\n")
+	byCategory := db.ByCategory()
+	for _, cat := range db.Categories() {
+		fmt.Fprintf(&sb, "rtmx_category_completion_percent{category=%s} %s\n",
+			promLabel(cat), promValue(phaseCompletion(byCategory[cat])))
+	}
+
+	return sb.String()
+}
+
+// kanbanCellWidth bounds the requirement text in a kanban cell.
+const kanbanCellWidth = 60
+
+// exportRequirements returns the requirements in category and phase, when
+// set, in database order.
+func exportRequirements(db *database.Database, category string, phase int) []*database.Requirement {
+	var reqs []*database.Requirement
+	for _, req := range db.All() {
+		if category != "" && !strings.EqualFold(req.Category, category) {
+			continue
+		}
+		if phase != 0 && req.Phase != phase {
+			continue
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs
+}
+
+// formatKanban renders reqs as CSV with a column per status, built-in
+// statuses first and then any others in use, and each requirement as a
+// cell under its status.
+func formatKanban(reqs []*database.Requirement) (string, error) {
+	columns := database.AllStatuses()
+	cells := make(map[database.Status][]string)
+	for _, s := range columns {
+		cells[s] = nil
+	}
+	var custom []string
+	for _, req := range reqs {
+		if _, ok := cells[req.Status]; !ok {
+			custom = append(custom, req.Status.String())
+		}
+		cells[req.Status] = append(cells[req.Status],
+			req.ReqID+": "+output.TruncateCell(req.RequirementText, kanbanCellWidth))
+	}
+	sort.Strings(custom)
+	for _, s := range custom {
+		columns = append(columns, database.Status(s))
+	}
+
+	rows := 0
+	header := make([]string, len(columns))
+	for i, s := range columns {
+		header[i] = s.String()
+		if len(cells[s]) > rows {
+			rows = len(cells[s])
+		}
+	}
+
+	var sb strings.Builder
+	w := csv.NewWriter(&sb)
+	_ = w.Write(header)
+	for r := 0; r < rows; r++ {
+		row := make([]string, len(columns))
+		for i, s := range columns {
+			if r < len(cells[s]) {
+				row[i] = cells[s][r]
+			}
+		}
+		_ = w.Write(row)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("failed to write kanban CSV: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// promLabel quotes a label value, escaping backslashes, quotes and
+// newlines as the exposition format requires.
+func promLabel(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `"`, `\"`)
+	v = strings.ReplaceAll(v, "\n", `\n`)
+	return `"` + v + `"`
+}
+
+// promValue formats a sample value.
+func promValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
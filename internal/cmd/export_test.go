@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// promLine matches a sample line of the Prometheus text exposition format.
+var promLine = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*(\{([a-zA-Z_][a-zA-Z0-9_]*="([^"\\]|\\.)*",?)*\})? -?[0-9]+(\.[0-9]+)?$`)
+
+func TestExportPrometheus(t *testing.T) {
+	tmpDir := setupTestProject(t, "REQ-EXPORT-001", "REQ-EXPORT-002", "REQ-EXPORT-003", "REQ-API-001")
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	db, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	for _, id := range []string{"REQ-EXPORT-001", "REQ-EXPORT-002", "REQ-EXPORT-003"} {
+		db.Get(id).Phase = 1
+	}
+	db.Get("REQ-EXPORT-001").Status = database.StatusComplete
+	db.Get("REQ-EXPORT-002").Status = database.StatusComplete
+	db.Get("REQ-API-001").Status = database.StatusPartial
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	origFormat, origOutput := exportFormat, exportOutput
+	defer func() { exportFormat, exportOutput = origFormat, origOutput }()
+	exportFormat, exportOutput = "prometheus", ""
+
+	var buf bytes.Buffer
+	exportCmd.SetOut(&buf)
+	if err := runExportCmd(exportCmd, nil); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+	out := buf.String()
+
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+		if strings.HasPrefix(line, "# HELP ") || strings.HasPrefix(line, "# TYPE ") {
+			continue
+		}
+		if !promLine.MatchString(line) {
+			t.Errorf("Malformed metric line %q", line)
+		}
+	}
+
+	want := []string{
+		"# TYPE rtmx_requirements_total gauge",
+		`rtmx_requirements_total{category="API",phase="",status="PARTIAL"} 1`,
+		`rtmx_requirements_total{category="EXPORT",phase="1",status="COMPLETE"} 2`,
+		`rtmx_requirements_total{category="EXPORT",phase="1",status="MISSING"} 1`,
+		`rtmx_requirements_complete{category="EXPORT",phase="1"} 2`,
+		"rtmx_completion_percent 62.5",
+		`rtmx_category_completion_percent{category="API"} 50`,
+	}
+	for _, line := range want {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("Expected line %q, got:\n%s", line, out)
+		}
+	}
+	if strings.Contains(out, `rtmx_requirements_complete{category="API"`) {
+		t.Errorf("Expected no complete series for a category without complete requirements, got:\n%s", out)
+	}
+}
+
+func TestPromLabelEscaping(t *testing.T) {
+	if got, want := promLabel("a\"b\\c\nd"), `"a\"b\\c\nd"`; got != want {
+		t.Errorf("promLabel = %s, want %s", got, want)
+	}
+}
+
+func TestExportKanban(t *testing.T) {
+	tmpDir := setupTestProject(t, "REQ-EXPORT-001", "REQ-EXPORT-002", "REQ-EXPORT-003", "REQ-API-001")
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	db, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	db.Get("REQ-EXPORT-001").Status = database.StatusComplete
+	db.Get("REQ-EXPORT-001").RequirementText = "Users can log in, with SSO"
+	db.Get("REQ-EXPORT-002").Status = database.StatusPartial
+	db.Get("REQ-EXPORT-002").Phase = 2
+	db.Get("REQ-API-001").Status = database.StatusComplete
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	origFormat, origOutput, origCategory, origPhase := exportFormat, exportOutput, exportCategory, exportPhase
+	defer func() {
+		exportFormat, exportOutput, exportCategory, exportPhase = origFormat, origOutput, origCategory, origPhase
+	}()
+
+	tests := []struct {
+		name     string
+		category string
+		phase    int
+		want     [][]string
+	}{
+		{
+			name: "all",
+			want: [][]string{
+				{"COMPLETE", "PARTIAL", "MISSING", "NOT_STARTED"},
+				{"REQ-EXPORT-001: Users can log in, with SSO", "REQ-EXPORT-002: Requirement REQ-EXPORT-002", "REQ-EXPORT-003: Requirement REQ-EXPORT-003", ""},
+				{"REQ-API-001: Requirement REQ-API-001", "", "", ""},
+			},
+		},
+		{
+			name:     "category",
+			category: "export",
+			want: [][]string{
+				{"COMPLETE", "PARTIAL", "MISSING", "NOT_STARTED"},
+				{"REQ-EXPORT-001: Users can log in, with SSO", "REQ-EXPORT-002: Requirement REQ-EXPORT-002", "REQ-EXPORT-003: Requirement REQ-EXPORT-003", ""},
+			},
+		},
+		{
+			name:  "phase",
+			phase: 2,
+			want: [][]string{
+				{"COMPLETE", "PARTIAL", "MISSING", "NOT_STARTED"},
+				{"", "REQ-EXPORT-002: Requirement REQ-EXPORT-002", "", ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exportFormat, exportOutput, exportCategory, exportPhase = "kanban", "", tt.category, tt.phase
+
+			var buf bytes.Buffer
+			exportCmd.SetOut(&buf)
+			if err := runExportCmd(exportCmd, nil); err != nil {
+				t.Fatalf("export failed: %v", err)
+			}
+			rows, err := csv.NewReader(&buf).ReadAll()
+			if err != nil {
+				t.Fatalf("Expected valid CSV: %v", err)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("rows = %q, want %q", rows, tt.want)
+			}
+			for i := range rows {
+				if strings.Join(rows[i], "|") != strings.Join(tt.want[i], "|") {
+					t.Errorf("row %d = %q, want %q", i, rows[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
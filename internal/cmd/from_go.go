@@ -108,13 +108,13 @@ func runFromGo(cmd *cobra.Command, args []string) error {
 
 	cfg, err := config.LoadFromDir(cwd)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return configLoadError(err)
 	}
 
 	dbPath := cfg.DatabasePath(cwd)
 	db, err := database.Load(dbPath)
 	if err != nil && fromGoUpdate {
-		return fmt.Errorf("failed to load database: %w", err)
+		return databaseLoadError(err)
 	}
 
 	// Group results by requirement
@@ -202,7 +202,7 @@ func runFromGo(cmd *cobra.Command, args []string) error {
 		}
 
 		if err := db.Save(dbPath); err != nil {
-			return fmt.Errorf("failed to save database: %w", err)
+			return databaseSaveError(err)
 		}
 		cmd.Printf("\n%s Database saved\n", output.Color("✓", output.Green))
 	} else if fromGoDryRun {
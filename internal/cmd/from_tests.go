@@ -11,6 +11,7 @@ import (
 
 	"github.com/rtmx-ai/rtmx-go/internal/config"
 	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/markers"
 	"github.com/rtmx-ai/rtmx-go/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -24,10 +25,13 @@ var (
 var fromTestsCmd = &cobra.Command{
 	Use:   "from-tests [test_path]",
 	Short: "Scan test files for requirement markers",
-	Long: `Scan test files for @pytest.mark.req() markers and report coverage.
+	Long: `Scan test files for requirement markers and report coverage.
 
-This command parses Python test files to find requirement markers and
-shows which requirements have tests linked to them.
+This command parses test files to find requirement markers and shows
+which requirements have tests linked to them. Built-in rules recognize
+@pytest.mark.req() markers, Go "// REQ-..." comments and rtmx.Req calls,
+and JS/TS "@req" JSDoc tags and describe/it/test titles. Additional
+rules can be configured under markers.rules in rtmx.yaml.
 
 Examples:
   rtmx from-tests                 # Scan tests/ directory
@@ -71,14 +75,25 @@ func runFromTests(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("test path does not exist: %s", testPath)
 	}
 
+	// Load RTM config for marker rules and the database
+	cwd, _ := os.Getwd()
+	cfg, cfgErr := config.LoadFromDir(cwd)
+	if cfgErr != nil {
+		cfg = nil
+	}
+	extractor, err := markers.ForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("invalid marker rules: %w", err)
+	}
+
 	cmd.Printf("Scanning %s for requirement markers...\n\n", testPath)
 
 	// Scan for markers
 	var markers []TestRequirement
 	if info.IsDir() {
-		markers, err = scanTestDirectory(testPath)
+		markers, err = scanTestDirectoryWith(testPath, extractor)
 	} else {
-		markers, err = extractMarkersFromFile(testPath)
+		markers, err = extractTestMarkers(testPath, extractor)
 	}
 	if err != nil {
 		return fmt.Errorf("failed to scan tests: %w", err)
@@ -98,13 +113,11 @@ func runFromTests(cmd *cobra.Command, args []string) error {
 	cmd.Printf("Found %d test(s) linked to %d requirement(s)\n\n", len(markers), len(byReq))
 
 	// Load RTM database
-	cwd, _ := os.Getwd()
-	cfg, err := config.LoadFromDir(cwd)
 	var db *database.Database
 	var dbReqs map[string]bool
 	dbPath := ""
 
-	if err == nil {
+	if cfg != nil {
 		dbPath = cfg.DatabasePath(cwd)
 		db, err = database.Load(dbPath)
 		if err == nil {
@@ -240,8 +253,13 @@ func runFromTests(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// scanTestDirectory scans a directory for Python test files
+// scanTestDirectory scans a directory for test files using the built-in marker rules
 func scanTestDirectory(dir string) ([]TestRequirement, error) {
+	return scanTestDirectoryWith(dir, markers.Default())
+}
+
+// scanTestDirectoryWith scans a directory for test files matched by the extractor's rules
+func scanTestDirectoryWith(dir string, extractor *markers.Extractor) ([]TestRequirement, error) {
 	var results []TestRequirement
 
 	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
@@ -249,20 +267,17 @@ func scanTestDirectory(dir string) ([]TestRequirement, error) {
 			return err
 		}
 
-		// Skip directories and non-Python files
-		if info.IsDir() {
+		// Skip directories and non-test files
+		if info.IsDir() || !extractor.IsTestFile(path) {
 			return nil
 		}
 
-		// Match test_*.py pattern
-		if strings.HasPrefix(filepath.Base(path), "test_") && strings.HasSuffix(path, ".py") {
-			markers, err := extractMarkersFromFile(path)
-			if err != nil {
-				// Skip files that can't be parsed
-				return nil
-			}
-			results = append(results, markers...)
+		found, err := extractTestMarkers(path, extractor)
+		if err != nil {
+			// Skip files that can't be parsed
+			return nil
 		}
+		results = append(results, found...)
 
 		return nil
 	})
@@ -270,8 +285,61 @@ func scanTestDirectory(dir string) ([]TestRequirement, error) {
 	return results, err
 }
 
+// extractTestMarkers extracts requirement markers from a test file.
+// Python files are parsed for pytest metadata first; markers from the
+// configured rules that pytest parsing did not find are added after.
+func extractTestMarkers(filePath string, extractor *markers.Extractor) ([]TestRequirement, error) {
+	var results []TestRequirement
+	seen := make(map[string]bool)
+
+	if strings.HasSuffix(filePath, ".py") {
+		pyResults, err := extractPytestMarkers(filePath, extractor.IDPrefix())
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range pyResults {
+			fn := r.TestFunction
+			if idx := strings.LastIndex(fn, "::"); idx >= 0 {
+				fn = fn[idx+2:]
+			}
+			seen[r.ReqID+"|"+fn] = true
+		}
+		results = pyResults
+	}
+
+	scan, err := extractor.ExtractFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range scan.Markers {
+		if seen[m.ReqID+"|"+m.Function] {
+			continue
+		}
+		seen[m.ReqID+"|"+m.Function] = true
+
+		line := m.FunctionLine
+		if line == 0 {
+			line = m.Line
+		}
+		results = append(results, TestRequirement{
+			ReqID:        m.ReqID,
+			TestFile:     filePath,
+			TestFunction: m.Function,
+			LineNumber:   line,
+		})
+	}
+
+	return results, nil
+}
+
 // extractMarkersFromFile extracts requirement markers from a Python test file
 func extractMarkersFromFile(filePath string) ([]TestRequirement, error) {
+	return extractPytestMarkers(filePath, config.DefaultIDPrefix)
+}
+
+// extractPytestMarkers extracts markers for requirement IDs starting with
+// prefix from a Python test file
+func extractPytestMarkers(filePath string, prefix string) ([]TestRequirement, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
 		return nil, err
@@ -281,7 +349,7 @@ func extractMarkersFromFile(filePath string) ([]TestRequirement, error) {
 	var results []TestRequirement
 
 	// Regex patterns for pytest markers
-	reqMarkerPattern := regexp.MustCompile(`@pytest\.mark\.req\(['"](REQ-[A-Z0-9-]+)['"]\)`)
+	reqMarkerPattern := regexp.MustCompile(`@` + markers.PytestMarker(prefix) + `\(['"](` + regexp.QuoteMeta(prefix) + `-[A-Z0-9_-]+)['"]\)`)
 	funcPattern := regexp.MustCompile(`^(?:async\s+)?def\s+(test_\w+)\s*\(`)
 	classPattern := regexp.MustCompile(`^class\s+(Test\w+)\s*[:(]`)
 	otherMarkerPattern := regexp.MustCompile(`@pytest\.mark\.(scope_\w+|technique_\w+|env_\w+)`)
@@ -170,3 +170,39 @@ func newTestFromTestsCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&update, "update", false, "update RTM database")
 	return cmd
 }
+
+func TestScanTestDirectoryMultiLanguage(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	goTest := `package auth
+
+// REQ-GO-001
+func TestLogin(t *testing.T) {}
+`
+	jsTest := `describe("REQ-JS-001", () => {
+  it("renders", () => {});
+});
+`
+	_ = os.WriteFile(filepath.Join(tmpDir, "auth_test.go"), []byte(goTest), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "auth.test.js"), []byte(jsTest), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "auth.go"), []byte("// REQ-GO-999\npackage auth\n"), 0644)
+
+	found, err := scanTestDirectory(tmpDir)
+	if err != nil {
+		t.Fatalf("scanTestDirectory failed: %v", err)
+	}
+
+	byReq := make(map[string]string)
+	for _, m := range found {
+		byReq[m.ReqID] = m.TestFunction
+	}
+	if byReq["REQ-GO-001"] != "TestLogin" {
+		t.Errorf("REQ-GO-001 linked to %q, want TestLogin", byReq["REQ-GO-001"])
+	}
+	if _, ok := byReq["REQ-JS-001"]; !ok {
+		t.Error("Expected REQ-JS-001 from describe block")
+	}
+	if _, ok := byReq["REQ-GO-999"]; ok {
+		t.Error("Non-test files should not be scanned")
+	}
+}
@@ -0,0 +1,358 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	gateRelease       string
+	gateCategory      string
+	gatePhase         int
+	gateRequire       []string
+	gateMinCompletion float64
+	gateFormat        string
+)
+
+var gateCmd = &cobra.Command{
+	Use:   "gate",
+	Short: "Check release conditions for CI",
+	Long: `Evaluate release conditions against the RTM and exit with code 1 if any
+is not met, listing the requirements that fail it.
+
+The database can be narrowed with --release, --category and --phase
+before the conditions are checked.
+
+Each --require takes the form <field>:<value>=<STATUS> and demands that
+every requirement whose field has that value reaches at least STATUS
+(MISSING < PARTIAL < COMPLETE). The field is any CSV column, such as
+priority, category or phase. --min-completion demands an overall
+completion percentage, with PARTIAL requirements counting half.
+
+The completion_thresholds in rtmx.yaml are checked as well: each
+category and phase listed there must reach its own minimum, e.g.
+
+    completion_thresholds:
+      categories: {AUTH: 100, DOCS: 50}
+      phases: {1: 90}
+
+The gate fails when --release, --category and --phase leave no
+requirements to check, rather than passing over nothing.
+
+Examples:
+    rtmx gate --release v1.0 --require "priority:P0=COMPLETE"
+    rtmx gate --release v1.0 --require "priority:P0=COMPLETE" --min-completion 90
+    rtmx gate --category AUTH --require "priority:HIGH=PARTIAL" --format json`,
+	RunE: runGate,
+}
+
+func init() {
+	gateCmd.Flags().StringVar(&gateRelease, "release", "", "only check requirements in this release")
+	gateCmd.Flags().StringVar(&gateCategory, "category", "", "only check requirements in this category")
+	gateCmd.Flags().IntVar(&gatePhase, "phase", 0, "only check requirements in this phase")
+	gateCmd.Flags().StringArrayVar(&gateRequire, "require", nil, "condition <field>:<value>=<STATUS> (repeatable)")
+	gateCmd.Flags().Float64Var(&gateMinCompletion, "min-completion", 0, "minimum completion percentage")
+	gateCmd.Flags().StringVar(&gateFormat, "format", "terminal", "output format: terminal, json")
+	rootCmd.AddCommand(gateCmd)
+}
+
+// gateCondition is one parsed --require condition.
+type gateCondition struct {
+	Field  string
+	Value  string
+	Status database.Status
+}
+
+// gateResult is the outcome of one condition.
+type gateResult struct {
+	Condition string   `json:"condition"`
+	Passed    bool     `json:"passed"`
+	Message   string   `json:"message"`
+	Offending []string `json:"offending,omitempty"`
+}
+
+// gateReport is the outcome of the whole gate.
+type gateReport struct {
+	Passed       bool         `json:"passed"`
+	Requirements int          `json:"requirements"`
+	Completion   float64      `json:"completion_percent"`
+	Conditions   []gateResult `json:"conditions"`
+}
+
+func runGate(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	if gateFormat != "terminal" && gateFormat != "json" {
+		return NewValidationError(fmt.Sprintf("invalid format %q (use terminal or json)", gateFormat))
+	}
+	if gateMinCompletion < 0 || gateMinCompletion > 100 {
+		return NewValidationError("--min-completion must be between 0 and 100")
+	}
+	conditions := make([]gateCondition, 0, len(gateRequire))
+	for _, spec := range gateRequire {
+		c, err := parseGateCondition(spec)
+		if err != nil {
+			return NewValidationError(err.Error())
+		}
+		conditions = append(conditions, c)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	thresholds := cfg.RTMX.CompletionThresholds
+	if len(conditions) == 0 && gateMinCompletion == 0 && thresholds.IsEmpty() {
+		return NewValidationError("specify at least one --require or --min-completion, or set completion_thresholds in the config")
+	}
+
+	db, err := database.Load(cfg.DatabasePath(cwd))
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	var reqs []*database.Requirement
+	for _, req := range db.All() {
+		if gateRelease != "" && !strings.EqualFold(req.Release, gateRelease) {
+			continue
+		}
+		if gateCategory != "" && !strings.EqualFold(req.Category, gateCategory) {
+			continue
+		}
+		if gatePhase != 0 && req.Phase != gatePhase {
+			continue
+		}
+		reqs = append(reqs, req)
+	}
+	// A gate over nothing would pass vacuously, hiding a mistyped filter
+	if len(reqs) == 0 {
+		if scope := gateScope(); scope != "" {
+			return NewValidationError("no requirements match " + scope)
+		}
+		return NewValidationError("the RTM has no requirements to gate")
+	}
+
+	report := evaluateGate(reqs, conditions, gateMinCompletion)
+	for _, result := range evaluateThresholds(reqs, thresholds) {
+		report.Passed = report.Passed && result.Passed
+		report.Conditions = append(report.Conditions, result)
+	}
+	if gateFormat == "json" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize gate report: %w", err)
+		}
+		cmd.Println(string(data))
+	} else {
+		displayGateReport(cmd, report)
+	}
+
+	if !report.Passed {
+		return NewExitError(1, "")
+	}
+	return nil
+}
+
+// gateScope describes the filters narrowing the gate, or "" for none.
+func gateScope() string {
+	var filters []string
+	if gateRelease != "" {
+		filters = append(filters, "--release "+gateRelease)
+	}
+	if gateCategory != "" {
+		filters = append(filters, "--category "+gateCategory)
+	}
+	if gatePhase != 0 {
+		filters = append(filters, fmt.Sprintf("--phase %d", gatePhase))
+	}
+	return strings.Join(filters, " ")
+}
+
+// parseGateCondition parses "<field>:<value>=<STATUS>".
+func parseGateCondition(spec string) (gateCondition, error) {
+	selector, status, ok := strings.Cut(spec, "=")
+	field, value, hasValue := strings.Cut(selector, ":")
+	field, value = strings.TrimSpace(field), strings.TrimSpace(value)
+	if !ok || !hasValue || field == "" || value == "" {
+		return gateCondition{}, fmt.Errorf("invalid condition %q (use <field>:<value>=<STATUS>, e.g. priority:P0=COMPLETE)", spec)
+	}
+	if strings.TrimSpace(status) == "" {
+		return gateCondition{}, fmt.Errorf("invalid condition %q: missing status", spec)
+	}
+	parsed, err := database.ParseStatus(status)
+	if err != nil {
+		return gateCondition{}, fmt.Errorf("invalid condition %q: %v", spec, err)
+	}
+	return gateCondition{Field: strings.ToLower(field), Value: value, Status: parsed}, nil
+}
+
+func (c gateCondition) String() string {
+	return fmt.Sprintf("%s:%s=%s", c.Field, c.Value, c.Status)
+}
+
+// evaluateGate checks the conditions and minimum completion against reqs.
+func evaluateGate(reqs []*database.Requirement, conditions []gateCondition, minCompletion float64) *gateReport {
+	report := &gateReport{
+		Passed:       true,
+		Requirements: len(reqs),
+		Completion:   completionPercent(reqs),
+		Conditions:   []gateResult{},
+	}
+
+	for _, c := range conditions {
+		result := gateResult{Condition: c.String()}
+		matched := 0
+		for _, req := range reqs {
+			if !strings.EqualFold(strings.TrimSpace(req.Field(c.Field)), c.Value) {
+				continue
+			}
+			matched++
+			// Lower weights are further along
+			if req.Status.Weight() > c.Status.Weight() {
+				result.Offending = append(result.Offending, fmt.Sprintf("%s (%s)", req.ReqID, req.Status))
+			}
+		}
+		result.Passed = len(result.Offending) == 0
+		if result.Passed {
+			result.Message = fmt.Sprintf("%d requirement(s) with %s %s are %s", matched, c.Field, c.Value, c.Status)
+		} else {
+			result.Message = fmt.Sprintf("%d of %d requirement(s) with %s %s are not %s", len(result.Offending), matched, c.Field, c.Value, c.Status)
+		}
+		report.Passed = report.Passed && result.Passed
+		report.Conditions = append(report.Conditions, result)
+	}
+
+	if minCompletion > 0 {
+		result := gateResult{
+			Condition: fmt.Sprintf("completion>=%g%%", minCompletion),
+			Passed:    report.Completion >= minCompletion,
+			Message:   fmt.Sprintf("completion %.1f%% (minimum %g%%)", report.Completion, minCompletion),
+		}
+		report.Passed = report.Passed && result.Passed
+		report.Conditions = append(report.Conditions, result)
+	}
+	return report
+}
+
+// evaluateThresholds checks each configured category and phase threshold
+// against the requirements of reqs in that category or phase. Thresholds
+// for areas without requirements are skipped.
+func evaluateThresholds(reqs []*database.Requirement, thresholds config.CompletionThresholdsConfig) []gateResult {
+	var results []gateResult
+
+	categories := make([]string, 0, len(thresholds.Categories))
+	for category := range thresholds.Categories {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	for _, category := range categories {
+		var area []*database.Requirement
+		for _, req := range reqs {
+			if strings.EqualFold(req.Category, category) {
+				area = append(area, req)
+			}
+		}
+		if r, ok := thresholdResult("category "+category, area, thresholds.Categories[category]); ok {
+			results = append(results, r)
+		}
+	}
+
+	phases := make([]int, 0, len(thresholds.Phases))
+	for phase := range thresholds.Phases {
+		phases = append(phases, phase)
+	}
+	sort.Ints(phases)
+	for _, phase := range phases {
+		var area []*database.Requirement
+		for _, req := range reqs {
+			if req.Phase == phase {
+				area = append(area, req)
+			}
+		}
+		if r, ok := thresholdResult(fmt.Sprintf("phase %d", phase), area, thresholds.Phases[phase]); ok {
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+// thresholdResult checks one area's completion against min. It returns
+// false when the area has no requirements.
+func thresholdResult(name string, area []*database.Requirement, min float64) (gateResult, bool) {
+	if len(area) == 0 {
+		return gateResult{}, false
+	}
+	completion := completionPercent(area)
+	result := gateResult{
+		Condition: fmt.Sprintf("%s>=%g%%", strings.ReplaceAll(name, " ", ":"), min),
+		Passed:    completion >= min,
+		Message:   fmt.Sprintf("%s completion %.1f%% (minimum %g%%)", name, completion, min),
+	}
+	if !result.Passed {
+		for _, req := range area {
+			if !req.IsComplete() {
+				result.Offending = append(result.Offending, fmt.Sprintf("%s (%s)", req.ReqID, req.Status))
+			}
+		}
+	}
+	return result, true
+}
+
+// completionPercent is the average completion of reqs, counting PARTIAL
+// as half done.
+func completionPercent(reqs []*database.Requirement) float64 {
+	if len(reqs) == 0 {
+		return 0
+	}
+	var total float64
+	for _, req := range reqs {
+		total += req.Status.CompletionPercent()
+	}
+	return total / float64(len(reqs))
+}
+
+func displayGateReport(cmd *cobra.Command, report *gateReport) {
+	cmd.Println(output.Header("Release Gate", 60))
+	cmd.Println()
+
+	for _, r := range report.Conditions {
+		icon := output.Color("✓", output.Green)
+		if !r.Passed {
+			icon = output.Color("✗", output.Red)
+		}
+		cmd.Printf("  %s %s: %s\n", icon, r.Condition, r.Message)
+		for _, id := range r.Offending {
+			cmd.Printf("      - %s\n", id)
+		}
+	}
+	cmd.Println()
+
+	if report.Passed {
+		cmd.Printf("%s Gate passed (%d requirements)\n", output.Color("✓", output.Green), report.Requirements)
+		return
+	}
+	failed := 0
+	for _, r := range report.Conditions {
+		if !r.Passed {
+			failed++
+		}
+	}
+	cmd.Printf("%s Gate failed: %d of %d condition(s) not met\n",
+		output.Color("✗", output.Red), failed, len(report.Conditions))
+}
@@ -0,0 +1,232 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// setupGateProject creates a project with two releases: v1.0 is done, v1.1
+// has an incomplete P0.
+func setupGateProject(t *testing.T) {
+	t.Helper()
+
+	tmpDir := setupTestProject(t, "REQ-GATE-001", "REQ-GATE-002", "REQ-GATE-003", "REQ-GATE-004", "REQ-GATE-005")
+	updateTestDatabase(t, tmpDir, func(db *database.Database) {
+		for _, r := range []struct {
+			id, release string
+			priority    database.Priority
+			status      database.Status
+		}{
+			{"REQ-GATE-001", "v1.0", database.PriorityP0, database.StatusComplete},
+			{"REQ-GATE-002", "v1.0", database.PriorityMedium, database.StatusComplete},
+			{"REQ-GATE-003", "v1.1", database.PriorityP0, database.StatusComplete},
+			{"REQ-GATE-004", "v1.1", database.PriorityP0, database.StatusPartial},
+			{"REQ-GATE-005", "v1.1", database.PriorityLow, database.StatusMissing},
+		} {
+			req := db.Get(r.id)
+			req.Release = r.release
+			req.Priority = r.priority
+			req.Status = r.status
+		}
+	})
+
+	origRelease, origCategory, origPhase := gateRelease, gateCategory, gatePhase
+	origRequire, origMin, origFormat := gateRequire, gateMinCompletion, gateFormat
+	t.Cleanup(func() {
+		gateRelease, gateCategory, gatePhase = origRelease, origCategory, origPhase
+		gateRequire, gateMinCompletion, gateFormat = origRequire, origMin, origFormat
+	})
+	gateCategory, gatePhase, gateFormat = "", 0, "terminal"
+}
+
+func TestGatePasses(t *testing.T) {
+	setupGateProject(t)
+	gateRelease = "v1.0"
+	gateRequire = []string{"priority:P0=COMPLETE"}
+	gateMinCompletion = 90
+
+	var buf bytes.Buffer
+	gateCmd.SetOut(&buf)
+	defer gateCmd.SetOut(nil)
+
+	if err := runGate(gateCmd, nil); err != nil {
+		t.Fatalf("runGate failed: %v\n%s", err, buf.String())
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"priority:P0=COMPLETE: 1 requirement(s) with priority P0 are COMPLETE",
+		"completion>=90%: completion 100.0% (minimum 90%)",
+		"Gate passed (2 requirements)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGateFailsListingIncompleteP0s(t *testing.T) {
+	setupGateProject(t)
+	gateRelease = "v1.1"
+	gateRequire = []string{"priority:P0=COMPLETE", "priority:LOW=MISSING"}
+	gateMinCompletion = 90
+
+	var buf bytes.Buffer
+	gateCmd.SetOut(&buf)
+	defer gateCmd.SetOut(nil)
+
+	err := runGate(gateCmd, nil)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != 1 {
+		t.Fatalf("expected exit code 1, got %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"priority:P0=COMPLETE: 1 of 2 requirement(s) with priority P0 are not COMPLETE",
+		"- REQ-GATE-004 (PARTIAL)",
+		"✓ priority:LOW=MISSING",
+		"completion 50.0% (minimum 90%)",
+		"Gate failed: 2 of 3 condition(s) not met",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "REQ-GATE-003") {
+		t.Errorf("complete P0 should not be listed:\n%s", out)
+	}
+
+	// The same report as JSON
+	buf.Reset()
+	gateFormat = "json"
+	if err := runGate(gateCmd, nil); !errors.As(err, &exitErr) {
+		t.Fatalf("expected exit error, got %v", err)
+	}
+	var report gateReport
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if report.Passed || report.Requirements != 3 || len(report.Conditions) != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if got := report.Conditions[0].Offending; len(got) != 1 || got[0] != "REQ-GATE-004 (PARTIAL)" {
+		t.Errorf("offending = %v, want [REQ-GATE-004 (PARTIAL)]", got)
+	}
+}
+
+func TestGateFailsOnEmptyScope(t *testing.T) {
+	setupGateProject(t)
+	gateRelease = "v9.9"
+	gateCategory = "gate"
+	gateRequire = []string{"priority:P0=COMPLETE"}
+	gateMinCompletion = 0
+
+	var buf bytes.Buffer
+	gateCmd.SetOut(&buf)
+	defer gateCmd.SetOut(nil)
+
+	err := runGate(gateCmd, nil)
+	if ExitCode(err) != 1 {
+		t.Fatalf("expected exit code 1 for an empty scope, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "no requirements match --release v9.9 --category gate") {
+		t.Errorf("error should name the filters, got %q", err.Error())
+	}
+	if strings.Contains(buf.String(), "Gate passed") {
+		t.Errorf("gate over no requirements should not pass:\n%s", buf.String())
+	}
+}
+
+func TestParseGateCondition(t *testing.T) {
+	c, err := parseGateCondition("Priority:P0=complete")
+	if err != nil {
+		t.Fatalf("parseGateCondition failed: %v", err)
+	}
+	if c.Field != "priority" || c.Value != "P0" || c.Status != database.StatusComplete {
+		t.Errorf("parsed %+v", c)
+	}
+	for _, bad := range []string{"priority=COMPLETE", "priority:P0", "priority:P0=", "priority:P0=DONE", ":P0=COMPLETE"} {
+		if _, err := parseGateCondition(bad); err == nil {
+			t.Errorf("parseGateCondition(%q) should fail", bad)
+		}
+	}
+}
+
+func TestCompletionThresholds(t *testing.T) {
+	setupGateProject(t)
+	writeTestConfig(t, ".", "  completion_thresholds:\n    categories: {AUTH: 90, DOCS: 50}\n    phases: {2: 50}\n")
+
+	// AUTH is 8 of 10 complete (80%), DOCS 1 of 2 (50%), all in phase 2
+	db := database.NewDatabase()
+	add := func(id, category string, status database.Status) {
+		req := database.NewRequirement(id)
+		req.Category = category
+		req.Phase = 2
+		req.Status = status
+		_ = db.Add(req)
+	}
+	for i := 1; i <= 10; i++ {
+		status := database.StatusComplete
+		if i > 8 {
+			status = database.StatusMissing
+		}
+		add(fmt.Sprintf("REQ-AUTH-%03d", i), "AUTH", status)
+	}
+	add("REQ-DOCS-001", "DOCS", database.StatusComplete)
+	add("REQ-DOCS-002", "DOCS", database.StatusMissing)
+	if err := db.Save(filepath.Join(".rtmx", "database.csv")); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	var buf bytes.Buffer
+	gateCmd.SetOut(&buf)
+	defer gateCmd.SetOut(nil)
+	gateRelease, gateRequire, gateMinCompletion = "", nil, 0
+
+	err := runGate(gateCmd, nil)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != 1 {
+		t.Fatalf("Expected the gate to fail, got %v\n%s", err, buf.String())
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"✗ category:AUTH>=90%: category AUTH completion 80.0% (minimum 90%)",
+		"- REQ-AUTH-009 (MISSING)",
+		"✓ category:DOCS>=50%: category DOCS completion 50.0% (minimum 50%)",
+		"✓ phase:2>=50%: phase 2 completion 75.0% (minimum 50%)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in gate output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "REQ-DOCS-002") {
+		t.Errorf("DOCS meets its threshold and should not list requirements:\n%s", out)
+	}
+
+	result := runHealthChecks(db, ".", config.CompletionThresholdsConfig{
+		Categories: map[string]float64{"AUTH": 90, "DOCS": 50},
+	})
+	var found bool
+	for _, check := range result.Checks {
+		if check.Name != "completion_thresholds" {
+			continue
+		}
+		found = true
+		if check.Status != CheckFail || !strings.Contains(check.Message, "category AUTH completion 80.0%") || strings.Contains(check.Message, "DOCS") {
+			t.Errorf("completion_thresholds check = %+v, want a failure naming only AUTH", check)
+		}
+	}
+	if !found {
+		t.Error("Expected a completion_thresholds health check")
+	}
+	if result.ExitCode != 2 {
+		t.Errorf("health exit code = %d, want 2 for a missed threshold", result.ExitCode)
+	}
+}
@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// rtmGitDriver names the git merge and diff drivers for the RTM database.
+const rtmGitDriver = "rtmx-csv"
+
+// rtmGitAttributesLine returns the .gitattributes entry for the database
+// at dbPath, relative to the directory holding .gitattributes.
+func rtmGitAttributesLine(dbPath string) string {
+	pattern := "/" + strings.TrimPrefix(filepath.ToSlash(filepath.Clean(dbPath)), "/")
+	return fmt.Sprintf("%s text eol=lf diff=%s merge=%s", pattern, rtmGitDriver, rtmGitDriver)
+}
+
+// gitRelativePath returns path relative to dir in slash form, as
+// .gitattributes patterns take it. A path outside dir cannot be matched
+// from there and is an error.
+func gitRelativePath(dir, path string) (string, error) {
+	if filepath.IsAbs(path) {
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return "", fmt.Errorf("database %s is outside %s, so .gitattributes cannot cover it", path, dir)
+		}
+		path = rel
+	}
+	return filepath.ToSlash(filepath.Clean(path)), nil
+}
+
+// writeRTMGitAttributes adds the database's entry to .gitattributes in dir,
+// creating the file if needed. It returns false when the database already
+// has an entry.
+func writeRTMGitAttributes(dir, dbPath string) (bool, error) {
+	path := filepath.Join(dir, ".gitattributes")
+	line := rtmGitAttributesLine(dbPath)
+	pattern := strings.Fields(line)[0]
+
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return false, fmt.Errorf("failed to read .gitattributes: %w", err)
+	}
+	for _, l := range strings.Split(string(existing), "\n") {
+		if fields := strings.Fields(l); len(fields) > 0 && (fields[0] == pattern || "/"+fields[0] == pattern) {
+			return false, nil
+		}
+	}
+
+	content := string(existing)
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	content += "# RTM database: stable line endings and the rtmx merge driver\n" + line + "\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return false, fmt.Errorf("failed to write .gitattributes: %w", err)
+	}
+	return true, nil
+}
+
+// isGitRepo reports whether dir is inside a git work tree.
+func isGitRepo(dir string) bool {
+	gitCmd := exec.Command("git", "rev-parse", "--is-inside-work-tree")
+	gitCmd.Dir = dir
+	out, err := gitCmd.Output()
+	return err == nil && strings.TrimSpace(string(out)) == "true"
+}
+
+// installRTMGitDriver registers the drivers named in .gitattributes in the
+// local git config of the repository at dir. Merges sort each side by
+// requirement ID before merging lines, and word diffs compare whole cells.
+func installRTMGitDriver(dir string) error {
+	settings := [][2]string{
+		{"merge." + rtmGitDriver + ".name", "RTMX RTM database merged in requirement ID order"},
+		{"merge." + rtmGitDriver + ".driver", "rtmx merge-driver %O %A %B"},
+		{"diff." + rtmGitDriver + ".wordRegex", "[^,]+"},
+	}
+	for _, kv := range settings {
+		gitCmd := exec.Command("git", "config", "--local", kv[0], kv[1])
+		gitCmd.Dir = dir
+		if out, err := gitCmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("git config %s failed: %s", kv[0], strings.TrimSpace(string(out)))
+		}
+	}
+	return nil
+}
+
+// initGitAttributes writes the .gitattributes entry in dir for the
+// database at dbPath, absolute or relative to dir, and, in a git
+// repository, registers the merge driver, reporting each step.
+func initGitAttributes(cmd *cobra.Command, dir, dbPath string) error {
+	dbPath, err := gitRelativePath(dir, dbPath)
+	if err != nil {
+		return err
+	}
+	added, err := writeRTMGitAttributes(dir, dbPath)
+	if err != nil {
+		return err
+	}
+	gitattributes := filepath.Join(dir, ".gitattributes")
+	if added {
+		cmd.Printf("  %s Added %s to %s\n", output.Color("✓", output.Green), filepath.ToSlash(dbPath), gitattributes)
+	} else {
+		cmd.Printf("  %s %s already has an entry for %s\n", output.Color("✓", output.Green), gitattributes, filepath.ToSlash(dbPath))
+	}
+
+	if !isGitRepo(dir) {
+		cmd.Printf("  %s Not a git repository, so the %s merge driver was not registered\n",
+			output.Color("Warning:", output.Yellow), rtmGitDriver)
+		return nil
+	}
+	if err := installRTMGitDriver(dir); err != nil {
+		return err
+	}
+	cmd.Printf("  %s Registered the %s merge driver in the local git config\n", output.Color("✓", output.Green), rtmGitDriver)
+	return nil
+}
+
+var mergeDriverCmd = &cobra.Command{
+	Use:   "merge-driver BASE OURS THEIRS",
+	Short: "Merge versions of the RTM database (git merge driver)",
+	Long: `Merge three versions of the RTM database for git.
+
+Registered by 'rtmx init --git' as the rtmx-csv merge driver. Each version
+is rewritten in requirement ID order before git merge-file merges them
+line by line, so rows added or reordered on both sides do not conflict.
+The result is left in OURS; conflicts are marked as usual.`,
+	Args:   cobra.ExactArgs(3),
+	Hidden: true,
+	RunE:   runMergeDriver,
+}
+
+func init() {
+	rootCmd.AddCommand(mergeDriverCmd)
+}
+
+func runMergeDriver(cmd *cobra.Command, args []string) error {
+	base, ours, theirs := args[0], args[1], args[2]
+	for _, path := range args {
+		if err := sortDatabaseFile(path); err != nil {
+			return err
+		}
+	}
+
+	gitCmd := exec.Command("git", "merge-file", "-L", "ours", "-L", "base", "-L", "theirs", ours, base, theirs)
+	gitCmd.Stderr = cmd.ErrOrStderr()
+	if err := gitCmd.Run(); err != nil {
+		// git merge-file exits with the number of conflicts
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 && exitErr.ExitCode() < 128 {
+			return NewExitError(1, fmt.Sprintf("%d conflict(s) in %s", exitErr.ExitCode(), ours))
+		}
+		return fmt.Errorf("git merge-file failed: %w", err)
+	}
+	return nil
+}
+
+// sortDatabaseFile rewrites the database at path in requirement ID order.
+// A file that does not hold a database, such as the empty base of a file
+// added on both sides, is left as is.
+func sortDatabaseFile(path string) error {
+	db, err := database.Load(path)
+	if err != nil {
+		return nil
+	}
+	db.SortByID()
+	return db.Save(path)
+}
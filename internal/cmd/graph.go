@@ -0,0 +1,240 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/graph"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	graphFormat         string
+	graphOutput         string
+	graphIncompleteOnly bool
+	graphCriticalPath   bool
+)
+
+var graphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Export the dependency graph",
+	Long: `Export the requirement dependency graph for rendering.
+
+Each requirement is a node labelled with its ID, status and text, with an
+edge from every dependency to the requirement that depends on it. Nodes
+are colored by state: complete, partial, blocked by an incomplete
+dependency, or ready to work on.
+
+Formats:
+  dot      Graphviz DOT, e.g. for "dot -Tsvg"
+  mermaid  A Mermaid flowchart, for Markdown that renders Mermaid
+
+The full graph of a large RTM is hard to read. --incomplete-only drops
+complete requirements, and --critical-path renders only the longest chain
+of incomplete requirements that each wait on the one before.
+
+Examples:
+    rtmx graph | dot -Tsvg -o deps.svg
+    rtmx graph --incomplete-only --format mermaid -o deps.mmd
+    rtmx graph --critical-path`,
+	Args: cobra.NoArgs,
+	RunE: runGraph,
+}
+
+func init() {
+	graphCmd.Flags().StringVar(&graphFormat, "format", "dot", "output format: dot, mermaid")
+	graphCmd.Flags().StringVarP(&graphOutput, "output", "o", "", "output file")
+	graphCmd.Flags().BoolVar(&graphIncompleteOnly, "incomplete-only", false, "omit complete requirements")
+	graphCmd.Flags().BoolVar(&graphCriticalPath, "critical-path", false, "render only the longest chain of incomplete requirements")
+
+	rootCmd.AddCommand(graphCmd)
+}
+
+func runGraph(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	switch graphFormat {
+	case "dot", "mermaid":
+	default:
+		return NewValidationError(fmt.Sprintf("unsupported graph format %q", graphFormat), "use dot or mermaid")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	db, err := database.Load(cfg.DatabasePath(cwd))
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	view := buildGraphView(db, graph.NewGraph(db), graphIncompleteOnly, graphCriticalPath)
+	var content string
+	if graphFormat == "mermaid" {
+		content = formatGraphMermaid(view)
+	} else {
+		content = formatGraphDOT(view)
+	}
+
+	if graphOutput != "" {
+		if err := os.WriteFile(graphOutput, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write output: %w", err)
+		}
+		cmd.Printf("%s Written to %s\n", output.Color("✓", output.Green), graphOutput)
+		return nil
+	}
+	cmd.Print(content)
+	return nil
+}
+
+// graphNodeState is how a node is colored.
+type graphNodeState string
+
+const (
+	graphNodeComplete graphNodeState = "complete"
+	graphNodePartial  graphNodeState = "partial"
+	graphNodeBlocked  graphNodeState = "blocked"
+	graphNodeReady    graphNodeState = "ready"
+)
+
+// graphNodeColors are the fill colors of each node state.
+var graphNodeColors = map[graphNodeState]string{
+	graphNodeComplete: "#c8e6c9",
+	graphNodePartial:  "#fff9c4",
+	graphNodeBlocked:  "#ffcdd2",
+	graphNodeReady:    "#ffffff",
+}
+
+// graphLabelWidth bounds the requirement text in a node label.
+const graphLabelWidth = 40
+
+type graphNode struct {
+	ID     string
+	Status database.Status
+	Text   string
+	State  graphNodeState
+}
+
+// graphEdge runs from a dependency to the requirement depending on it.
+type graphEdge struct {
+	From, To string
+}
+
+type graphView struct {
+	Nodes []graphNode
+	Edges []graphEdge
+}
+
+// buildGraphView selects the nodes and edges to render, in database
+// order. With criticalPath only the critical chain and the edges along it
+// are kept; with incompleteOnly complete requirements are dropped.
+func buildGraphView(db *database.Database, g *graph.Graph, incompleteOnly, criticalPath bool) graphView {
+	var ids []string
+	if criticalPath {
+		ids = g.CriticalChain()
+	} else {
+		for _, req := range db.All() {
+			if incompleteOnly && !req.IsIncomplete() {
+				continue
+			}
+			ids = append(ids, req.ReqID)
+		}
+	}
+
+	included := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		included[id] = true
+	}
+
+	var view graphView
+	for i, id := range ids {
+		req := db.Get(id)
+		state := graphNodeReady
+		switch {
+		case !req.IsIncomplete():
+			state = graphNodeComplete
+		case g.IsBlocked(id):
+			state = graphNodeBlocked
+		case req.Status == database.StatusPartial:
+			state = graphNodePartial
+		}
+		view.Nodes = append(view.Nodes, graphNode{
+			ID:     id,
+			Status: req.Status,
+			Text:   output.Truncate(req.RequirementText, graphLabelWidth),
+			State:  state,
+		})
+
+		if criticalPath {
+			if i > 0 {
+				view.Edges = append(view.Edges, graphEdge{From: ids[i-1], To: id})
+			}
+			continue
+		}
+		dependents := append([]string(nil), g.Dependents(id)...)
+		database.SortReqIDs(dependents)
+		for _, dependent := range dependents {
+			if included[dependent] {
+				view.Edges = append(view.Edges, graphEdge{From: id, To: dependent})
+			}
+		}
+	}
+	return view
+}
+
+// formatGraphDOT renders view as a Graphviz digraph.
+func formatGraphDOT(view graphView) string {
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+	}
+
+	var sb strings.Builder
+	sb.WriteString("digraph rtmx {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	for _, n := range view.Nodes {
+		label := quote(n.ID + "\n" + n.Status.String() + "\n" + n.Text)
+		// The escaped newlines become DOT line breaks
+		label = strings.ReplaceAll(label, "\n", `\n`)
+		fmt.Fprintf(&sb, "  %s [label=%s, fillcolor=%s];\n", quote(n.ID), label, quote(graphNodeColors[n.State]))
+	}
+	for _, e := range view.Edges {
+		fmt.Fprintf(&sb, "  %s -> %s;\n", quote(e.From), quote(e.To))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// formatGraphMermaid renders view as a Mermaid flowchart. Requirement IDs
+// are not valid Mermaid node IDs, so nodes are numbered.
+func formatGraphMermaid(view graphView) string {
+	escape := strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;")
+	nodeIDs := make(map[string]string, len(view.Nodes))
+
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	for i, n := range view.Nodes {
+		nodeIDs[n.ID] = fmt.Sprintf("n%d", i)
+		fmt.Fprintf(&sb, "  %s[\"%s<br/>%s<br/>%s\"]:::%s\n",
+			nodeIDs[n.ID], escape.Replace(n.ID), n.Status, escape.Replace(n.Text), n.State)
+	}
+	for _, e := range view.Edges {
+		fmt.Fprintf(&sb, "  %s --> %s\n", nodeIDs[e.From], nodeIDs[e.To])
+	}
+	for _, state := range []graphNodeState{graphNodeComplete, graphNodePartial, graphNodeBlocked, graphNodeReady} {
+		fmt.Fprintf(&sb, "  classDef %s fill:%s,stroke:#616161\n", state, graphNodeColors[state])
+	}
+	return sb.String()
+}
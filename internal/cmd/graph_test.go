@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+func TestGraphFilters(t *testing.T) {
+	origFormat, origOutput, origIncomplete, origCritical := graphFormat, graphOutput, graphIncompleteOnly, graphCriticalPath
+	defer func() {
+		graphFormat, graphOutput, graphIncompleteOnly, graphCriticalPath = origFormat, origOutput, origIncomplete, origCritical
+	}()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	// REQ-G-001 (complete) -> REQ-G-002 -> REQ-G-003 -> REQ-G-004,
+	// and REQ-G-005 -> REQ-G-006 beside it
+	db := database.NewDatabase()
+	deps := map[string][]string{
+		"REQ-G-002": {"REQ-G-001"},
+		"REQ-G-003": {"REQ-G-002"},
+		"REQ-G-004": {"REQ-G-003"},
+		"REQ-G-006": {"REQ-G-005"},
+	}
+	for _, id := range []string{"REQ-G-001", "REQ-G-002", "REQ-G-003", "REQ-G-004", "REQ-G-005", "REQ-G-006"} {
+		req := database.NewRequirement(id)
+		req.Category = "G"
+		req.RequirementText = "Requirement " + id
+		req.Status = database.StatusMissing
+		req.Dependencies = database.NewStringSet(deps[id]...)
+		_ = db.Add(req)
+	}
+	db.Get("REQ-G-001").Status = database.StatusComplete
+	db.Get("REQ-G-002").Status = database.StatusPartial
+	if err := db.Save(filepath.Join(tmpDir, ".rtmx", "database.csv")); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	render := func(format string, incompleteOnly, criticalPath bool) string {
+		graphFormat, graphOutput, graphIncompleteOnly, graphCriticalPath = format, "", incompleteOnly, criticalPath
+		var buf bytes.Buffer
+		graphCmd.SetOut(&buf)
+		defer graphCmd.SetOut(nil)
+		if err := runGraph(graphCmd, nil); err != nil {
+			t.Fatalf("graph failed: %v", err)
+		}
+		return buf.String()
+	}
+
+	out := render("dot", false, false)
+	for _, want := range []string{`"REQ-G-001" -> "REQ-G-002";`, `"REQ-G-005" -> "REQ-G-006";`, `"REQ-G-001" [label="REQ-G-001\nCOMPLETE`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in the full graph:\n%s", want, out)
+		}
+	}
+	// Blocked nodes are colored apart from ready ones
+	if !strings.Contains(out, `"REQ-G-003" [label="REQ-G-003\nMISSING\nRequirement REQ-G-003", fillcolor="#ffcdd2"];`) ||
+		!strings.Contains(out, `"REQ-G-005" [label="REQ-G-005\nMISSING\nRequirement REQ-G-005", fillcolor="#ffffff"];`) {
+		t.Errorf("Expected blocked and ready nodes colored differently:\n%s", out)
+	}
+
+	out = render("dot", true, false)
+	if strings.Contains(out, `"REQ-G-001"`) {
+		t.Errorf("Expected --incomplete-only to omit the COMPLETE requirement:\n%s", out)
+	}
+	if !strings.Contains(out, `"REQ-G-002" -> "REQ-G-003";`) || !strings.Contains(out, `"REQ-G-006"`) {
+		t.Errorf("Expected every incomplete requirement and its edges:\n%s", out)
+	}
+
+	out = render("dot", false, true)
+	for _, id := range []string{"REQ-G-001", "REQ-G-005", "REQ-G-006"} {
+		if strings.Contains(out, id) {
+			t.Errorf("Expected --critical-path to omit %s:\n%s", id, out)
+		}
+	}
+	for _, want := range []string{`"REQ-G-002" -> "REQ-G-003";`, `"REQ-G-003" -> "REQ-G-004";`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected the longest chain edge %q:\n%s", want, out)
+		}
+	}
+	if n := strings.Count(out, "->"); n != 2 {
+		t.Errorf("Expected only the 2 edges of the chain, got %d:\n%s", n, out)
+	}
+
+	out = render("mermaid", false, true)
+	for _, want := range []string{"flowchart LR", `n0["REQ-G-002<br/>PARTIAL<br/>Requirement REQ-G-002"]:::partial`, "n0 --> n1", "n1 --> n2", "classDef blocked fill:#ffcdd2"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in the Mermaid chart:\n%s", want, out)
+		}
+	}
+
+	graphFormat = "svg"
+	if err := runGraph(graphCmd, nil); err == nil {
+		t.Error("Expected an unsupported format to be rejected")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/rtmx-ai/rtmx-go/internal/config"
 	"github.com/rtmx-ai/rtmx-go/internal/database"
@@ -89,18 +90,18 @@ func runHealth(cmd *cobra.Command, args []string) error {
 
 	cfg, err := config.LoadFromDir(cwd)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return configLoadError(err)
 	}
 
 	// Load database
 	dbPath := cfg.DatabasePath(cwd)
 	db, err := database.Load(dbPath)
 	if err != nil {
-		return fmt.Errorf("failed to load database: %w", err)
+		return databaseLoadError(err)
 	}
 
 	// Run health checks
-	result := runHealthChecks(db)
+	result := runHealthChecks(db, cwd, cfg.RTMX.CompletionThresholds)
 
 	// Output
 	if healthJSON {
@@ -109,7 +110,9 @@ func runHealth(cmd *cobra.Command, args []string) error {
 	return outputHealthText(cmd, result)
 }
 
-func runHealthChecks(db *database.Database) *HealthResult {
+// runHealthChecks checks db; spec files are resolved relative to root.
+// Completion is checked against thresholds when any are set.
+func runHealthChecks(db *database.Database, root string, thresholds config.CompletionThresholdsConfig) *HealthResult {
 	result := &HealthResult{
 		Checks: make([]HealthCheck, 0),
 	}
@@ -196,6 +199,21 @@ func runHealthChecks(db *database.Database) *HealthResult {
 		}
 	}
 
+	// Check for requirements blocked in the external tracker
+	var blockedExternally []string
+	for _, req := range db.Incomplete() {
+		if req.BlockedExternal != "" {
+			blockedExternally = append(blockedExternally, req.ReqID)
+		}
+	}
+	if len(blockedExternally) > 0 {
+		result.Checks = append(result.Checks, HealthCheck{
+			Name:    "blocked_external",
+			Status:  CheckWarn,
+			Message: fmt.Sprintf("Blocked externally: %d requirement(s) (%s)", len(blockedExternally), strings.Join(blockedExternally, ", ")),
+		})
+	}
+
 	// Check 4: Test coverage
 	testCoverage := 0.0
 	if result.Stats.Total > 0 {
@@ -221,7 +239,91 @@ func runHealthChecks(db *database.Database) *HealthResult {
 		})
 	}
 
-	// Check 5: Cycles (placeholder)
+	// Check 5: COMPLETE requirements with unchecked acceptance criteria
+	uncheckedReqs := 0
+	for _, req := range db.All() {
+		if req.IsComplete() && len(uncheckedCriteria(specFilePath(root, req))) > 0 {
+			uncheckedReqs++
+		}
+	}
+	if uncheckedReqs > 0 {
+		result.Checks = append(result.Checks, HealthCheck{
+			Name:    "acceptance_criteria",
+			Status:  CheckWarn,
+			Message: fmt.Sprintf("Unchecked acceptance criteria: %d COMPLETE requirement(s)", uncheckedReqs),
+		})
+	} else {
+		result.Checks = append(result.Checks, HealthCheck{
+			Name:    "acceptance_criteria",
+			Status:  CheckPass,
+			Message: "COMPLETE requirements have all acceptance criteria checked",
+		})
+	}
+
+	// Check 6: Inconsistent effort, phase, status and dates
+	inconsistentReqs := 0
+	for _, req := range db.All() {
+		if len(req.Inconsistencies()) > 0 {
+			inconsistentReqs++
+		}
+	}
+	if inconsistentReqs > 0 {
+		result.Checks = append(result.Checks, HealthCheck{
+			Name:    "consistency",
+			Status:  CheckWarn,
+			Message: fmt.Sprintf("Inconsistent data: %d requirement(s) (run rtmx validate)", inconsistentReqs),
+		})
+	} else {
+		result.Checks = append(result.Checks, HealthCheck{
+			Name:    "consistency",
+			Status:  CheckPass,
+			Message: "Effort, phases and dates are consistent",
+		})
+	}
+
+	// Check for external IDs linked by several requirements
+	if dupes := db.DuplicateExternalIDs(); len(dupes) > 0 {
+		links := make([]string, len(dupes))
+		for i, d := range dupes {
+			links[i] = fmt.Sprintf("%s linked by %s", d, strings.Join(d.ReqIDs, ", "))
+		}
+		result.Checks = append(result.Checks, HealthCheck{
+			Name:    "external_ids",
+			Status:  CheckWarn,
+			Message: fmt.Sprintf("Duplicate external IDs: %d (%s)", len(dupes), strings.Join(links, "; ")),
+		})
+	} else {
+		result.Checks = append(result.Checks, HealthCheck{
+			Name:    "external_ids",
+			Status:  CheckPass,
+			Message: "Each external ID is linked by one requirement",
+		})
+	}
+
+	// Check 7: Completion thresholds per category and phase
+	if !thresholds.IsEmpty() {
+		var shortfalls []string
+		for _, r := range evaluateThresholds(db.All(), thresholds) {
+			if !r.Passed {
+				shortfalls = append(shortfalls, r.Message)
+			}
+		}
+		if len(shortfalls) > 0 {
+			result.Checks = append(result.Checks, HealthCheck{
+				Name:    "completion_thresholds",
+				Status:  CheckFail,
+				Message: "Below completion threshold: " + strings.Join(shortfalls, "; "),
+			})
+		} else {
+			result.Checks = append(result.Checks, HealthCheck{
+				Name:    "completion_thresholds",
+				Status:  CheckPass,
+				Message: "All categories and phases meet their completion thresholds",
+			})
+		}
+	}
+
+	// Check 8: Cycles (placeholder)
 	result.Stats.CycleCount = 0
 	result.Checks = append(result.Checks, HealthCheck{
 		Name:    "cycles",
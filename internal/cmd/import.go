@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importMapping string
+	importColumns []string
+	importUpdate  bool
+	importDryRun  bool
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import FILE",
+	Short: "Import requirements from a CSV file",
+	Long: `Import requirements from a CSV file into the RTM database.
+
+The source file may use a different schema. Use --map to rename source
+columns to RTM fields, or --mapping to load a YAML mapping file that can
+also translate values (e.g. a "Done" state to COMPLETE). Columns that are
+not mapped to an RTM field are kept as extra columns.
+
+Mapping file format:
+    columns:
+      Key: req_id
+      Summary: requirement_text
+      State: status
+    values:
+      status:
+        Done: COMPLETE
+        In Progress: PARTIAL
+
+Examples:
+    rtmx import export.csv --mapping mapping.yaml
+    rtmx import export.csv --map Key=req_id --map Summary=requirement_text
+    rtmx import export.csv --mapping mapping.yaml --update --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImportCSV,
+}
+
+func init() {
+	importCmd.Flags().StringVar(&importMapping, "mapping", "", "YAML file mapping source columns and values to RTM fields")
+	importCmd.Flags().StringArrayVar(&importColumns, "map", nil, "map a source column to an RTM field (Source=field, repeatable)")
+	importCmd.Flags().BoolVar(&importUpdate, "update", false, "update requirements that already exist")
+	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "show what would be imported without writing")
+
+	rootCmd.AddCommand(importCmd)
+}
+
+func runImportCSV(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	mapping, err := buildImportMapping(importMapping, importColumns)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer file.Close()
+
+	source, err := database.ReadCSVWithMapping(file, mapping)
+	if err != nil {
+		return fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	dbPath := cfg.DatabasePath(cwd)
+	db, err := database.Load(dbPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return databaseLoadError(err)
+		}
+		db = database.NewDatabase()
+	}
+
+	var added, updated, skipped []string
+	for _, req := range source.All() {
+		if !db.Exists(req.ReqID) {
+			if !importDryRun {
+				if err := db.Add(req.Clone()); err != nil {
+					return fmt.Errorf("failed to add %s: %w", req.ReqID, err)
+				}
+			}
+			added = append(added, req.ReqID)
+			continue
+		}
+		if !importUpdate {
+			skipped = append(skipped, req.ReqID)
+			continue
+		}
+		if !importDryRun {
+			existing := db.Get(req.ReqID)
+			*existing = *req.Clone()
+		}
+		updated = append(updated, req.ReqID)
+	}
+
+	if importDryRun {
+		cmd.Println(output.Color("DRY RUN - no changes will be made", output.Yellow))
+		cmd.Println()
+	}
+
+	cmd.Printf("Imported from %s:\n", args[0])
+	cmd.Printf("  %s %d added\n", output.Color("+", output.Green), len(added))
+	cmd.Printf("  %s %d updated\n", output.Color("~", output.Yellow), len(updated))
+	cmd.Printf("  %s %d skipped (already exist)\n", output.Color("-", output.Dim), len(skipped))
+
+	if importDryRun || len(added)+len(updated) == 0 {
+		return nil
+	}
+
+	if err := db.Save(dbPath); err != nil {
+		return databaseSaveError(err)
+	}
+	cmd.Printf("\n%s Saved %s\n", output.Color("✓", output.Green), dbPath)
+
+	return nil
+}
+
+// buildImportMapping combines a mapping file and inline --map pairs.
+// Inline pairs take precedence over the file. Returns nil when neither is set.
+func buildImportMapping(path string, pairs []string) (*database.ColumnMapping, error) {
+	if path == "" && len(pairs) == 0 {
+		return nil, nil
+	}
+
+	mapping := database.NewColumnMapping()
+	if path != "" {
+		loaded, err := database.LoadColumnMapping(path)
+		if err != nil {
+			return nil, err
+		}
+		mapping = loaded
+	}
+
+	for _, pair := range pairs {
+		if err := mapping.ParseColumnPair(pair); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mapping.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid mapping: %w", err)
+	}
+	return mapping, nil
+}
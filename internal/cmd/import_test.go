@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// TestImportWithMapping imports a foreign-schema CSV using a mapping file.
+func TestImportWithMapping(t *testing.T) {
+	origMapping := importMapping
+	origColumns := importColumns
+	origUpdate := importUpdate
+	origDryRun := importDryRun
+	defer func() {
+		importMapping = origMapping
+		importColumns = origColumns
+		importUpdate = origUpdate
+		importDryRun = origDryRun
+	}()
+
+	tmpDir := setupTestProject(t)
+
+	source := `Issue Key,Summary,State,Component,Sprint Name
+TRK-101,Users can log in,Resolved,AUTH,S1
+TRK-102,Users can reset passwords,Open,AUTH,S2
+`
+	sourcePath := filepath.Join(tmpDir, "export.csv")
+	writeTestFile(t, tmpDir, "export.csv", source)
+
+	mappingYAML := `columns:
+  Issue Key: req_id
+  Summary: requirement_text
+  State: status
+values:
+  status:
+    Resolved: COMPLETE
+    Open: MISSING
+`
+	mappingPath := filepath.Join(tmpDir, "mapping.yaml")
+	writeTestFile(t, tmpDir, "mapping.yaml", mappingYAML)
+
+	importMapping = mappingPath
+	importColumns = []string{"Component=category"}
+	importUpdate = false
+	importDryRun = false
+
+	var buf bytes.Buffer
+	importCmd.SetOut(&buf)
+	importCmd.SetErr(&buf)
+
+	if err := runImportCSV(importCmd, []string{sourcePath}); err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "2 added") {
+		t.Errorf("Expected output to report 2 added, got:\n%s", buf.String())
+	}
+
+	db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+
+	req := db.Get("TRK-101")
+	if req == nil {
+		t.Fatal("TRK-101 not imported")
+	}
+	if req.Status != database.StatusComplete {
+		t.Errorf("Status = %v, want COMPLETE (translated from Resolved)", req.Status)
+	}
+	if req.Category != "AUTH" {
+		t.Errorf("Category = %q, want AUTH", req.Category)
+	}
+	if req.RequirementText != "Users can log in" {
+		t.Errorf("RequirementText = %q, want %q", req.RequirementText, "Users can log in")
+	}
+	if req.Extra["Sprint Name"] != "S1" {
+		t.Errorf("Extra[Sprint Name] = %q, want S1", req.Extra["Sprint Name"])
+	}
+
+	// Re-importing without --update skips existing requirements
+	buf.Reset()
+	if err := runImportCSV(importCmd, []string{sourcePath}); err != nil {
+		t.Fatalf("second import failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "2 skipped") {
+		t.Errorf("Expected output to report 2 skipped, got:\n%s", buf.String())
+	}
+}
+
+func TestBuildImportMappingInvalid(t *testing.T) {
+	if _, err := buildImportMapping("", []string{"Summary"}); err == nil {
+		t.Error("Expected error for malformed --map pair")
+	}
+	if _, err := buildImportMapping("", []string{"Summary=bogus_field"}); err == nil {
+		t.Error("Expected error for unknown target field")
+	}
+	m, err := buildImportMapping("", nil)
+	if err != nil || m != nil {
+		t.Errorf("Expected nil mapping with no options, got %v, %v", m, err)
+	}
+}
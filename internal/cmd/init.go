@@ -4,14 +4,24 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/rtmx-ai/rtmx-go/internal/output"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 var (
-	initForce  bool
-	initLegacy bool
+	initForce           bool
+	initLegacy          bool
+	initDatabase        string
+	initRequirementsDir string
+	initSchema          string
+	initGitHubRepo      string
+	initJiraServer      string
+	initJiraProject     string
+	initNoSample        bool
+	initGit             bool
 )
 
 var initCmd = &cobra.Command{
@@ -31,13 +41,40 @@ By default, creates the modern .rtmx/ directory structure:
   │       └── REQ-EX-001.md
   └── cache/              # Cache directory (gitignored)
 
-Use --legacy to create the older docs/ structure instead.`,
+Use --legacy to create the older docs/ structure instead.
+
+The generated config can be filled in up front for automated
+provisioning: --database and --requirements-dir move the database and
+spec files, --schema sets the schema, and --github-repo or --jira-server
+with --jira-project enable those adapters. --no-sample leaves out the
+example requirement, so the database starts with only its header.
+
+--git adds the database to .gitattributes with LF line endings and the
+rtmx-csv diff and merge drivers, and inside a git repository registers
+those drivers in the local git config. The merge driver sorts each
+version by requirement ID before merging, so rows added on two branches
+rarely conflict; the diff driver makes --word-diff compare whole cells.
+
+Examples:
+    rtmx init
+    rtmx init --legacy
+    rtmx init --database rtm/database.csv --requirements-dir rtm/specs --no-sample
+    rtmx init --github-repo acme/app --jira-server https://acme.atlassian.net --jira-project APP
+    rtmx init --git`,
 	RunE: runInit,
 }
 
 func init() {
 	initCmd.Flags().BoolVarP(&initForce, "force", "f", false, "overwrite existing files")
 	initCmd.Flags().BoolVar(&initLegacy, "legacy", false, "use legacy docs/ directory structure")
+	initCmd.Flags().StringVar(&initDatabase, "database", "", "database path in the generated config (default: .rtmx/database.csv, or docs/rtm_database.csv with --legacy)")
+	initCmd.Flags().StringVar(&initRequirementsDir, "requirements-dir", "", "requirements directory in the generated config (default: .rtmx/requirements, or docs/requirements with --legacy)")
+	initCmd.Flags().StringVar(&initSchema, "schema", "core", "schema in the generated config")
+	initCmd.Flags().StringVar(&initGitHubRepo, "github-repo", "", "enable the GitHub adapter for owner/repo")
+	initCmd.Flags().StringVar(&initJiraServer, "jira-server", "", "enable the Jira adapter for this server URL (requires --jira-project)")
+	initCmd.Flags().StringVar(&initJiraProject, "jira-project", "", "Jira project key (requires --jira-server)")
+	initCmd.Flags().BoolVar(&initNoSample, "no-sample", false, "do not create the example requirement")
+	initCmd.Flags().BoolVar(&initGit, "git", false, "add the database to .gitattributes and register the rtmx merge driver")
 
 	rootCmd.AddCommand(initCmd)
 }
@@ -52,69 +89,123 @@ func runInit(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get working directory: %w", err)
 	}
 
+	if (initJiraServer == "") != (initJiraProject == "") {
+		return NewValidationError("--jira-server and --jira-project must be set together")
+	}
+
 	if initLegacy {
 		return initLegacyStructure(cmd, cwd)
 	}
 	return initRtmxStructure(cmd, cwd)
 }
 
-func initRtmxStructure(cmd *cobra.Command, cwd string) error {
-	rtmxDir := filepath.Join(cwd, ".rtmx")
-	rtmCSV := filepath.Join(rtmxDir, "database.csv")
-	requirementsDir := filepath.Join(rtmxDir, "requirements")
-	configFile := filepath.Join(rtmxDir, "config.yaml")
-	cacheDir := filepath.Join(rtmxDir, "cache")
-	gitignore := filepath.Join(rtmxDir, ".gitignore")
+// initPaths returns the database and requirements directory paths for the
+// generated config, relative to the project: the flags, or defaults.
+func initPaths(database, requirementsDir string) (string, string) {
+	if initDatabase != "" {
+		database = filepath.ToSlash(initDatabase)
+	}
+	if initRequirementsDir != "" {
+		requirementsDir = filepath.ToSlash(initRequirementsDir)
+	}
+	return database, requirementsDir
+}
 
-	// Check for existing files
-	if !initForce {
-		if _, err := os.Stat(rtmxDir); err == nil {
-			cmd.Printf("%s The following already exist:\n", output.Color("Warning:", output.Yellow))
-			cmd.Printf("  %s\n\n", rtmxDir)
-			cmd.Printf("%s\n", output.Color("Use --force to overwrite", output.Dim))
-			os.Exit(1)
-		}
+// initProjectPath resolves a configured path against the project
+// directory, keeping an absolute path as is.
+func initProjectPath(cwd, path string) string {
+	if filepath.IsAbs(filepath.FromSlash(path)) {
+		return filepath.FromSlash(path)
 	}
+	return filepath.Join(cwd, filepath.FromSlash(path))
+}
 
-	// Create directories
-	cmd.Printf("Creating RTM structure in %s/.rtmx/\n\n", cwd)
+// initConfigContent renders the generated config for the given paths, with the
+// schema and adapters set by the flags.
+func initConfigContent(database, requirementsDir string) string {
+	schema := initSchema
+	if schema == "" {
+		schema = "core"
+	}
 
-	if err := os.MkdirAll(rtmxDir, 0755); err != nil {
-		return fmt.Errorf("failed to create .rtmx directory: %w", err)
+	var sb strings.Builder
+	sb.WriteString(`# RTMX Configuration
+# See https://rtmx.ai for documentation
+
+rtmx:
+`)
+	fmt.Fprintf(&sb, "  database: %s\n", yamlScalar(database))
+	fmt.Fprintf(&sb, "  requirements_dir: %s\n", yamlScalar(requirementsDir))
+	fmt.Fprintf(&sb, "  schema: %s\n", yamlScalar(schema))
+	sb.WriteString(`  pytest:
+    marker_prefix: "req"
+    register_markers: true
+`)
+	if initGitHubRepo != "" || initJiraServer != "" {
+		sb.WriteString("  adapters:\n")
 	}
-	if err := os.MkdirAll(requirementsDir, 0755); err != nil {
-		return fmt.Errorf("failed to create requirements directory: %w", err)
+	if initGitHubRepo != "" {
+		fmt.Fprintf(&sb, "    github:\n      enabled: true\n      repo: %s\n", yamlScalar(initGitHubRepo))
 	}
-	if err := os.MkdirAll(cacheDir, 0755); err != nil {
-		return fmt.Errorf("failed to create cache directory: %w", err)
+	if initJiraServer != "" {
+		fmt.Fprintf(&sb, "    jira:\n      enabled: true\n      server: %s\n      project: %s\n",
+			yamlScalar(initJiraServer), yamlScalar(initJiraProject))
 	}
+	return sb.String()
+}
 
-	// Create .gitignore
-	gitignoreContent := `# RTMX cache and generated files
-cache/
-`
-	if err := os.WriteFile(gitignore, []byte(gitignoreContent), 0644); err != nil {
-		return fmt.Errorf("failed to create .gitignore: %w", err)
+// yamlScalar renders s as a YAML scalar, quoting it only when needed.
+func yamlScalar(s string) string {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("%q", s)
+	}
+	return strings.TrimSuffix(string(data), "\n")
+}
+
+// initRTMHeader is the header of a new RTM database.
+const initRTMHeader = "req_id,category,subcategory,requirement_text,target_value,test_module,test_function,validation_method,status,priority,phase,notes,effort_weeks,dependencies,blocks,assignee,sprint,started_date,completed_date,requirement_file\n"
+
+// writeInitRTM writes the database at rtmCSV, with the example requirement
+// and its spec file under requirementsDir unless --no-sample is set.
+func writeInitRTM(cmd *cobra.Command, cwd, rtmCSV, requirementsDir string) error {
+	if err := os.MkdirAll(filepath.Dir(rtmCSV), 0755); err != nil {
+		return fmt.Errorf("failed to create database directory: %w", err)
+	}
+	if initNoSample {
+		if err := os.WriteFile(rtmCSV, []byte(initRTMHeader), 0644); err != nil {
+			return fmt.Errorf("failed to create %s: %w", filepath.Base(rtmCSV), err)
+		}
+		cmd.Printf("  %s Created %s\n", output.Color("✓", output.Green), rtmCSV)
+		return nil
 	}
-	cmd.Printf("  %s Created %s\n", output.Color("✓", output.Green), gitignore)
 
 	// Create sample RTM database
-	sampleRTM := `req_id,category,subcategory,requirement_text,target_value,test_module,test_function,validation_method,status,priority,phase,notes,effort_weeks,dependencies,blocks,assignee,sprint,started_date,completed_date,requirement_file
-REQ-EX-001,EXAMPLE,SAMPLE,Sample requirement for demonstration,Target value here,tests/test_example.py,test_sample,Unit Test,MISSING,MEDIUM,1,This is a sample requirement,1.0,,,developer,v0.1,,,.rtmx/requirements/EXAMPLE/REQ-EX-001.md
+	sampleFile, err := specPathConfig(cwd, requirementsDir).RequirementFile("EXAMPLE", "REQ-EX-001", 1)
+	if err != nil {
+		return NewTypedError(ErrorTypeConfig, "", err)
+	}
+	sampleRTM := initRTMHeader + `REQ-EX-001,EXAMPLE,SAMPLE,Sample requirement for demonstration,Target value here,tests/test_example.py,test_sample,Unit Test,MISSING,MEDIUM,1,This is a sample requirement,1.0,,,developer,v0.1,,,` + sampleFile + `
 `
 	if err := os.WriteFile(rtmCSV, []byte(sampleRTM), 0644); err != nil {
-		return fmt.Errorf("failed to create database.csv: %w", err)
+		return fmt.Errorf("failed to create %s: %w", filepath.Base(rtmCSV), err)
 	}
 	cmd.Printf("  %s Created %s\n", output.Color("✓", output.Green), rtmCSV)
 
 	// Create sample requirement file
-	sampleReqDir := filepath.Join(requirementsDir, "EXAMPLE")
-	if err := os.MkdirAll(sampleReqDir, 0755); err != nil {
-		return fmt.Errorf("failed to create EXAMPLE directory: %w", err)
+	sampleReqFile := initProjectPath(cwd, sampleFile)
+	if err := os.MkdirAll(filepath.Dir(sampleReqFile), 0755); err != nil {
+		return fmt.Errorf("failed to create sample requirement directory: %w", err)
 	}
+	if err := os.WriteFile(sampleReqFile, []byte(initSampleRequirement), 0644); err != nil {
+		return fmt.Errorf("failed to create sample requirement: %w", err)
+	}
+	cmd.Printf("  %s Created %s\n", output.Color("✓", output.Green), sampleReqFile)
+	return nil
+}
 
-	sampleReqFile := filepath.Join(sampleReqDir, "REQ-EX-001.md")
-	sampleReqContent := `# REQ-EX-001: Sample Requirement
+// initSampleRequirement is the spec file of the example requirement.
+const initSampleRequirement = `# REQ-EX-001: Sample Requirement
 
 ## Description
 This is a sample requirement demonstrating the RTMX requirement file format.
@@ -142,28 +233,74 @@ None
 ## Notes
 This is a sample requirement. Replace with your actual requirements.
 `
-	if err := os.WriteFile(sampleReqFile, []byte(sampleReqContent), 0644); err != nil {
-		return fmt.Errorf("failed to create sample requirement: %w", err)
+
+func initRtmxStructure(cmd *cobra.Command, cwd string) error {
+	database, requirements := initPaths(".rtmx/database.csv", ".rtmx/requirements")
+	rtmxDir := filepath.Join(cwd, ".rtmx")
+	rtmCSV := initProjectPath(cwd, database)
+	requirementsDir := initProjectPath(cwd, requirements)
+	configFile := filepath.Join(rtmxDir, "config.yaml")
+	cacheDir := filepath.Join(rtmxDir, "cache")
+	gitignore := filepath.Join(rtmxDir, ".gitignore")
+
+	// Check for existing files
+	if !initForce {
+		var existing []string
+		for _, path := range []string{rtmxDir, rtmCSV, configFile} {
+			if _, err := os.Stat(path); err == nil {
+				existing = append(existing, path)
+			}
+		}
+		if len(existing) > 0 {
+			cmd.Printf("%s The following already exist:\n", output.Color("Warning:", output.Yellow))
+			for _, f := range existing {
+				cmd.Printf("  %s\n", f)
+			}
+			cmd.Println()
+			cmd.Printf("%s\n", output.Color("Use --force to overwrite", output.Dim))
+			return NewExitError(1, "")
+		}
 	}
-	cmd.Printf("  %s Created %s\n", output.Color("✓", output.Green), sampleReqFile)
 
-	// Create config file
-	configContent := `# RTMX Configuration
-# See https://rtmx.ai for documentation
+	// Create directories
+	cmd.Printf("Creating RTM structure in %s/.rtmx/\n\n", cwd)
 
-rtmx:
-  database: .rtmx/database.csv
-  requirements_dir: .rtmx/requirements
-  schema: core
-  pytest:
-    marker_prefix: "req"
-    register_markers: true
+	if err := os.MkdirAll(rtmxDir, 0755); err != nil {
+		return fmt.Errorf("failed to create .rtmx directory: %w", err)
+	}
+	if err := os.MkdirAll(requirementsDir, 0755); err != nil {
+		return fmt.Errorf("failed to create requirements directory: %w", err)
+	}
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	// Create .gitignore
+	gitignoreContent := `# RTMX cache and generated files
+cache/
 `
+	if err := os.WriteFile(gitignore, []byte(gitignoreContent), 0644); err != nil {
+		return fmt.Errorf("failed to create .gitignore: %w", err)
+	}
+	cmd.Printf("  %s Created %s\n", output.Color("✓", output.Green), gitignore)
+
+	if err := writeInitRTM(cmd, cwd, rtmCSV, requirements); err != nil {
+		return err
+	}
+
+	// Create config file
+	configContent := initConfigContent(database, requirements)
 	if err := os.WriteFile(configFile, []byte(configContent), 0644); err != nil {
 		return fmt.Errorf("failed to create config.yaml: %w", err)
 	}
 	cmd.Printf("  %s Created %s\n", output.Color("✓", output.Green), configFile)
 
+	if initGit {
+		if err := initGitAttributes(cmd, cwd, database); err != nil {
+			return err
+		}
+	}
+
 	cmd.Println()
 	cmd.Printf("%s\n", output.Color("✓ RTM initialized successfully!", output.Green))
 	cmd.Println()
@@ -176,8 +313,9 @@ rtmx:
 }
 
 func initLegacyStructure(cmd *cobra.Command, cwd string) error {
-	rtmCSV := filepath.Join(cwd, "docs", "rtm_database.csv")
-	requirementsDir := filepath.Join(cwd, "docs", "requirements")
+	database, requirements := initPaths("docs/rtm_database.csv", "docs/requirements")
+	rtmCSV := initProjectPath(cwd, database)
+	requirementsDir := initProjectPath(cwd, requirements)
 	configFile := filepath.Join(cwd, "rtmx.yaml")
 
 	// Check for existing files
@@ -197,7 +335,7 @@ func initLegacyStructure(cmd *cobra.Command, cwd string) error {
 			}
 			cmd.Println()
 			cmd.Printf("%s\n", output.Color("Use --force to overwrite", output.Dim))
-			os.Exit(1)
+			return NewExitError(1, "")
 		}
 	}
 
@@ -211,72 +349,23 @@ func initLegacyStructure(cmd *cobra.Command, cwd string) error {
 		return fmt.Errorf("failed to create requirements directory: %w", err)
 	}
 
-	// Create sample RTM database
-	sampleRTM := `req_id,category,subcategory,requirement_text,target_value,test_module,test_function,validation_method,status,priority,phase,notes,effort_weeks,dependencies,blocks,assignee,sprint,started_date,completed_date,requirement_file
-REQ-EX-001,EXAMPLE,SAMPLE,Sample requirement for demonstration,Target value here,tests/test_example.py,test_sample,Unit Test,MISSING,MEDIUM,1,This is a sample requirement,1.0,,,developer,v0.1,,,docs/requirements/EXAMPLE/REQ-EX-001.md
-`
-	if err := os.WriteFile(rtmCSV, []byte(sampleRTM), 0644); err != nil {
-		return fmt.Errorf("failed to create rtm_database.csv: %w", err)
-	}
-	cmd.Printf("  %s Created %s\n", output.Color("✓", output.Green), rtmCSV)
-
-	// Create sample requirement file
-	sampleReqDir := filepath.Join(requirementsDir, "EXAMPLE")
-	if err := os.MkdirAll(sampleReqDir, 0755); err != nil {
-		return fmt.Errorf("failed to create EXAMPLE directory: %w", err)
-	}
-
-	sampleReqFile := filepath.Join(sampleReqDir, "REQ-EX-001.md")
-	sampleReqContent := `# REQ-EX-001: Sample Requirement
-
-## Description
-This is a sample requirement demonstrating the RTMX requirement file format.
-
-## Target
-**Metric**: Target value here
-
-## Acceptance Criteria
-- [ ] Achieves target value
-- [ ] Test implemented and passing
-- [ ] Documentation complete
-
-## Implementation
-- **Status**: MISSING
-- **Phase**: 1
-- **Priority**: MEDIUM
-
-## Validation
-- **Test**: tests/test_example.py::test_sample
-- **Method**: Unit Test
-
-## Dependencies
-None
-
-## Notes
-This is a sample requirement. Replace with your actual requirements.
-`
-	if err := os.WriteFile(sampleReqFile, []byte(sampleReqContent), 0644); err != nil {
-		return fmt.Errorf("failed to create sample requirement: %w", err)
+	if err := writeInitRTM(cmd, cwd, rtmCSV, requirements); err != nil {
+		return err
 	}
-	cmd.Printf("  %s Created %s\n", output.Color("✓", output.Green), sampleReqFile)
 
 	// Create config file
-	configContent := `# RTMX Configuration
-# See https://rtmx.ai for documentation
-
-rtmx:
-  database: docs/rtm_database.csv
-  requirements_dir: docs/requirements
-  schema: core
-  pytest:
-    marker_prefix: "req"
-    register_markers: true
-`
+	configContent := initConfigContent(database, requirements)
 	if err := os.WriteFile(configFile, []byte(configContent), 0644); err != nil {
 		return fmt.Errorf("failed to create rtmx.yaml: %w", err)
 	}
 	cmd.Printf("  %s Created %s\n", output.Color("✓", output.Green), configFile)
 
+	if initGit {
+		if err := initGitAttributes(cmd, cwd, database); err != nil {
+			return err
+		}
+	}
+
 	cmd.Println()
 	cmd.Printf("%s\n", output.Color("✓ RTM initialized successfully!", output.Green))
 	cmd.Println()
@@ -3,9 +3,13 @@ package cmd
 import (
 	"bytes"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
 )
 
 func TestInitCommand(t *testing.T) {
@@ -197,3 +201,243 @@ func TestInitConfigContent(t *testing.T) {
 		t.Error("Config should have rtmx section")
 	}
 }
+
+func TestInitConfigFlags(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	t.Cleanup(func() {
+		_ = os.Chdir(oldWd)
+		initDatabase, initRequirementsDir, initSchema = "", "", "core"
+		initGitHubRepo, initJiraServer, initJiraProject = "", "", ""
+		initNoSample = false
+		rootCmd.SetOut(nil)
+	})
+
+	buf := new(bytes.Buffer)
+	rootCmd.SetOut(buf)
+	rootCmd.SetArgs([]string{"init",
+		"--database", "rtm/requirements.csv",
+		"--requirements-dir", "rtm/specs",
+		"--schema", "phoenix",
+		"--github-repo", "acme/app",
+		"--jira-server", "https://acme.atlassian.net",
+		"--jira-project", "APP",
+		"--no-sample",
+	})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("init command failed: %v", err)
+	}
+
+	cfg, err := config.Load(filepath.Join(tmpDir, ".rtmx", "config.yaml"))
+	if err != nil {
+		t.Fatalf("Failed to load generated config: %v", err)
+	}
+	if cfg.RTMX.Database != "rtm/requirements.csv" || cfg.RTMX.RequirementsDir != "rtm/specs" || cfg.RTMX.Schema != "phoenix" {
+		t.Errorf("config paths = %s, %s, %s; want the flag values", cfg.RTMX.Database, cfg.RTMX.RequirementsDir, cfg.RTMX.Schema)
+	}
+	if gh := cfg.RTMX.Adapters.GitHub; !gh.Enabled || gh.Repo != "acme/app" {
+		t.Errorf("GitHub adapter = %+v, want enabled for acme/app", gh)
+	}
+	if jira := cfg.RTMX.Adapters.Jira; !jira.Enabled || jira.Server != "https://acme.atlassian.net" || jira.Project != "APP" {
+		t.Errorf("Jira adapter = %+v, want enabled for APP", jira)
+	}
+
+	// --no-sample leaves only the header in the database
+	db, err := database.Load(filepath.Join(tmpDir, "rtm", "requirements.csv"))
+	if err != nil {
+		t.Fatalf("Failed to load generated database: %v", err)
+	}
+	if db.Len() != 0 {
+		t.Errorf("Expected an empty database with --no-sample, got %d requirements", db.Len())
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "rtm", "specs", "EXAMPLE")); !os.IsNotExist(err) {
+		t.Error("Expected no example spec file with --no-sample")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "rtm", "specs")); err != nil {
+		t.Errorf("Expected the requirements directory to be created: %v", err)
+	}
+}
+
+func TestInitJiraFlagsTogether(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	t.Cleanup(func() {
+		_ = os.Chdir(oldWd)
+		initJiraServer = ""
+	})
+
+	rootCmd.SetArgs([]string{"init", "--jira-server", "https://acme.atlassian.net"})
+	if err := rootCmd.Execute(); err == nil {
+		t.Error("Expected --jira-server without --jira-project to fail")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, ".rtmx")); !os.IsNotExist(err) {
+		t.Error("Expected nothing to be created")
+	}
+}
+
+func TestInitGitAttributes(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	t.Cleanup(func() {
+		_ = os.Chdir(oldWd)
+		initDatabase, initGit = "", false
+		initCmd.SetOut(nil)
+	})
+
+	// Existing attributes are kept
+	if err := os.WriteFile(filepath.Join(tmpDir, ".gitattributes"), []byte("*.png binary"), 0644); err != nil {
+		t.Fatalf("Failed to write .gitattributes: %v", err)
+	}
+
+	initDatabase, initGit = "rtm/requirements.csv", true
+	buf := new(bytes.Buffer)
+	initCmd.SetOut(buf)
+	if err := runInit(initCmd, nil); err != nil {
+		t.Fatalf("init command failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, ".gitattributes"))
+	if err != nil {
+		t.Fatalf("Failed to read .gitattributes: %v", err)
+	}
+	want := "/rtm/requirements.csv text eol=lf diff=rtmx-csv merge=rtmx-csv\n"
+	if !strings.HasPrefix(string(content), "*.png binary\n") || !strings.HasSuffix(string(content), want) {
+		t.Errorf(".gitattributes = %q, want the existing entry and %q", content, want)
+	}
+	if !strings.Contains(buf.String(), "Not a git repository") {
+		t.Errorf("Expected a warning that the merge driver was not registered:\n%s", buf.String())
+	}
+
+	// A second run does not add the entry again
+	if added, err := writeRTMGitAttributes(tmpDir, "rtm/requirements.csv"); err != nil || added {
+		t.Errorf("writeRTMGitAttributes = %v, %v; want the existing entry kept", added, err)
+	}
+	if added, _ := writeRTMGitAttributes(tmpDir, ".rtmx/database.csv"); !added {
+		t.Error("Expected an entry for another database path")
+	}
+}
+
+func TestInitGitAttributesAbsoluteDatabase(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	cwd, _ := os.Getwd()
+	t.Cleanup(func() {
+		_ = os.Chdir(oldWd)
+		initDatabase, initGit = "", false
+		initCmd.SetOut(nil)
+	})
+
+	initDatabase, initGit = filepath.Join(cwd, "rtm", "requirements.csv"), true
+	initCmd.SetOut(new(bytes.Buffer))
+	if err := runInit(initCmd, nil); err != nil {
+		t.Fatalf("init command failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, ".gitattributes"))
+	if err != nil {
+		t.Fatalf("Failed to read .gitattributes: %v", err)
+	}
+	if want := "/rtm/requirements.csv text eol=lf"; !strings.Contains(string(content), want) {
+		t.Errorf(".gitattributes = %q, want the repo-relative entry %q", content, want)
+	}
+
+	if _, err := gitRelativePath(filepath.Join(cwd, "sub"), filepath.Join(cwd, "rtm.csv")); err == nil {
+		t.Error("Expected a database outside the directory to be an error")
+	}
+}
+
+func TestRTMGitMergeDriver(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	tmpDir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", tmpDir).CombinedOutput(); err != nil {
+		t.Fatalf("git init failed: %s", out)
+	}
+
+	if err := installRTMGitDriver(tmpDir); err != nil {
+		t.Fatalf("installRTMGitDriver failed: %v", err)
+	}
+	gitConfig := exec.Command("git", "config", "--get", "merge.rtmx-csv.driver")
+	gitConfig.Dir = tmpDir
+	if out, err := gitConfig.Output(); err != nil || strings.TrimSpace(string(out)) != "rtmx merge-driver %O %A %B" {
+		t.Errorf("merge.rtmx-csv.driver = %q, %v", out, err)
+	}
+
+	// Both sides append a row; once sorted by ID the additions no longer
+	// touch the same lines
+	write := func(name string, ids ...string) string {
+		db := database.NewDatabase()
+		for _, id := range ids {
+			_ = db.Add(database.NewRequirement(id))
+		}
+		path := filepath.Join(tmpDir, name)
+		if err := db.Save(path); err != nil {
+			t.Fatalf("Failed to save %s: %v", name, err)
+		}
+		return path
+	}
+	base := write("base.csv", "REQ-M-002", "REQ-M-004")
+	ours := write("ours.csv", "REQ-M-002", "REQ-M-004", "REQ-M-005")
+	theirs := write("theirs.csv", "REQ-M-002", "REQ-M-004", "REQ-M-001")
+
+	if err := runMergeDriver(mergeDriverCmd, []string{base, ours, theirs}); err != nil {
+		t.Fatalf("merge-driver failed: %v", err)
+	}
+	merged, err := database.Load(ours)
+	if err != nil {
+		t.Fatalf("Failed to load the merged database: %v", err)
+	}
+	if got := strings.Join(merged.IDs(), ","); got != "REQ-M-001,REQ-M-002,REQ-M-004,REQ-M-005" {
+		t.Errorf("merged IDs = %s, want both additions in ID order", got)
+	}
+}
+
+func TestInitKeepsExistingDatabase(t *testing.T) {
+	tmpDir := t.TempDir()
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	t.Cleanup(func() {
+		_ = os.Chdir(oldWd)
+		initDatabase, initForce = "", false
+		initCmd.SetOut(nil)
+	})
+
+	existing := initRTMHeader + "REQ-KEEP-001,KEEP,,Existing requirement,,,,,MISSING,HIGH,1,,,,,,,,,\n"
+	dbPath := filepath.Join(tmpDir, "rtm", "database.csv")
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0755); err != nil {
+		t.Fatalf("Failed to create rtm dir: %v", err)
+	}
+	if err := os.WriteFile(dbPath, []byte(existing), 0644); err != nil {
+		t.Fatalf("Failed to write database: %v", err)
+	}
+
+	// Without --force an existing database is refused, relative or absolute
+	buf := new(bytes.Buffer)
+	initCmd.SetOut(buf)
+	for _, path := range []string{"rtm/database.csv", dbPath} {
+		initDatabase = path
+		if err := runInit(initCmd, nil); err == nil {
+			t.Errorf("Expected init --database %s to refuse an existing database", path)
+		}
+		if content, _ := os.ReadFile(dbPath); string(content) != existing {
+			t.Fatalf("Expected the existing database to be kept, got:\n%s", content)
+		}
+		if _, err := os.Stat(filepath.Join(tmpDir, ".rtmx")); !os.IsNotExist(err) {
+			t.Fatal("Expected nothing to be created")
+		}
+	}
+
+	// An absolute path is used as is, not joined onto the project
+	initDatabase, initForce = dbPath, true
+	if err := runInit(initCmd, nil); err != nil {
+		t.Fatalf("init --force failed: %v", err)
+	}
+	if db, err := database.Load(dbPath); err != nil || !db.Exists("REQ-EX-001") {
+		t.Errorf("Expected --force to write the database at %s: %v", dbPath, err)
+	}
+}
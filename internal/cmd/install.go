@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/rtmx-ai/rtmx-go/internal/config"
 	"github.com/rtmx-ai/rtmx-go/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -81,15 +82,15 @@ const prePushHookTemplate = `#!/bin/sh
 
 echo "Checking test marker compliance..."
 if command -v pytest >/dev/null 2>&1; then
-    # Count tests with @pytest.mark.req marker
-    WITH_REQ=$(pytest tests/ --collect-only -q -m req 2>/dev/null | grep -c "::test_" || echo "0")
+    # Count tests with @pytest.mark.{{marker}} marker
+    WITH_REQ=$(pytest tests/ --collect-only -q -m {{marker}} 2>/dev/null | grep -c "::test_" || echo "0")
     TOTAL=$(pytest tests/ --collect-only -q 2>/dev/null | grep -c "::test_" || echo "0")
 
     if [ "$TOTAL" -gt 0 ]; then
         PCT=$((WITH_REQ * 100 / TOTAL))
         if [ "$PCT" -lt 80 ]; then
             echo "Test marker compliance is ${PCT}% (requires 80%)."
-            echo "Push aborted. Add @pytest.mark.req() markers to tests."
+            echo "Push aborted. Add @pytest.mark.{{marker}}() markers to tests."
             exit 1
         fi
         echo "Test marker compliance: ${PCT}%"
@@ -227,6 +228,16 @@ func runInstall(cmd *cobra.Command, args []string) error {
 	return runAgentInstall(cmd)
 }
 
+// prePushHook renders the pre-push hook for the pytest marker configured
+// in dir.
+func prePushHook(dir string) string {
+	marker := config.DefaultMarkerPrefix
+	if cfg, err := config.LoadFromDir(dir); err == nil {
+		marker = cfg.MarkerPrefix()
+	}
+	return strings.ReplaceAll(prePushHookTemplate, "{{marker}}", marker)
+}
+
 func runHooksInstall(cmd *cobra.Command) error {
 	cmd.Println("=== RTMX Git Hooks ===")
 	cmd.Println()
@@ -272,7 +283,7 @@ func runHooksInstall(cmd *cobra.Command) error {
 	}
 
 	if installPrePush {
-		hooks = append(hooks, hookInfo{"pre-push", prePushHookTemplate})
+		hooks = append(hooks, hookInfo{"pre-push", prePushHook(cwd)})
 	}
 
 	if installRemove {
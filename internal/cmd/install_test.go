@@ -238,3 +238,24 @@ func TestInstallHookTemplates(t *testing.T) {
 		t.Error("Pre-push template should contain pytest check")
 	}
 }
+
+func TestPrePushHookMarker(t *testing.T) {
+	dir := t.TempDir()
+	if hook := prePushHook(dir); !strings.Contains(hook, "-m req ") {
+		t.Errorf("Expected the default req marker, got:\n%s", hook)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, ".rtmx"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".rtmx", "config.yaml"), []byte("rtmx:\n  pytest:\n    marker_prefix: proj\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	hook := prePushHook(dir)
+	if !strings.Contains(hook, "-m proj ") || !strings.Contains(hook, "@pytest.mark.proj()") {
+		t.Errorf("Expected the configured proj marker, got:\n%s", hook)
+	}
+	if strings.Contains(hook, "{{marker}}") {
+		t.Errorf("Expected every marker placeholder filled, got:\n%s", hook)
+	}
+}
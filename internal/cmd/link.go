@@ -0,0 +1,240 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	linkCommits []string
+	linkPRs     []string
+	linkScan    bool
+	linkSince   string
+	linkDryRun  bool
+)
+
+var linkCmd = &cobra.Command{
+	Use:   "link [REQ-ID]",
+	Short: "Link commits and pull requests to requirements",
+	Long: `Record which commits and pull requests implemented a requirement.
+
+Link explicitly with --commit and --pr, or use --scan to read the git log
+and link every commit whose message cites a requirement ID in the RTM.
+
+Examples:
+    rtmx link REQ-AUTH-001 --commit 3b15fff
+    rtmx link REQ-AUTH-001 --pr https://github.com/org/repo/pull/42
+    rtmx link --scan                    # Scan the whole history
+    rtmx link --scan --since v1.2.0     # Scan commits after a ref
+    rtmx link --scan --dry-run`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runLink,
+}
+
+func init() {
+	linkCmd.Flags().StringArrayVar(&linkCommits, "commit", nil, "commit SHA to link (repeatable)")
+	linkCmd.Flags().StringArrayVar(&linkPRs, "pr", nil, "pull request URL or reference to link (repeatable)")
+	linkCmd.Flags().BoolVar(&linkScan, "scan", false, "link commits whose messages cite requirement IDs")
+	linkCmd.Flags().StringVar(&linkSince, "since", "", "with --scan, only scan commits after this ref")
+	linkCmd.Flags().BoolVar(&linkDryRun, "dry-run", false, "show links without writing")
+
+	rootCmd.AddCommand(linkCmd)
+}
+
+// gitCommit is a commit read from the git log.
+type gitCommit struct {
+	SHA     string
+	Message string
+}
+
+// reqCitationPattern matches tokens shaped like requirement IDs (e.g. REQ-AUTH-001).
+var reqCitationPattern = regexp.MustCompile(`\b[A-Z][A-Z0-9]*(?:-[A-Z0-9]+)+\b`)
+
+func runLink(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	if !linkScan && len(args) == 0 {
+		return fmt.Errorf("specify a requirement ID or --scan")
+	}
+	if !linkScan && len(linkCommits) == 0 && len(linkPRs) == 0 {
+		return fmt.Errorf("specify --commit or --pr to link")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	dbPath := cfg.DatabasePath(cwd)
+	db, err := database.Load(dbPath)
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	if linkDryRun {
+		cmd.Println(output.Color("DRY RUN - no changes will be made", output.Yellow))
+		cmd.Println()
+	}
+
+	added := 0
+	if len(args) == 1 {
+		req := db.Get(args[0])
+		if req == nil {
+			return fmt.Errorf("requirement %q not found", args[0])
+		}
+		for _, sha := range linkCommits {
+			if addCommitLink(req, sha, linkDryRun) {
+				cmd.Printf("  %s %s ← commit %s\n", output.Color("+", output.Green), req.ReqID, sha)
+				added++
+			}
+		}
+		for _, pr := range linkPRs {
+			if addPRLink(req, pr, linkDryRun) {
+				cmd.Printf("  %s %s ← PR %s\n", output.Color("+", output.Green), req.ReqID, pr)
+				added++
+			}
+		}
+	}
+
+	if linkScan {
+		commits, err := readGitLog(cwd, linkSince)
+		if err != nil {
+			return err
+		}
+		cmd.Printf("Scanned %d commit(s)\n", len(commits))
+
+		links := linkCommitsToRequirements(db, commits)
+		ids := make([]string, 0, len(links))
+		for id := range links {
+			ids = append(ids, id)
+		}
+		database.SortReqIDs(ids)
+
+		for _, id := range ids {
+			for _, sha := range links[id] {
+				if addCommitLink(db.Get(id), sha, linkDryRun) {
+					cmd.Printf("  %s %s ← commit %s\n", output.Color("+", output.Green), id, shortSHA(sha))
+					added++
+				}
+			}
+		}
+	}
+
+	if added == 0 {
+		cmd.Println("No new links.")
+		return nil
+	}
+	cmd.Printf("\n%d new link(s)\n", added)
+
+	if linkDryRun {
+		return nil
+	}
+	if err := db.Save(dbPath); err != nil {
+		return databaseSaveError(err)
+	}
+	return nil
+}
+
+// linkCommitsToRequirements maps requirement IDs to the commits citing them.
+// Only IDs present in the database are linked.
+func linkCommitsToRequirements(db *database.Database, commits []gitCommit) map[string][]string {
+	links := make(map[string][]string)
+	for _, c := range commits {
+		seen := make(map[string]bool)
+		for _, id := range reqCitationPattern.FindAllString(c.Message, -1) {
+			if seen[id] || !db.Exists(id) {
+				continue
+			}
+			seen[id] = true
+			links[id] = append(links[id], c.SHA)
+		}
+	}
+	return links
+}
+
+// readGitLog reads commit SHAs and messages from the repository at dir.
+func readGitLog(dir, since string) ([]gitCommit, error) {
+	args := []string{"log", "--format=%H%x00%B%x1e"}
+	if since != "" {
+		args = append(args, since+"..HEAD")
+	}
+
+	gitCmd := exec.Command("git", args...)
+	gitCmd.Dir = dir
+	out, err := gitCmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read git log: %w", err)
+	}
+	return parseGitLog(string(out)), nil
+}
+
+// parseGitLog parses output of git log --format=%H%x00%B%x1e.
+func parseGitLog(out string) []gitCommit {
+	var commits []gitCommit
+	for _, record := range strings.Split(out, "\x1e") {
+		record = strings.TrimLeft(record, "\n")
+		sha, message, ok := strings.Cut(record, "\x00")
+		if !ok || sha == "" {
+			continue
+		}
+		commits = append(commits, gitCommit{SHA: sha, Message: strings.TrimSpace(message)})
+	}
+	return commits
+}
+
+// addCommitLink links a commit, skipping SHAs already linked by prefix.
+func addCommitLink(req *database.Requirement, sha string, dryRun bool) bool {
+	sha = strings.TrimSpace(sha)
+	if sha == "" {
+		return false
+	}
+	for existing := range req.Commits {
+		if strings.HasPrefix(existing, sha) || strings.HasPrefix(sha, existing) {
+			return false
+		}
+	}
+	if !dryRun {
+		if req.Commits == nil {
+			req.Commits = make(database.StringSet)
+		}
+		req.Commits.Add(sha)
+	}
+	return true
+}
+
+// addPRLink links a pull request, skipping duplicates.
+func addPRLink(req *database.Requirement, pr string, dryRun bool) bool {
+	pr = strings.TrimSpace(pr)
+	if pr == "" || req.PullRequests.Contains(pr) {
+		return false
+	}
+	if !dryRun {
+		if req.PullRequests == nil {
+			req.PullRequests = make(database.StringSet)
+		}
+		req.PullRequests.Add(pr)
+	}
+	return true
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 12 {
+		return sha[:12]
+	}
+	return sha
+}
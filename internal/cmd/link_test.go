@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+func TestLinkCommitsToRequirements(t *testing.T) {
+	db := database.NewDatabase()
+	_ = db.Add(database.NewRequirement("REQ-AUTH-001"))
+	_ = db.Add(database.NewRequirement("REQ-AUTH-002"))
+
+	commits := []gitCommit{
+		{SHA: "aaa111", Message: "REQ-AUTH-001: fix"},
+		{SHA: "bbb222", Message: "Refactor login (REQ-AUTH-001, REQ-AUTH-002)"},
+		{SHA: "ccc333", Message: "Mention REQ-UNKNOWN-999 and UTF-8 handling"},
+		{SHA: "ddd444", Message: "Unrelated change"},
+	}
+
+	links := linkCommitsToRequirements(db, commits)
+
+	if got := strings.Join(links["REQ-AUTH-001"], ","); got != "aaa111,bbb222" {
+		t.Errorf("REQ-AUTH-001 commits = %q, want aaa111,bbb222", got)
+	}
+	if got := strings.Join(links["REQ-AUTH-002"], ","); got != "bbb222" {
+		t.Errorf("REQ-AUTH-002 commits = %q, want bbb222", got)
+	}
+	if len(links) != 2 {
+		t.Errorf("Expected links only for known requirements, got %v", links)
+	}
+}
+
+func TestParseGitLog(t *testing.T) {
+	out := "abc123\x00REQ-AUTH-001: fix\n\nLonger body\n\x1e\ndef456\x00Second commit\n\x1e\n"
+	commits := parseGitLog(out)
+	if len(commits) != 2 {
+		t.Fatalf("Expected 2 commits, got %d: %v", len(commits), commits)
+	}
+	if commits[0].SHA != "abc123" || !strings.HasPrefix(commits[0].Message, "REQ-AUTH-001: fix") {
+		t.Errorf("First commit = %+v", commits[0])
+	}
+	if commits[1].SHA != "def456" || commits[1].Message != "Second commit" {
+		t.Errorf("Second commit = %+v", commits[1])
+	}
+}
+
+// TestLinkScanGitRepository scans a real repository and links the commit.
+func TestLinkScanGitRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	origScan, origSince, origDryRun := linkScan, linkSince, linkDryRun
+	origCommits, origPRs := linkCommits, linkPRs
+	defer func() {
+		linkScan, linkSince, linkDryRun = origScan, origSince, origDryRun
+		linkCommits, linkPRs = origCommits, origPRs
+	}()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	db := database.NewDatabase()
+	req := database.NewRequirement("REQ-AUTH-001")
+	req.Category = "AUTH"
+	req.RequirementText = "Users can log in"
+	_ = db.Add(req)
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	git := func(args ...string) string {
+		c := exec.Command("git", args...)
+		c.Dir = tmpDir
+		c.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := c.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	git("init", "-q")
+	git("add", "-A")
+	git("commit", "-q", "-m", "REQ-AUTH-001: fix")
+	sha := git("rev-parse", "HEAD")
+
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	linkScan = true
+	linkSince = ""
+	linkDryRun = false
+	linkCommits = nil
+	linkPRs = nil
+
+	var buf bytes.Buffer
+	linkCmd.SetOut(&buf)
+	if err := runLink(linkCmd, nil); err != nil {
+		t.Fatalf("link --scan failed: %v", err)
+	}
+
+	db, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	if !db.Get("REQ-AUTH-001").Commits.Contains(sha) {
+		t.Errorf("Expected commit %s linked, got %v", sha, db.Get("REQ-AUTH-001").Commits.Slice())
+	}
+
+	// Scanning again adds nothing
+	buf.Reset()
+	if err := runLink(linkCmd, nil); err != nil {
+		t.Fatalf("second scan failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No new links") {
+		t.Errorf("Expected no new links on rescan, got:\n%s", buf.String())
+	}
+
+	// Manual PR link shows up in trace
+	linkScan = false
+	linkPRs = []string{"https://github.com/org/repo/pull/42"}
+	if err := runLink(linkCmd, []string{"REQ-AUTH-001"}); err != nil {
+		t.Fatalf("link --pr failed: %v", err)
+	}
+
+	buf.Reset()
+	traceCmd.SetOut(&buf)
+	if err := runTrace(traceCmd, []string{"REQ-AUTH-001"}); err != nil {
+		t.Fatalf("trace failed: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{sha, "https://github.com/org/repo/pull/42", "Commits", "Pull Requests"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected trace output to contain %q:\n%s", want, out)
+		}
+	}
+}
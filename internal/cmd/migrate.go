@@ -0,0 +1,340 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var migrateDryRun bool
+
+const (
+	modernDatabase        = ".rtmx/database.csv"
+	modernRequirementsDir = ".rtmx/requirements"
+	modernConfig          = ".rtmx/config.yaml"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Migrate a legacy docs/ layout to .rtmx/",
+	Long: `Upgrade a project from the legacy docs/ layout (created by init --legacy)
+to the .rtmx/ structure.
+
+The database and requirement specs are moved into .rtmx/, requirement_file
+paths are rewritten, and the configuration is moved to .rtmx/config.yaml
+with database and requirements_dir updated. Originals are copied to
+.rtmx/backup/ first.
+
+Examples:
+    rtmx migrate --dry-run    # Show the plan
+    rtmx migrate              # Migrate after confirmation
+    rtmx migrate --yes        # Migrate without prompting`,
+	RunE: runMigrate,
+}
+
+func init() {
+	migrateCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false, "show what would be migrated without changing files")
+
+	rootCmd.AddCommand(migrateCmd)
+}
+
+// migrationPlan describes the files a migration moves, all relative to root.
+type migrationPlan struct {
+	root            string
+	oldDatabase     string
+	oldRequirements string // empty when the project has no spec directory
+	oldConfig       string // empty when the project has no config file
+	backupDir       string
+	rewrites        int
+}
+
+func runMigrate(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	plan, err := planMigration(cwd)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.Load(filepath.Join(cwd, plan.oldDatabase))
+	if err != nil {
+		return databaseLoadError(err)
+	}
+	plan.rewrites = rewriteRequirementFiles(db, plan.oldRequirements)
+
+	summary := []string{
+		fmt.Sprintf("back up originals to %s", plan.backupDir),
+		fmt.Sprintf("move %s → %s", plan.oldDatabase, modernDatabase),
+	}
+	if plan.oldRequirements != "" {
+		summary = append(summary, fmt.Sprintf("move %s → %s", plan.oldRequirements, modernRequirementsDir))
+	}
+	if plan.oldConfig != "" {
+		summary = append(summary, fmt.Sprintf("move %s → %s", plan.oldConfig, modernConfig))
+	} else {
+		summary = append(summary, fmt.Sprintf("create %s", modernConfig))
+	}
+	summary = append(summary, fmt.Sprintf("rewrite %d requirement_file path(s)", plan.rewrites))
+
+	if migrateDryRun {
+		cmd.Println(output.Color("DRY RUN - no changes will be made", output.Yellow))
+		cmd.Println()
+		cmd.Println("Planned changes:")
+		for _, line := range summary {
+			cmd.Printf("  %s\n", line)
+		}
+		return nil
+	}
+
+	if !confirmChanges(cmd.OutOrStdout(), summary) {
+		return errAborted
+	}
+
+	if err := applyMigration(plan, db); err != nil {
+		return err
+	}
+
+	cmd.Printf("%s Migrated to .rtmx/ (backup in %s)\n", output.Color("✓", output.Green), plan.backupDir)
+	return nil
+}
+
+// planMigration locates the legacy files under root.
+func planMigration(root string) (*migrationPlan, error) {
+	if _, err := os.Stat(filepath.Join(root, modernDatabase)); err == nil {
+		return nil, fmt.Errorf("%s already exists; project is already migrated", modernDatabase)
+	}
+
+	plan := &migrationPlan{
+		root:            root,
+		oldDatabase:     "docs/rtm_database.csv",
+		oldRequirements: "docs/requirements",
+		backupDir:       filepath.ToSlash(filepath.Join(".rtmx", "backup", "legacy-"+time.Now().Format("20060102-150405"))),
+	}
+
+	for _, name := range []string{"rtmx.yaml", "rtmx.yml"} {
+		if _, err := os.Stat(filepath.Join(root, name)); err == nil {
+			plan.oldConfig = name
+			break
+		}
+	}
+	if plan.oldConfig != "" {
+		cfg, err := config.Load(filepath.Join(root, plan.oldConfig))
+		if err != nil {
+			return nil, configLoadError(err)
+		}
+		if cfg.RTMX.Database != "" {
+			plan.oldDatabase = filepath.ToSlash(filepath.Clean(cfg.RTMX.Database))
+		}
+		if cfg.RTMX.RequirementsDir != "" {
+			plan.oldRequirements = filepath.ToSlash(filepath.Clean(cfg.RTMX.RequirementsDir))
+		}
+	}
+
+	if filepath.IsAbs(plan.oldDatabase) || strings.HasPrefix(plan.oldDatabase, ".rtmx/") {
+		return nil, fmt.Errorf("database %s is not in a legacy location", plan.oldDatabase)
+	}
+	if _, err := os.Stat(filepath.Join(root, plan.oldDatabase)); err != nil {
+		return nil, fmt.Errorf("no legacy database found at %s", plan.oldDatabase)
+	}
+	if info, err := os.Stat(filepath.Join(root, plan.oldRequirements)); err != nil || !info.IsDir() {
+		plan.oldRequirements = ""
+	}
+
+	return plan, nil
+}
+
+// rewriteRequirementFiles points requirement_file paths under the legacy
+// spec directory at .rtmx/requirements. It returns the number rewritten.
+func rewriteRequirementFiles(db *database.Database, oldDir string) int {
+	if oldDir == "" {
+		return 0
+	}
+	prefix := strings.TrimSuffix(oldDir, "/") + "/"
+
+	count := 0
+	for _, req := range db.All() {
+		path := filepath.ToSlash(req.RequirementFile)
+		path = strings.TrimPrefix(path, "./")
+		if strings.HasPrefix(path, prefix) {
+			req.RequirementFile = modernRequirementsDir + "/" + strings.TrimPrefix(path, prefix)
+			count++
+		}
+	}
+	return count
+}
+
+// applyMigration backs up the legacy files, then moves them into .rtmx/.
+func applyMigration(plan *migrationPlan, db *database.Database) error {
+	abs := func(rel string) string { return filepath.Join(plan.root, filepath.FromSlash(rel)) }
+
+	// Back up originals before touching anything
+	backup := abs(plan.backupDir)
+	originals := []string{plan.oldDatabase, plan.oldRequirements, plan.oldConfig}
+	for _, rel := range originals {
+		if rel == "" {
+			continue
+		}
+		if err := copyPath(abs(rel), filepath.Join(backup, filepath.FromSlash(rel))); err != nil {
+			return fmt.Errorf("failed to back up %s: %w", rel, err)
+		}
+	}
+
+	if err := os.MkdirAll(abs(".rtmx/cache"), 0755); err != nil {
+		return fmt.Errorf("failed to create .rtmx directory: %w", err)
+	}
+	gitignore := abs(".rtmx/.gitignore")
+	if _, err := os.Stat(gitignore); os.IsNotExist(err) {
+		if err := os.WriteFile(gitignore, []byte("# RTMX cache and generated files\ncache/\n"), 0644); err != nil {
+			return fmt.Errorf("failed to create .gitignore: %w", err)
+		}
+	}
+
+	// Requirement specs
+	if plan.oldRequirements != "" {
+		if err := os.Rename(abs(plan.oldRequirements), abs(modernRequirementsDir)); err != nil {
+			return fmt.Errorf("failed to move requirements: %w", err)
+		}
+	} else if err := os.MkdirAll(abs(modernRequirementsDir), 0755); err != nil {
+		return fmt.Errorf("failed to create requirements directory: %w", err)
+	}
+
+	// Database, with rewritten requirement_file paths
+	if err := db.Save(abs(modernDatabase)); err != nil {
+		return databaseSaveError(err)
+	}
+	if err := os.Remove(abs(plan.oldDatabase)); err != nil {
+		return fmt.Errorf("failed to remove legacy database: %w", err)
+	}
+
+	// Config
+	var content []byte
+	if plan.oldConfig != "" {
+		data, err := os.ReadFile(abs(plan.oldConfig))
+		if err != nil {
+			return fmt.Errorf("failed to read config: %w", err)
+		}
+		content, err = setConfigPaths(data)
+		if err != nil {
+			return fmt.Errorf("failed to update config: %w", err)
+		}
+	} else {
+		content = []byte(fmt.Sprintf("rtmx:\n  database: %s\n  requirements_dir: %s\n", modernDatabase, modernRequirementsDir))
+	}
+	if err := os.WriteFile(abs(modernConfig), content, 0644); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	if plan.oldConfig != "" {
+		if err := os.Remove(abs(plan.oldConfig)); err != nil {
+			return fmt.Errorf("failed to remove legacy config: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// setConfigPaths updates rtmx.database and rtmx.requirements_dir in a YAML
+// config, keeping the rest of the document (including comments) intact.
+func setConfigPaths(data []byte) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("config is not a YAML mapping")
+	}
+
+	rtmx := mappingValue(doc.Content[0], "rtmx")
+	if rtmx == nil {
+		rtmx = &yaml.Node{Kind: yaml.MappingNode}
+		doc.Content[0].Content = append(doc.Content[0].Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "rtmx"}, rtmx)
+	}
+	setMappingValue(rtmx, "database", modernDatabase)
+	setMappingValue(rtmx, "requirements_dir", modernRequirementsDir)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func setMappingValue(node *yaml.Node, key, value string) {
+	if v := mappingValue(node, key); v != nil {
+		v.Kind = yaml.ScalarNode
+		v.Tag = ""
+		v.Value = value
+		return
+	}
+	node.Content = append(node.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Value: key},
+		&yaml.Node{Kind: yaml.ScalarNode, Value: value})
+}
+
+// copyPath copies a file or directory tree from src to dst.
+func copyPath(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(out, in); err != nil {
+			out.Close()
+			return err
+		}
+		return out.Close()
+	})
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// setupLegacyProject creates a project with the init --legacy layout.
+func setupLegacyProject(t *testing.T) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	var buf bytes.Buffer
+	initCmd.SetOut(&buf)
+	if err := initLegacyStructure(initCmd, tmpDir); err != nil {
+		t.Fatalf("Failed to create legacy project: %v", err)
+	}
+	return tmpDir
+}
+
+func TestMigrateLegacyProject(t *testing.T) {
+	origDryRun, origYes := migrateDryRun, assumeYes
+	defer func() { migrateDryRun, assumeYes = origDryRun, origYes }()
+
+	tmpDir := setupLegacyProject(t)
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	migrateDryRun = false
+	assumeYes = true
+
+	var buf bytes.Buffer
+	migrateCmd.SetOut(&buf)
+	if err := runMigrate(migrateCmd, nil); err != nil {
+		t.Fatalf("migrate failed: %v\n%s", err, buf.String())
+	}
+
+	// Legacy files moved away
+	for _, old := range []string{"docs/rtm_database.csv", "docs/requirements", "rtmx.yaml"} {
+		if _, err := os.Stat(filepath.Join(tmpDir, old)); !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be moved, stat err = %v", old, err)
+		}
+	}
+
+	// Config points at the new layout and keeps other settings
+	cfg, err := config.LoadFromDir(tmpDir)
+	if err != nil {
+		t.Fatalf("Failed to load migrated config: %v", err)
+	}
+	if cfg.RTMX.Database != ".rtmx/database.csv" || cfg.RTMX.RequirementsDir != ".rtmx/requirements" {
+		t.Errorf("Config paths = %q, %q", cfg.RTMX.Database, cfg.RTMX.RequirementsDir)
+	}
+	if cfg.RTMX.Schema != "core" {
+		t.Errorf("Expected schema to be preserved, got %q", cfg.RTMX.Schema)
+	}
+
+	// Database moved with requirement_file rewritten, and the spec followed it
+	db, err := database.Load(cfg.DatabasePath(tmpDir))
+	if err != nil {
+		t.Fatalf("Failed to load migrated database: %v", err)
+	}
+	req := db.Get("REQ-EX-001")
+	if req == nil {
+		t.Fatal("Expected REQ-EX-001 in migrated database")
+	}
+	if req.RequirementFile != ".rtmx/requirements/EXAMPLE/REQ-EX-001.md" {
+		t.Errorf("requirement_file = %q", req.RequirementFile)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, req.RequirementFile)); err != nil {
+		t.Errorf("Requirement spec not found at rewritten path: %v", err)
+	}
+
+	// Originals backed up
+	backups, _ := filepath.Glob(filepath.Join(tmpDir, ".rtmx", "backup", "legacy-*"))
+	if len(backups) != 1 {
+		t.Fatalf("Expected one backup directory, got %v", backups)
+	}
+	for _, rel := range []string{"docs/rtm_database.csv", "docs/requirements/EXAMPLE/REQ-EX-001.md", "rtmx.yaml"} {
+		if _, err := os.Stat(filepath.Join(backups[0], rel)); err != nil {
+			t.Errorf("Expected backup of %s: %v", rel, err)
+		}
+	}
+	backedUp, _ := os.ReadFile(filepath.Join(backups[0], "docs", "rtm_database.csv"))
+	if !strings.Contains(string(backedUp), "docs/requirements/EXAMPLE/REQ-EX-001.md") {
+		t.Error("Backup should keep the original requirement_file paths")
+	}
+
+	// A second run refuses
+	if err := runMigrate(migrateCmd, nil); err == nil {
+		t.Error("Expected error migrating an already migrated project")
+	}
+}
+
+func TestMigrateDryRun(t *testing.T) {
+	origDryRun, origYes := migrateDryRun, assumeYes
+	defer func() { migrateDryRun, assumeYes = origDryRun, origYes }()
+
+	tmpDir := setupLegacyProject(t)
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	migrateDryRun = true
+	assumeYes = false
+
+	var buf bytes.Buffer
+	migrateCmd.SetOut(&buf)
+	if err := runMigrate(migrateCmd, nil); err != nil {
+		t.Fatalf("migrate --dry-run failed: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"docs/rtm_database.csv → .rtmx/database.csv", "rewrite 1 requirement_file path(s)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in plan:\n%s", want, out)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, ".rtmx")); !os.IsNotExist(err) {
+		t.Error("Dry run should not create .rtmx/")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "docs", "rtm_database.csv")); err != nil {
+		t.Errorf("Dry run should leave the legacy database in place: %v", err)
+	}
+}
+
+func TestSetConfigPathsKeepsComments(t *testing.T) {
+	in := "# Project config\nrtmx:\n  database: docs/rtm_database.csv # legacy\n  schema: core\n"
+	out, err := setConfigPaths([]byte(in))
+	if err != nil {
+		t.Fatalf("setConfigPaths failed: %v", err)
+	}
+	got := string(out)
+	for _, want := range []string{"# Project config", "database: .rtmx/database.csv", "requirements_dir: .rtmx/requirements", "schema: core"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected %q in:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var noPager bool
+
+// startPager sends cmd's output through the pager unless paging is off:
+// with --no-pager, for JSON output, or when stdout is not a terminal. The
+// returned function shows the output and must run when the command is done.
+func startPager(cmd *cobra.Command) func() {
+	if noPager || wantsJSON(cmd) {
+		return func() {}
+	}
+	out := cmd.OutOrStdout()
+	pager := output.NewPager(out, output.PagerCommand(os.Getenv))
+	if !pager.Paging() {
+		return func() {}
+	}
+	cmd.SetOut(pager)
+	return func() {
+		_ = pager.Close()
+		cmd.SetOut(out)
+	}
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// ttyBuffer is a buffer that reports itself as a terminal.
+type ttyBuffer struct {
+	bytes.Buffer
+}
+
+func (b *ttyBuffer) IsTerminal() bool { return true }
+
+func TestStartPager(t *testing.T) {
+	origNoPager := noPager
+	defer func() { noPager = origNoPager }()
+
+	tests := []struct {
+		name      string
+		out       io.Writer
+		noPager   bool
+		format    string
+		wantPaged bool
+	}{
+		{"terminal", &ttyBuffer{}, false, "terminal", true},
+		{"not a terminal", &bytes.Buffer{}, false, "terminal", false},
+		{"json output", &ttyBuffer{}, false, "json", false},
+		{"--no-pager", &ttyBuffer{}, true, "terminal", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("RTMX_PAGER", "less -R")
+			noPager = tt.noPager
+			cmd := &cobra.Command{Use: "status"}
+			cmd.Flags().String("format", tt.format, "")
+			cmd.SetOut(tt.out)
+
+			done := startPager(cmd)
+			_, paged := cmd.OutOrStdout().(*output.Pager)
+			done()
+
+			if paged != tt.wantPaged {
+				t.Errorf("paged = %v, want %v", paged, tt.wantPaged)
+			}
+			if cmd.OutOrStdout() != tt.out {
+				t.Error("Expected the original output to be restored")
+			}
+		})
+	}
+}
@@ -44,13 +44,13 @@ func runReconcile(cmd *cobra.Command, args []string) error {
 
 	cfg, err := config.LoadFromDir(cwd)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return configLoadError(err)
 	}
 
 	dbPath := cfg.DatabasePath(cwd)
 	db, err := database.Load(dbPath)
 	if err != nil {
-		return fmt.Errorf("failed to load database: %w", err)
+		return databaseLoadError(err)
 	}
 
 	width := 80
@@ -156,7 +156,7 @@ func runReconcile(cmd *cobra.Command, args []string) error {
 
 	// Save database
 	if err := db.Save(dbPath); err != nil {
-		return fmt.Errorf("failed to save database: %w", err)
+		return databaseSaveError(err)
 	}
 
 	cmd.Println()
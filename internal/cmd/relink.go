@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/adapters"
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	relinkService string
+	relinkDryRun  bool
+)
+
+var relinkCmd = &cobra.Command{
+	Use:   "relink",
+	Short: "Repair external IDs by matching RTMX markers",
+	Long: `Re-match requirements to the items of an external service by the
+"RTMX: REQ-..." marker in each item, instead of by the stored external_id,
+and update each requirement's external_id for the service to the item
+that carries its marker.
+
+Use it after moving repositories or re-keying a Jira project, when the
+stored IDs are stale and sync would create duplicates.
+
+Requirements whose marker appears on more than one item are reported as
+ambiguous and left unchanged. Requirements linked to the service whose
+marker is on no item, and markers naming no requirement, are reported as
+unmatched.
+
+Examples:
+    rtmx relink --service github --dry-run
+    rtmx relink --service jira`,
+	RunE: runRelink,
+}
+
+func init() {
+	relinkCmd.Flags().StringVarP(&relinkService, "service", "s", "github", "service to relink with (github, jira)")
+	relinkCmd.Flags().BoolVar(&relinkDryRun, "dry-run", false, "report changes without writing")
+	rootCmd.AddCommand(relinkCmd)
+}
+
+// relinkChange is a requirement whose external ID is corrected.
+type relinkChange struct {
+	ReqID string
+	From  string
+	To    string
+}
+
+// relinkAmbiguity is a requirement whose marker is on several items.
+type relinkAmbiguity struct {
+	ReqID string
+	IDs   []string
+}
+
+// relinkReport is the outcome of matching items to requirements.
+type relinkReport struct {
+	Relinked  []relinkChange
+	Unchanged int
+	Ambiguous []relinkAmbiguity
+	// UnknownMarkers are items whose marker names no requirement.
+	UnknownMarkers []string
+	// Unlinked are requirements linked to the service whose marker is on
+	// no item.
+	Unlinked []string
+	// Unmarked counts items without a marker.
+	Unmarked int
+}
+
+func runRelink(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+	syncProgress = output.NewStderrProgress()
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	dbPath := cfg.DatabasePath(cwd)
+	db, err := database.Load(dbPath)
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	adapter, err := getAdapter(relinkService, cfg, syncPageProgress(relinkService))
+	if err != nil {
+		return NewTypedError(ErrorTypeConfig, "", err)
+	}
+
+	items, err := fetchItems(adapter)
+	if err != nil {
+		return NewTypedError(ErrorTypeNetwork, fmt.Sprintf("failed to fetch from %s", relinkService), err)
+	}
+
+	if relinkDryRun {
+		cmd.Printf("%s\n\n", output.Color("DRY RUN - no changes will be made", output.Yellow))
+	}
+
+	report := relinkRequirements(db, items, relinkService, !relinkDryRun)
+	displayRelinkReport(cmd, report, relinkService, relinkDryRun)
+
+	if !relinkDryRun && len(report.Relinked) > 0 {
+		if err := db.Save(dbPath); err != nil {
+			return databaseSaveError(err)
+		}
+	}
+	return nil
+}
+
+// relinkRequirements matches items to requirements by their marker and,
+// when apply is set, points each matched requirement's external ID for
+// service at its item.
+func relinkRequirements(db *database.Database, items []adapters.ExternalItem, service string, apply bool) *relinkReport {
+	report := &relinkReport{}
+
+	byReq := make(map[string][]string)
+	for _, item := range items {
+		if item.RequirementID == "" {
+			report.Unmarked++
+			continue
+		}
+		byReq[item.RequirementID] = append(byReq[item.RequirementID], item.ExternalID)
+	}
+
+	marked := make([]string, 0, len(byReq))
+	for reqID := range byReq {
+		marked = append(marked, reqID)
+	}
+	sort.Strings(marked)
+
+	for _, reqID := range marked {
+		ids := byReq[reqID]
+		req := db.Get(reqID)
+		switch {
+		case req == nil:
+			for _, id := range ids {
+				report.UnknownMarkers = append(report.UnknownMarkers, fmt.Sprintf("%s (%s)", id, reqID))
+			}
+		case len(ids) > 1:
+			sort.Strings(ids)
+			report.Ambiguous = append(report.Ambiguous, relinkAmbiguity{ReqID: reqID, IDs: ids})
+		default:
+			current := adapters.ExternalIDFor(req, service)
+			if current == ids[0] {
+				report.Unchanged++
+				continue
+			}
+			report.Relinked = append(report.Relinked, relinkChange{ReqID: reqID, From: current, To: ids[0]})
+			if apply {
+				// Drop a stale legacy ID rather than leave it behind
+				if req.ExternalIDFor(service) == "" && req.ExternalID == current {
+					req.ExternalID = ""
+				}
+				adapters.LinkExternalID(req, service, ids[0])
+			}
+		}
+	}
+
+	for _, req := range db.All() {
+		if _, ok := byReq[req.ReqID]; !ok && adapters.ExternalIDFor(req, service) != "" {
+			report.Unlinked = append(report.Unlinked, req.ReqID)
+		}
+	}
+	return report
+}
+
+func displayRelinkReport(cmd *cobra.Command, report *relinkReport, service string, dryRun bool) {
+	verb := "Relinked"
+	if dryRun {
+		verb = "Would relink"
+	}
+	for _, c := range report.Relinked {
+		from := c.From
+		if from == "" {
+			from = "(none)"
+		}
+		cmd.Printf("  %s %s %s: %s:%s → %s:%s\n", output.Color("↻", output.Blue), verb, c.ReqID, service, from, service, c.To)
+	}
+	for _, a := range report.Ambiguous {
+		cmd.Printf("  %s Ambiguous %s: marker on %s\n", output.Color("?", output.Yellow), a.ReqID, strings.Join(a.IDs, ", "))
+	}
+	for _, id := range report.UnknownMarkers {
+		cmd.Printf("  %s Unknown requirement on %s:%s\n", output.Color("!", output.Yellow), service, id)
+	}
+	for _, id := range report.Unlinked {
+		cmd.Printf("  %s Unmatched %s: no %s item carries its marker\n", output.Color("!", output.Yellow), id, service)
+	}
+
+	cmd.Println()
+	cmd.Printf("%s: %d, unchanged: %d, ambiguous: %d, unmatched: %d, unmarked items: %d\n",
+		verb, len(report.Relinked), report.Unchanged, len(report.Ambiguous),
+		len(report.UnknownMarkers)+len(report.Unlinked), report.Unmarked)
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/adapters"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+func relinkTestDB() *database.Database {
+	db := database.NewDatabase()
+	for _, id := range []string{"REQ-REL-001", "REQ-REL-002", "REQ-REL-003", "REQ-REL-004", "REQ-REL-005"} {
+		_ = db.Add(database.NewRequirement(id))
+	}
+	// Stale links from before the repository moved
+	db.Get("REQ-REL-001").SetExternalIDFor("github", "old/repo#1")
+	db.Get("REQ-REL-002").ExternalID = "old/repo#2"
+	db.Get("REQ-REL-003").SetExternalIDFor("github", "7")
+	db.Get("REQ-REL-005").SetExternalIDFor("github", "old/repo#5")
+	return db
+}
+
+func TestRelinkRequirements(t *testing.T) {
+	items := []adapters.ExternalItem{
+		{ExternalID: "11", RequirementID: "REQ-REL-001"},
+		{ExternalID: "12", RequirementID: "REQ-REL-002"},
+		{ExternalID: "7", RequirementID: "REQ-REL-003"},
+		{ExternalID: "14", RequirementID: "REQ-REL-004"},
+		{ExternalID: "15", RequirementID: "REQ-REL-004"},
+		{ExternalID: "16", RequirementID: "REQ-REL-999"},
+		{ExternalID: "17"},
+	}
+
+	// A dry run reports without touching the database
+	db := relinkTestDB()
+	report := relinkRequirements(db, items, "github", false)
+	if got := db.Get("REQ-REL-001").ExternalIDFor("github"); got != "old/repo#1" {
+		t.Errorf("dry run changed REQ-REL-001 to %q", got)
+	}
+	if len(report.Relinked) != 2 {
+		t.Fatalf("Relinked = %+v, want REQ-REL-001 and REQ-REL-002", report.Relinked)
+	}
+
+	db = relinkTestDB()
+	report = relinkRequirements(db, items, "github", true)
+
+	want := []relinkChange{
+		{ReqID: "REQ-REL-001", From: "old/repo#1", To: "11"},
+		{ReqID: "REQ-REL-002", From: "old/repo#2", To: "12"},
+	}
+	for i, c := range want {
+		if i >= len(report.Relinked) || report.Relinked[i] != c {
+			t.Errorf("Relinked = %+v, want %+v", report.Relinked, want)
+			break
+		}
+	}
+	for id, ext := range map[string]string{"REQ-REL-001": "11", "REQ-REL-002": "12", "REQ-REL-003": "7"} {
+		if got := adapters.ExternalIDFor(db.Get(id), "github"); got != ext {
+			t.Errorf("%s github ID = %q, want %q", id, got, ext)
+		}
+	}
+	if got := db.Get("REQ-REL-002").ExternalID; got != "" {
+		t.Errorf("Expected the stale legacy external_id to be dropped, got %q", got)
+	}
+
+	if report.Unchanged != 1 {
+		t.Errorf("Unchanged = %d, want 1", report.Unchanged)
+	}
+	if len(report.Ambiguous) != 1 || report.Ambiguous[0].ReqID != "REQ-REL-004" || strings.Join(report.Ambiguous[0].IDs, ",") != "14,15" {
+		t.Errorf("Ambiguous = %+v, want REQ-REL-004 on 14 and 15", report.Ambiguous)
+	}
+	if db.Get("REQ-REL-004").ExternalIDFor("github") != "" {
+		t.Error("An ambiguous requirement should be left unlinked")
+	}
+	if strings.Join(report.UnknownMarkers, ",") != "16 (REQ-REL-999)" {
+		t.Errorf("UnknownMarkers = %v, want [16 (REQ-REL-999)]", report.UnknownMarkers)
+	}
+	if strings.Join(report.Unlinked, ",") != "REQ-REL-005" {
+		t.Errorf("Unlinked = %v, want [REQ-REL-005]", report.Unlinked)
+	}
+	if report.Unmarked != 1 {
+		t.Errorf("Unmarked = %d, want 1", report.Unmarked)
+	}
+}
@@ -0,0 +1,306 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	renameCategoryDryRun      bool
+	renameCategorySubcategory bool
+	renameCategoryKeepIDs     bool
+)
+
+var renameCategoryCmd = &cobra.Command{
+	Use:   "rename-category OLD NEW",
+	Short: "Rename a category across the RTM",
+	Long: `Rename a category on every requirement that uses it.
+
+Requirement spec files are moved to the new category directory and
+requirement_file paths are rewritten. Requirement IDs that encode the
+category (e.g. REQ-AUTH-001) are renamed too, and dependency and blocks
+references to them are updated. Use --keep-ids to leave IDs unchanged.
+
+With --subcategory, OLD and NEW name a subcategory instead; only the
+subcategory field is updated.
+
+The changes are shown and must be confirmed unless --yes is given. Spec
+files are moved before the RTM is written; if a move fails, the ones
+already made are undone and the RTM is left as it was.
+
+Examples:
+    rtmx rename-category AUTH IDENTITY --dry-run
+    rtmx rename-category AUTH IDENTITY --yes
+    rtmx rename-category AUTH IDENTITY --keep-ids
+    rtmx rename-category Login SignIn --subcategory`,
+	Args: cobra.ExactArgs(2),
+	RunE: runRenameCategory,
+}
+
+func init() {
+	renameCategoryCmd.Flags().BoolVar(&renameCategoryDryRun, "dry-run", false, "show changes without writing")
+	renameCategoryCmd.Flags().BoolVar(&renameCategorySubcategory, "subcategory", false, "rename a subcategory instead of a category")
+	renameCategoryCmd.Flags().BoolVar(&renameCategoryKeepIDs, "keep-ids", false, "do not rename requirement IDs that encode the category")
+
+	rootCmd.AddCommand(renameCategoryCmd)
+}
+
+// categoryRename is the planned change for one requirement.
+type categoryRename struct {
+	req     *database.Requirement
+	newID   string
+	oldFile string
+	newFile string
+}
+
+func runRenameCategory(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	oldName, newName := args[0], args[1]
+	if oldName == newName {
+		return fmt.Errorf("old and new names are the same")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	dbPath := cfg.DatabasePath(cwd)
+	db, err := database.Load(dbPath)
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	kind := "category"
+	if renameCategorySubcategory {
+		kind = "subcategory"
+	}
+
+	var plan []categoryRename
+	for _, req := range db.All() {
+		if renameCategorySubcategory {
+			if req.Subcategory == oldName {
+				plan = append(plan, categoryRename{req: req, newID: req.ReqID})
+			}
+			continue
+		}
+		if req.Category != oldName {
+			continue
+		}
+
+		r := categoryRename{req: req, newID: req.ReqID}
+		if !renameCategoryKeepIDs {
+			r.newID = renameIDCategory(req.ReqID, oldName, newName)
+		}
+		if req.RequirementFile != "" {
+			r.oldFile = req.RequirementFile
+			r.newFile = renameSpecPath(req.RequirementFile, req.ReqID, r.newID, oldName, newName)
+		}
+		plan = append(plan, r)
+	}
+
+	if len(plan) == 0 {
+		return fmt.Errorf("no requirements with %s %q", kind, oldName)
+	}
+
+	// Refuse renames that would collide before touching anything
+	renamed := make(map[string]bool)
+	for _, r := range plan {
+		if r.newID == r.req.ReqID {
+			continue
+		}
+		if db.Exists(r.newID) || renamed[r.newID] {
+			return fmt.Errorf("cannot rename %s to %s: requirement already exists", r.req.ReqID, r.newID)
+		}
+		renamed[r.newID] = true
+	}
+
+	moves, err := planSpecMoves(cwd, plan)
+	if err != nil {
+		return err
+	}
+
+	var summary []string
+	for _, r := range plan {
+		summary = append(summary, fmt.Sprintf("%s: %s %s → %s", r.req.ReqID, kind, oldName, newName))
+		if r.newID != r.req.ReqID {
+			summary = append(summary, fmt.Sprintf("    id: %s → %s", r.req.ReqID, r.newID))
+		}
+		if r.newFile != r.oldFile {
+			summary = append(summary, fmt.Sprintf("    spec: %s → %s", r.oldFile, r.newFile))
+		}
+	}
+
+	if renameCategoryDryRun {
+		cmd.Println(output.Color("DRY RUN - no changes will be made", output.Yellow))
+		cmd.Println()
+		cmd.Println("Planned changes:")
+		for _, line := range summary {
+			cmd.Printf("  %s\n", line)
+		}
+		cmd.Println()
+		cmd.Printf("Would rename %s on %d requirement(s)\n", kind, len(plan))
+		return nil
+	}
+
+	if !confirmChanges(cmd.OutOrStdout(), summary) {
+		return errAborted
+	}
+
+	// Move every spec first, so a failure leaves the RTM untouched
+	if err := applySpecMoves(cwd, moves); err != nil {
+		return err
+	}
+
+	for _, r := range plan {
+		if renameCategorySubcategory {
+			r.req.Subcategory = newName
+			continue
+		}
+		r.req.Category = newName
+		r.req.RequirementFile = r.newFile
+		if r.newID != r.req.ReqID {
+			if err := db.Rename(r.req.ReqID, r.newID); err != nil {
+				undoSpecMoves(cwd, moves)
+				return err
+			}
+		}
+	}
+
+	if err := db.Save(dbPath); err != nil {
+		undoSpecMoves(cwd, moves)
+		return databaseSaveError(err)
+	}
+
+	cmd.Printf("%s Renamed %s %s → %s on %d requirement(s)\n",
+		output.Color("✓", output.Green), kind, oldName, newName, len(plan))
+	return nil
+}
+
+// renameIDCategory replaces the ID segment naming the category, so
+// REQ-AUTH-001 becomes REQ-IDENTITY-001. IDs without the category are
+// returned unchanged.
+func renameIDCategory(id, oldName, newName string) string {
+	parts := strings.Split(id, "-")
+	for i, part := range parts {
+		if strings.EqualFold(part, oldName) {
+			if part == strings.ToUpper(part) {
+				parts[i] = strings.ToUpper(newName)
+			} else {
+				parts[i] = newName
+			}
+			return strings.Join(parts, "-")
+		}
+	}
+	return id
+}
+
+// renameSpecPath moves a spec path from the old category directory to the
+// new one, renaming the file when it is named after the requirement ID.
+func renameSpecPath(file, oldID, newID, oldName, newName string) string {
+	file = filepath.ToSlash(file)
+	dir, base := path.Split(file)
+	dir = strings.TrimSuffix(dir, "/")
+
+	if path.Base(dir) == oldName {
+		dir = path.Join(path.Dir(dir), newName)
+	}
+	ext := path.Ext(base)
+	if strings.TrimSuffix(base, ext) == oldID {
+		base = newID + ext
+	}
+
+	if dir == "" || dir == "." {
+		return base
+	}
+	return dir + "/" + base
+}
+
+// specMove is a spec file to move, as paths relative to the project root.
+type specMove struct {
+	from, to string
+}
+
+// planSpecMoves lists the spec files the renames move, refusing before
+// anything is moved when a destination is taken. Missing specs are not
+// moved; their requirement_file paths are still rewritten.
+func planSpecMoves(root string, plan []categoryRename) ([]specMove, error) {
+	var moves []specMove
+	claimed := make(map[string]string)
+	for _, r := range plan {
+		if r.newFile == r.oldFile {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(r.oldFile))); os.IsNotExist(err) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(r.newFile))); err == nil {
+			return nil, fmt.Errorf("cannot move %s: %s already exists", r.oldFile, r.newFile)
+		}
+		if other, ok := claimed[r.newFile]; ok {
+			return nil, fmt.Errorf("cannot move both %s and %s to %s", other, r.oldFile, r.newFile)
+		}
+		claimed[r.newFile] = r.oldFile
+		moves = append(moves, specMove{from: r.oldFile, to: r.newFile})
+	}
+	return moves, nil
+}
+
+// applySpecMoves makes the planned moves. When one fails, the moves already
+// made are undone, so the specs stay where the RTM says they are.
+func applySpecMoves(root string, moves []specMove) error {
+	for i, m := range moves {
+		if err := moveSpecFile(root, m.from, m.to); err != nil {
+			undoSpecMoves(root, moves[:i])
+			return err
+		}
+	}
+	return nil
+}
+
+// undoSpecMoves moves specs back, latest first.
+func undoSpecMoves(root string, moves []specMove) {
+	for i := len(moves) - 1; i >= 0; i-- {
+		_ = moveSpecFile(root, moves[i].to, moves[i].from)
+	}
+}
+
+// moveSpecFile moves a spec file relative to root, skipping missing files
+// and removing the old directory once it is empty.
+func moveSpecFile(root, oldFile, newFile string) error {
+	src := filepath.Join(root, filepath.FromSlash(oldFile))
+	dst := filepath.Join(root, filepath.FromSlash(newFile))
+
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := os.Stat(dst); err == nil {
+		return fmt.Errorf("cannot move %s: %s already exists", oldFile, newFile)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(newFile), err)
+	}
+	if err := os.Rename(src, dst); err != nil {
+		return fmt.Errorf("failed to move %s: %w", oldFile, err)
+	}
+
+	// Only succeeds when the directory is empty
+	_ = os.Remove(filepath.Dir(src))
+	return nil
+}
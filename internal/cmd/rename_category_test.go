@@ -0,0 +1,294 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// setupRenameProject creates two AUTH requirements with spec files and a
+// requirement in another category that depends on one of them.
+func setupRenameProject(t *testing.T) string {
+	t.Helper()
+	tmpDir := setupTestProject(t, "REQ-AUTH-001", "REQ-AUTH-002", "REQ-API-001")
+	updateTestDatabase(t, tmpDir, func(db *database.Database) {
+		for _, id := range []string{"REQ-AUTH-001", "REQ-AUTH-002"} {
+			req := db.Get(id)
+			req.Subcategory = "Login"
+			req.RequirementFile = ".rtmx/requirements/AUTH/" + id + ".md"
+			writeTestFile(t, tmpDir, req.RequirementFile, "# "+id+"\n")
+		}
+		db.Get("REQ-AUTH-002").Dependencies.Add("REQ-AUTH-001")
+		db.Get("REQ-API-001").Dependencies.Add("REQ-AUTH-001")
+	})
+	return tmpDir
+}
+
+func TestRenameCategory(t *testing.T) {
+	origDryRun, origSub, origKeep, origYes := renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes
+	defer func() {
+		renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes = origDryRun, origSub, origKeep, origYes
+	}()
+	assumeYes = true
+
+	tmpDir := setupRenameProject(t)
+
+	renameCategoryDryRun = false
+	renameCategorySubcategory = false
+	renameCategoryKeepIDs = false
+
+	var buf bytes.Buffer
+	renameCategoryCmd.SetOut(&buf)
+	if err := runRenameCategory(renameCategoryCmd, []string{"AUTH", "IDENTITY"}); err != nil {
+		t.Fatalf("rename-category failed: %v\n%s", err, buf.String())
+	}
+
+	db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+
+	for _, id := range []string{"REQ-IDENTITY-001", "REQ-IDENTITY-002"} {
+		req := db.Get(id)
+		if req == nil {
+			t.Fatalf("Expected %s after rename, have %v", id, db.IDs())
+		}
+		if req.Category != "IDENTITY" {
+			t.Errorf("%s category = %q", id, req.Category)
+		}
+		if req.Subcategory != "Login" {
+			t.Errorf("%s subcategory = %q, want unchanged", id, req.Subcategory)
+		}
+		wantFile := ".rtmx/requirements/IDENTITY/" + id + ".md"
+		if req.RequirementFile != wantFile {
+			t.Errorf("%s requirement_file = %q, want %q", id, req.RequirementFile, wantFile)
+		}
+		if _, err := os.Stat(filepath.Join(tmpDir, filepath.FromSlash(wantFile))); err != nil {
+			t.Errorf("Spec not moved to %s: %v", wantFile, err)
+		}
+	}
+	if db.Exists("REQ-AUTH-001") || db.Exists("REQ-AUTH-002") {
+		t.Error("Old IDs should no longer exist")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, ".rtmx", "requirements", "AUTH")); !os.IsNotExist(err) {
+		t.Error("Expected empty AUTH spec directory to be removed")
+	}
+
+	// References follow the renamed IDs
+	if !db.Get("REQ-IDENTITY-002").Dependencies.Contains("REQ-IDENTITY-001") {
+		t.Errorf("Dependencies = %v", db.Get("REQ-IDENTITY-002").Dependencies.Slice())
+	}
+	if api := db.Get("REQ-API-001"); api.Category != "API" || !api.Dependencies.Contains("REQ-IDENTITY-001") {
+		t.Errorf("REQ-API-001 = %q deps %v", api.Category, api.Dependencies.Slice())
+	}
+}
+
+func TestRenameCategoryDryRun(t *testing.T) {
+	origDryRun, origSub, origKeep, origYes := renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes
+	defer func() {
+		renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes = origDryRun, origSub, origKeep, origYes
+	}()
+	assumeYes = true
+
+	tmpDir := setupRenameProject(t)
+
+	renameCategoryDryRun = true
+	renameCategorySubcategory = false
+	renameCategoryKeepIDs = false
+
+	var buf bytes.Buffer
+	renameCategoryCmd.SetOut(&buf)
+	if err := runRenameCategory(renameCategoryCmd, []string{"AUTH", "IDENTITY"}); err != nil {
+		t.Fatalf("rename-category --dry-run failed: %v", err)
+	}
+
+	db, _ := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if db.Get("REQ-AUTH-001") == nil || db.Get("REQ-AUTH-001").Category != "AUTH" {
+		t.Error("Dry run should not modify the database")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, ".rtmx", "requirements", "AUTH", "REQ-AUTH-001.md")); err != nil {
+		t.Errorf("Dry run should not move specs: %v", err)
+	}
+}
+
+func TestRenameCategoryOptions(t *testing.T) {
+	origDryRun, origSub, origKeep, origYes := renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes
+	defer func() {
+		renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes = origDryRun, origSub, origKeep, origYes
+	}()
+	assumeYes = true
+
+	tests := []struct {
+		name        string
+		args        []string
+		subcategory bool
+		keepIDs     bool
+		check       func(t *testing.T, db *database.Database)
+	}{
+		{
+			name:    "keep ids",
+			args:    []string{"AUTH", "IDENTITY"},
+			keepIDs: true,
+			check: func(t *testing.T, db *database.Database) {
+				req := db.Get("REQ-AUTH-001")
+				if req == nil || req.Category != "IDENTITY" {
+					t.Fatalf("Expected REQ-AUTH-001 in IDENTITY, got %+v", req)
+				}
+				if req.RequirementFile != ".rtmx/requirements/IDENTITY/REQ-AUTH-001.md" {
+					t.Errorf("requirement_file = %q", req.RequirementFile)
+				}
+			},
+		},
+		{
+			name:        "subcategory",
+			args:        []string{"Login", "SignIn"},
+			subcategory: true,
+			check: func(t *testing.T, db *database.Database) {
+				req := db.Get("REQ-AUTH-001")
+				if req == nil || req.Category != "AUTH" || req.Subcategory != "SignIn" {
+					t.Fatalf("Expected only subcategory renamed, got %+v", req)
+				}
+				if req.RequirementFile != ".rtmx/requirements/AUTH/REQ-AUTH-001.md" {
+					t.Errorf("requirement_file = %q", req.RequirementFile)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := setupRenameProject(t)
+
+			renameCategoryDryRun = false
+			renameCategorySubcategory = tt.subcategory
+			renameCategoryKeepIDs = tt.keepIDs
+
+			var buf bytes.Buffer
+			renameCategoryCmd.SetOut(&buf)
+			if err := runRenameCategory(renameCategoryCmd, tt.args); err != nil {
+				t.Fatalf("rename-category failed: %v", err)
+			}
+			db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+			if err != nil {
+				t.Fatalf("Failed to load database: %v", err)
+			}
+			tt.check(t, db)
+		})
+	}
+}
+
+func TestRenameCategoryErrors(t *testing.T) {
+	origDryRun, origSub, origKeep, origYes := renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes
+	defer func() {
+		renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes = origDryRun, origSub, origKeep, origYes
+	}()
+	assumeYes = true
+	renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs = false, false, false
+
+	tmpDir := setupRenameProject(t)
+
+	var buf bytes.Buffer
+	renameCategoryCmd.SetOut(&buf)
+	if err := runRenameCategory(renameCategoryCmd, []string{"NOPE", "OTHER"}); err == nil {
+		t.Error("Expected error for unknown category")
+	}
+	// REQ-AUTH-001 → REQ-API-001 collides with an existing requirement
+	if err := runRenameCategory(renameCategoryCmd, []string{"AUTH", "API"}); err == nil {
+		t.Error("Expected error when renamed IDs collide")
+	}
+	db, _ := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if db.Get("REQ-AUTH-001").Category != "AUTH" {
+		t.Error("Colliding rename should not modify the database")
+	}
+}
+
+func TestRenameCategoryConfirms(t *testing.T) {
+	origDryRun, origSub, origKeep, origYes := renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes
+	origReader, origInteractive := confirmReader, confirmInteractive
+	defer func() {
+		renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes = origDryRun, origSub, origKeep, origYes
+		confirmReader, confirmInteractive = origReader, origInteractive
+	}()
+	renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes = false, false, false, false
+	confirmInteractive = func() bool { return true }
+	confirmReader = strings.NewReader("n\n")
+
+	tmpDir := setupRenameProject(t)
+
+	var buf bytes.Buffer
+	renameCategoryCmd.SetOut(&buf)
+	if err := runRenameCategory(renameCategoryCmd, []string{"AUTH", "IDENTITY"}); err != errAborted {
+		t.Fatalf("Expected the declined rename to abort, got %v", err)
+	}
+	for _, want := range []string{"Planned changes:", "REQ-AUTH-001: category AUTH → IDENTITY", "id: REQ-AUTH-001 → REQ-IDENTITY-001"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected %q in the preview, got:\n%s", want, buf.String())
+		}
+	}
+	db, _ := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if db.Get("REQ-AUTH-001") == nil {
+		t.Error("Declined rename should not modify the database")
+	}
+}
+
+func TestRenameCategoryMovesNothingOnConflict(t *testing.T) {
+	origDryRun, origSub, origKeep, origYes := renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes
+	defer func() {
+		renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes = origDryRun, origSub, origKeep, origYes
+	}()
+	renameCategoryDryRun, renameCategorySubcategory, renameCategoryKeepIDs, assumeYes = false, false, false, true
+
+	tmpDir := setupRenameProject(t)
+
+	// The second spec's destination is taken, so the first must not move either
+	taken := filepath.Join(tmpDir, ".rtmx", "requirements", "IDENTITY", "REQ-IDENTITY-002.md")
+	if err := os.MkdirAll(filepath.Dir(taken), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(taken, []byte("# other\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	renameCategoryCmd.SetOut(&buf)
+	if err := runRenameCategory(renameCategoryCmd, []string{"AUTH", "IDENTITY"}); err == nil {
+		t.Fatal("Expected an error when a spec destination exists")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, ".rtmx", "requirements", "AUTH", "REQ-AUTH-001.md")); err != nil {
+		t.Errorf("REQ-AUTH-001 spec should not have moved: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, ".rtmx", "requirements", "IDENTITY", "REQ-IDENTITY-001.md")); !os.IsNotExist(err) {
+		t.Error("No spec should be moved into IDENTITY")
+	}
+	db, _ := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if req := db.Get("REQ-AUTH-001"); req == nil || req.Category != "AUTH" {
+		t.Error("Failed rename should not modify the database")
+	}
+}
+
+func TestApplySpecMovesUndoesOnFailure(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"a.md", "b.md"} {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The second move fails when its destination appears after planning
+	if err := os.WriteFile(filepath.Join(root, "taken.md"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	moves := []specMove{{from: "a.md", to: "new/a.md"}, {from: "b.md", to: "taken.md"}}
+	if err := applySpecMoves(root, moves); err == nil {
+		t.Fatal("Expected the second move to fail")
+	}
+	if _, err := os.Stat(filepath.Join(root, "a.md")); err != nil {
+		t.Errorf("First move should be undone: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "new", "a.md")); !os.IsNotExist(err) {
+		t.Error("Undone move should not leave the spec at its destination")
+	}
+}
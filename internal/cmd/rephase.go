@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rephaseTo       int
+	rephaseCategory string
+	rephaseDryRun   bool
+)
+
+var rephaseCmd = &cobra.Command{
+	Use:   "rephase [REQ-ID...]",
+	Short: "Move requirements to another phase",
+	Long: `Move requirements to a new phase.
+
+Name the requirements to move, or use --category to move every
+requirement in a category. After the move, incomplete dependencies that
+are scheduled in a later phase than the requirement depending on them are
+reported as scheduling risks. Risks are warnings; the move still happens.
+
+Examples:
+    rtmx rephase REQ-AUTH-001 --to 3
+    rtmx rephase REQ-AUTH-001 REQ-AUTH-002 --to 2 --dry-run
+    rtmx rephase --category AUTH --to 3`,
+	RunE: runRephase,
+}
+
+func init() {
+	rephaseCmd.Flags().IntVar(&rephaseTo, "to", 0, "phase to move to (required)")
+	rephaseCmd.Flags().StringVar(&rephaseCategory, "category", "", "move every requirement in this category")
+	rephaseCmd.Flags().BoolVar(&rephaseDryRun, "dry-run", false, "show changes without writing")
+
+	rootCmd.AddCommand(rephaseCmd)
+}
+
+// phaseRisk is an incomplete dependency scheduled after the requirement
+// that depends on it.
+type phaseRisk struct {
+	ReqID    string
+	Phase    int
+	DepID    string
+	DepPhase int
+}
+
+func runRephase(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	if rephaseTo < 1 {
+		return NewValidationError("--to must be a phase of 1 or more")
+	}
+	if len(args) == 0 && rephaseCategory == "" {
+		return NewValidationError("specify requirement IDs or --category")
+	}
+	if len(args) > 0 && rephaseCategory != "" {
+		return NewValidationError("use either requirement IDs or --category, not both")
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	dbPath := cfg.DatabasePath(cwd)
+	db, err := database.Load(dbPath)
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	var reqs []*database.Requirement
+	if rephaseCategory != "" {
+		for _, req := range db.All() {
+			if req.Category == rephaseCategory {
+				reqs = append(reqs, req)
+			}
+		}
+		if len(reqs) == 0 {
+			return fmt.Errorf("no requirements in category %q", rephaseCategory)
+		}
+	} else {
+		for _, id := range args {
+			req := db.Get(id)
+			if req == nil {
+				return fmt.Errorf("requirement %q not found", id)
+			}
+			reqs = append(reqs, req)
+		}
+	}
+
+	if rephaseDryRun {
+		cmd.Println(output.Color("DRY RUN - no changes will be made", output.Yellow))
+		cmd.Println()
+	}
+
+	moved := make(map[string]bool)
+	for _, req := range reqs {
+		if req.Phase == rephaseTo {
+			cmd.Printf("  %s %s is already in phase %d\n", output.Color("-", output.Dim), req.ReqID, rephaseTo)
+			continue
+		}
+		cmd.Printf("  %s %s: phase %s → %d\n", output.Color("~", output.Yellow), req.ReqID, phaseLabel(req.Phase), rephaseTo)
+		req.Phase = rephaseTo
+		moved[req.ReqID] = true
+	}
+
+	risks := phaseRisks(db, moved)
+	if len(risks) > 0 {
+		cmd.Println()
+		cmd.Printf("%s Scheduling risks (%d):\n", output.Color("⚠", output.Yellow), len(risks))
+		for _, r := range risks {
+			cmd.Printf("    %s (phase %d) depends on %s (phase %d)\n", r.ReqID, r.Phase, r.DepID, r.DepPhase)
+		}
+	}
+	cmd.Println()
+
+	if rephaseDryRun {
+		cmd.Printf("Would move %d requirement(s) to phase %d\n", len(moved), rephaseTo)
+		return nil
+	}
+	if len(moved) == 0 {
+		return nil
+	}
+
+	if err := db.Save(dbPath); err != nil {
+		return databaseSaveError(err)
+	}
+
+	cmd.Printf("%s Moved %d requirement(s) to phase %d\n", output.Color("✓", output.Green), len(moved), rephaseTo)
+	return nil
+}
+
+// phaseRisks returns the incomplete dependencies scheduled in a later
+// phase than their dependent, for dependencies of the moved requirements
+// and for requirements depending on them. Requirements without a phase
+// are not checked.
+func phaseRisks(db *database.Database, moved map[string]bool) []phaseRisk {
+	var risks []phaseRisk
+	for _, req := range db.All() {
+		if req.Phase == 0 {
+			continue
+		}
+		for _, depID := range req.Dependencies.Slice() {
+			if !moved[req.ReqID] && !moved[depID] {
+				continue
+			}
+			dep := db.Get(depID)
+			if dep == nil || dep.Phase == 0 || dep.Status.IsComplete() {
+				continue
+			}
+			if dep.Phase > req.Phase {
+				risks = append(risks, phaseRisk{ReqID: req.ReqID, Phase: req.Phase, DepID: depID, DepPhase: dep.Phase})
+			}
+		}
+	}
+	return risks
+}
+
+// phaseLabel formats a phase for display, showing an unset phase as "-".
+func phaseLabel(phase int) string {
+	if phase == 0 {
+		return "-"
+	}
+	return fmt.Sprint(phase)
+}
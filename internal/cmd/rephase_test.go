@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+func TestRephase(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		category   string
+		wantPhases map[string]int
+		wantRisk   string
+	}{
+		{
+			name:       "single requirement ahead of its dependency",
+			args:       []string{"REQ-PLAN-001"},
+			wantPhases: map[string]int{"REQ-PLAN-001": 2, "REQ-PLAN-002": 4, "REQ-PLAN-003": 1},
+			wantRisk:   "REQ-PLAN-001 (phase 2) depends on REQ-PLAN-002 (phase 4)",
+		},
+		{
+			name:       "category moves past a dependent",
+			category:   "PLAN",
+			wantPhases: map[string]int{"REQ-PLAN-001": 2, "REQ-PLAN-002": 2, "REQ-PLAN-003": 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := setupTestProject(t, "REQ-PLAN-001", "REQ-PLAN-002", "REQ-PLAN-003")
+			dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+			db, err := database.Load(dbPath)
+			if err != nil {
+				t.Fatalf("Failed to load database: %v", err)
+			}
+			db.Get("REQ-PLAN-001").Phase = 1
+			db.Get("REQ-PLAN-001").Dependencies.Add("REQ-PLAN-002")
+			db.Get("REQ-PLAN-002").Phase = 4
+			db.Get("REQ-PLAN-003").Phase = 1
+			if err := db.Save(dbPath); err != nil {
+				t.Fatalf("Failed to save database: %v", err)
+			}
+
+			origTo, origCategory, origDryRun := rephaseTo, rephaseCategory, rephaseDryRun
+			defer func() { rephaseTo, rephaseCategory, rephaseDryRun = origTo, origCategory, origDryRun }()
+			rephaseTo, rephaseCategory, rephaseDryRun = 2, tt.category, false
+
+			var buf bytes.Buffer
+			rephaseCmd.SetOut(&buf)
+			if err := runRephase(rephaseCmd, tt.args); err != nil {
+				t.Fatalf("rephase failed: %v\n%s", err, buf.String())
+			}
+			out := buf.String()
+
+			db, err = database.Load(dbPath)
+			if err != nil {
+				t.Fatalf("Failed to reload database: %v", err)
+			}
+			for id, want := range tt.wantPhases {
+				if got := db.Get(id).Phase; got != want {
+					t.Errorf("%s phase = %d, want %d", id, got, want)
+				}
+			}
+
+			if tt.wantRisk != "" {
+				if !strings.Contains(out, "Scheduling risks (1)") || !strings.Contains(out, tt.wantRisk) {
+					t.Errorf("Expected risk %q, got:\n%s", tt.wantRisk, out)
+				}
+			} else if strings.Contains(out, "Scheduling risks") {
+				t.Errorf("Expected no risks, got:\n%s", out)
+			}
+		})
+	}
+}
+
+func TestRephaseRequiresTarget(t *testing.T) {
+	setupTestProject(t, "REQ-PLAN-001")
+
+	origTo, origCategory := rephaseTo, rephaseCategory
+	defer func() { rephaseTo, rephaseCategory = origTo, origCategory }()
+	rephaseTo, rephaseCategory = 0, ""
+
+	var buf bytes.Buffer
+	rephaseCmd.SetOut(&buf)
+	if err := runRephase(rephaseCmd, []string{"REQ-PLAN-001"}); err == nil {
+		t.Error("Expected rephase without --to to fail")
+	}
+}
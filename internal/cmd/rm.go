@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var rmCmd = &cobra.Command{
+	Use:   "rm REQ-ID...",
+	Short: "Remove requirements from the RTM database",
+	Long: `Remove one or more requirements from the RTM database.
+
+References to the removed requirements are also dropped from the
+dependencies and blocks of the remaining requirements. The planned
+changes are shown and must be confirmed unless --yes is given.
+
+Examples:
+    rtmx rm REQ-OLD-001
+    rtmx rm REQ-OLD-001 REQ-OLD-002 --yes`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runRm,
+}
+
+func init() {
+	rootCmd.AddCommand(rmCmd)
+}
+
+func runRm(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	dbPath := cfg.DatabasePath(cwd)
+	db, err := database.Load(dbPath)
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	var ids []string
+	removing := make(map[string]bool)
+	for _, id := range args {
+		if !db.Exists(id) {
+			return fmt.Errorf("requirement %q not found", id)
+		}
+		if !removing[id] {
+			removing[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	// Plan the removal and the references it leaves behind
+	var summary []string
+	for _, id := range ids {
+		summary = append(summary, fmt.Sprintf("remove %s: %s", id, output.Truncate(db.Get(id).RequirementText, 50)))
+	}
+	for _, req := range db.All() {
+		if removing[req.ReqID] {
+			continue
+		}
+		for _, id := range ids {
+			if req.Dependencies.Contains(id) || req.Blocks.Contains(id) {
+				summary = append(summary, fmt.Sprintf("drop reference to %s from %s", id, req.ReqID))
+			}
+		}
+	}
+
+	if !confirmChanges(cmd.OutOrStdout(), summary) {
+		return errAborted
+	}
+
+	for _, req := range db.All() {
+		for id := range removing {
+			req.Dependencies.Remove(id)
+			req.Blocks.Remove(id)
+		}
+	}
+	for _, id := range ids {
+		if err := db.Remove(id); err != nil {
+			return err
+		}
+	}
+
+	if err := db.Save(dbPath); err != nil {
+		return databaseSaveError(err)
+	}
+
+	cmd.Printf("%s Removed %d requirement(s)\n", output.Color("✓", output.Green), len(ids))
+	return nil
+}
@@ -3,7 +3,14 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
 
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -17,8 +24,11 @@ var (
 )
 
 var (
-	cfgFile string
-	noColor bool
+	cfgFile    string
+	configEnv  string
+	noColor    bool
+	quiet      bool
+	dateFormat string
 )
 
 // ExitError is an error that carries an exit code.
@@ -26,15 +36,32 @@ var (
 type ExitError struct {
 	Code    int
 	Message string
+
+	// Type classifies the error for the JSON error envelope.
+	Type ErrorType
+	// Details are extra lines, such as individual validation failures.
+	Details []string
+	// Err is the underlying error, if any.
+	Err error
 }
 
 func (e *ExitError) Error() string {
-	if e.Message != "" {
+	switch {
+	case e.Message != "" && e.Err != nil:
+		return e.Message + ": " + e.Err.Error()
+	case e.Message != "":
 		return e.Message
+	case e.Err != nil:
+		return e.Err.Error()
 	}
 	return fmt.Sprintf("exit code %d", e.Code)
 }
 
+// Unwrap returns the underlying error.
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
 // NewExitError creates a new ExitError with the given code.
 func NewExitError(code int, message string) *ExitError {
 	return &ExitError{Code: code, Message: message}
@@ -50,16 +77,43 @@ var rootCmd = &cobra.Command{
 It provides commands to track requirements, run verification tests, manage dependencies,
 and synchronize with external tools like GitHub and Jira.
 
+Projects can set default flag values per command under "defaults:" in
+rtmx.yaml. A flag given on the command line always wins, then the
+config default, then the built-in default.
+
+Use --env (or RTMX_ENV) to merge an environment overlay such as
+rtmx.ci.yaml over the base config; the overlay's settings win.
+
+Long output on a terminal (status, backlog, trace) is shown through
+$RTMX_PAGER, $PAGER or "less -R"; use --no-pager to print it directly.
+
+Exit codes: 1 validation or general failure, 3 file I/O error, 4 network
+error, 5 configuration error. health and diff exit 2 for blocking
+findings, as their help describes. Commands run with --format json
+report errors as {"error": {"type", "message", "details"}} on stdout.
+
 Documentation: https://rtmx.ai/docs
 Source: https://github.com/rtmx-ai/rtmx-go`,
-	SilenceUsage:  true,
-	SilenceErrors: true,
+	SilenceUsage:      true,
+	SilenceErrors:     true,
+	PersistentPreRunE: applyConfigDefaults,
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
+// Errors are reported before returning; use ExitCode for the exit status.
 func Execute() error {
-	return rootCmd.Execute()
+	return executeRoot(rootCmd, os.Stderr)
+}
+
+// executeRoot runs root and reports any error to stderr, or as a JSON
+// envelope when the command was asked for JSON output.
+func executeRoot(root *cobra.Command, stderr io.Writer) error {
+	cmd, err := root.ExecuteC()
+	if err != nil {
+		reportError(cmd, err, stderr)
+	}
+	return err
 }
 
 func init() {
@@ -67,7 +121,17 @@ func init() {
 
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default: .rtmx/config.yaml or rtmx.yaml)")
+	rootCmd.PersistentFlags().StringVar(&configEnv, "env", "", "merge the config overlay for this environment, e.g. ci for rtmx.ci.yaml (default: $RTMX_ENV)")
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colored output")
+	rootCmd.PersistentFlags().BoolVar(&quiet, "quiet", false, "suppress progress indicators")
+	rootCmd.PersistentFlags().BoolVar(&noPager, "no-pager", false, "do not page long output")
+	rootCmd.PersistentFlags().BoolVarP(&assumeYes, "yes", "y", false, "apply changes without asking for confirmation")
+	rootCmd.PersistentFlags().StringVar(&dateFormat, "date-format", "", "Go time layout for written and displayed dates, e.g. 02.01.2006 (default: locale.date_format or 2006-01-02)")
+
+	// Bad flags are validation errors
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return NewTypedError(ErrorTypeValidation, "", err)
+	})
 
 	// Add subcommands
 	rootCmd.AddCommand(versionCmd)
@@ -76,7 +140,85 @@ func init() {
 	rootCmd.AddCommand(healthCmd)
 }
 
+// applyConfigDefaults sets flags not given on the command line from the
+// project's defaults block and applies the configured statuses and locale.
+// Config load errors are left to the command.
+func applyConfigDefaults(cmd *cobra.Command, args []string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return applyLocale(config.LocaleConfig{})
+	}
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return applyLocale(config.LocaleConfig{})
+	}
+	database.SetCustomStatuses(cfg.RTMX.Statuses.Custom)
+	database.SetIncompleteStatuses(cfg.RTMX.Statuses.Incomplete)
+	if err := applyLocale(cfg.RTMX.Locale); err != nil {
+		return err
+	}
+	return applyCommandDefaults(cmd, cfg)
+}
+
+// applyLocale sets the date layout, from --date-format or the config, and
+// the decimal separator used for output.
+func applyLocale(locale config.LocaleConfig) error {
+	layout := locale.DateFormat
+	if dateFormat != "" {
+		layout = dateFormat
+	}
+	if layout != "" {
+		if err := database.ValidateDateLayout(layout); err != nil {
+			return NewValidationError(err.Error())
+		}
+	}
+	database.SetDateLayout(layout)
+	output.SetDecimalSeparator(locale.DecimalSeparator)
+	return nil
+}
+
+// displayDate shows a stored date in the configured date layout, leaving
+// values that do not parse as they are.
+func displayDate(value string) string {
+	t, err := database.ParseDate(value)
+	if err != nil {
+		return value
+	}
+	return database.FormatDate(t)
+}
+
+// applyCommandDefaults applies cfg's defaults for cmd to its unchanged flags.
+// The flags stay unchanged, so commands can still tell an explicit flag
+// from a configured default.
+func applyCommandDefaults(cmd *cobra.Command, cfg *config.Config) error {
+	name := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+	defaults := cfg.RTMX.Defaults[name]
+
+	flags := make([]string, 0, len(defaults))
+	for flag := range defaults {
+		flags = append(flags, flag)
+	}
+	sort.Strings(flags)
+
+	for _, flag := range flags {
+		f := cmd.Flags().Lookup(flag)
+		if f == nil {
+			return fmt.Errorf("invalid config default defaults.%s.%s: unknown flag --%s", name, flag, flag)
+		}
+		if f.Changed {
+			continue
+		}
+		if err := f.Value.Set(defaults[flag]); err != nil {
+			return fmt.Errorf("invalid config default defaults.%s.%s: %w", name, flag, err)
+		}
+	}
+	return nil
+}
+
 func initConfig() {
+	output.SetQuiet(quiet)
+	config.SetEnvironment(configEnv)
+
 	// Config loading is handled by individual commands via config.LoadFromDir()
 	// The --config flag is reserved for future use
 	_ = cfgFile // Suppress unused warning until implemented
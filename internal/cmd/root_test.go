@@ -2,10 +2,17 @@ package cmd
 
 import (
 	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +27,76 @@ func executeCommand(root *cobra.Command, args ...string) (string, error) {
 	return buf.String(), err
 }
 
+// setupTestProject creates a project whose database has the given
+// requirements, all MISSING and each in the category named by its ID
+// (REQ-AUTH-001 is in AUTH), and changes into it.
+func setupTestProject(t *testing.T, ids ...string) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	db := database.NewDatabase()
+	for _, id := range ids {
+		req := database.NewRequirement(id)
+		if parts := strings.Split(id, "-"); len(parts) > 2 {
+			req.Category = parts[1]
+		}
+		req.RequirementText = "Requirement " + id
+		_ = db.Add(req)
+	}
+	if err := db.Save(filepath.Join(tmpDir, ".rtmx", "database.csv")); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+	return tmpDir
+}
+
+// updateTestDatabase applies update to the database of a project made by
+// setupTestProject and saves it.
+func updateTestDatabase(t *testing.T, dir string, update func(db *database.Database)) {
+	t.Helper()
+	dbPath := filepath.Join(dir, ".rtmx", "database.csv")
+	db, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	update(db)
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+}
+
+// writeTestConfig replaces the config of a project made by
+// setupTestProject with the given rtmx settings, which follow the
+// database setting and are indented to match it.
+func writeTestConfig(t *testing.T, dir, settings string) {
+	t.Helper()
+	config := "rtmx:\n  database: .rtmx/database.csv\n" + settings
+	if err := os.WriteFile(filepath.Join(dir, ".rtmx", "config.yaml"), []byte(config), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+}
+
+// writeTestFile writes content to a slash-separated path under dir,
+// creating its directories.
+func writeTestFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create %s: %v", filepath.Dir(name), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+}
+
 // newTestStatusCmd creates a fresh status command for testing.
 func newTestStatusCmd() *cobra.Command {
 	var verbosity int
@@ -322,3 +399,175 @@ func TestHealthJSON(t *testing.T) {
 		t.Errorf("expected JSON output, got: %s", output)
 	}
 }
+
+func TestConfigCommandDefaults(t *testing.T) {
+	tmpDir := t.TempDir()
+	config := `rtmx:
+  database: .rtmx/database.csv
+  defaults:
+    backlog:
+      view: critical
+      limit: "5"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "rtmx.yaml"), []byte(config), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	newRoot := func() *cobra.Command {
+		root := &cobra.Command{Use: "rtmx", PersistentPreRunE: applyConfigDefaults}
+		root.AddCommand(newTestBacklogCmd())
+		return root
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{"config default used", []string{"backlog"}, []string{"View: critical", "Limit: 5"}},
+		{"flag overrides config", []string{"backlog", "--view", "blockers"}, []string{"View: blockers", "Limit: 5"}},
+		{"explicit built-in value overrides config", []string{"backlog", "--view", "all", "-n", "2"}, []string{"View: all", "Limit: 2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := executeCommand(newRoot(), tt.args...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("expected %q in output, got: %s", want, output)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigCommandDefaultsAreNotExplicit(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Defaults = map[string]map[string]string{"assign": {"release": "v2.0"}}
+
+	var release string
+	root := &cobra.Command{Use: "rtmx"}
+	assign := &cobra.Command{Use: "assign"}
+	assign.Flags().StringVar(&release, "release", "", "release")
+	root.AddCommand(assign)
+
+	if err := applyCommandDefaults(assign, cfg); err != nil {
+		t.Fatalf("applyCommandDefaults failed: %v", err)
+	}
+	if release != "v2.0" {
+		t.Errorf("release = %q, want the configured default", release)
+	}
+	if assign.Flags().Changed("release") {
+		t.Error("Expected a configured default not to count as an explicit flag")
+	}
+}
+
+func TestConfigCommandDefaultsUnknownFlag(t *testing.T) {
+	tmpDir := t.TempDir()
+	config := "rtmx:\n  defaults:\n    backlog:\n      colour: blue\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, "rtmx.yaml"), []byte(config), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	root := &cobra.Command{Use: "rtmx", PersistentPreRunE: applyConfigDefaults, SilenceUsage: true, SilenceErrors: true}
+	root.AddCommand(newTestBacklogCmd())
+	if _, err := executeCommand(root, "backlog"); err == nil || !strings.Contains(err.Error(), "defaults.backlog.colour") {
+		t.Errorf("expected unknown flag error, got %v", err)
+	}
+}
+
+func TestLocaleDateFormat(t *testing.T) {
+	origFix, origDateFormat, origNow := validateFix, dateFormat, backlogNow
+	defer func() {
+		validateFix, dateFormat, backlogNow = origFix, origDateFormat, origNow
+		database.SetDateLayout("")
+		output.SetDecimalSeparator("")
+	}()
+	backlogNow = func() time.Time { return time.Date(2025, 6, 15, 9, 0, 0, 0, time.Local) }
+
+	tmpDir := t.TempDir()
+	config := `rtmx:
+  database: .rtmx/database.csv
+  locale:
+    date_format: "02.01.2006"
+    decimal_separator: ","
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "rtmx.yaml"), []byte(config), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	db := database.NewDatabase()
+	done := database.NewRequirement("REQ-LOC-001")
+	done.Category, done.RequirementText, done.Phase = "LOC", "Done", 1
+	done.Status = database.StatusComplete
+	_ = db.Add(done)
+	due := database.NewRequirement("REQ-LOC-002")
+	due.Category, due.RequirementText = "LOC", "Overdue"
+	due.DueDate = "2025-06-01"
+	_ = db.Add(due)
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	origDir, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(origDir) }()
+
+	if err := applyConfigDefaults(validateCmd, nil); err != nil {
+		t.Fatalf("applyConfigDefaults failed: %v", err)
+	}
+
+	// validate --fix writes completed_date in the configured layout, and
+	// validate accepts it afterwards
+	validateCmd.SetOut(new(bytes.Buffer))
+	defer validateCmd.SetOut(nil)
+	validateFix = true
+	_ = runValidate(validateCmd, nil)
+	fixed, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	if got, want := fixed.Get("REQ-LOC-001").CompletedDate, time.Now().Format("02.01.2006"); got != want {
+		t.Errorf("completed_date = %q, want %q", got, want)
+	}
+	validateFix = false
+	if err := runValidate(validateCmd, nil); err != nil {
+		t.Errorf("Expected the configured date layout to validate, got %v", err)
+	}
+
+	// Displayed dates and percentages follow the locale
+	out, err := executeCommand(createBacklogTestCmd(), "backlog", "--view", "overdue")
+	if err != nil {
+		t.Fatalf("backlog failed: %v", err)
+	}
+	for _, want := range []string{"01.06.2025", "MISSING: 1 (50,0%)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output:\n%s", want, out)
+		}
+	}
+
+	// --date-format overrides the config and must be a usable layout
+	dateFormat = "2006/01/02"
+	if err := applyConfigDefaults(validateCmd, nil); err != nil || database.DateLayout() != "2006/01/02" {
+		t.Errorf("--date-format: layout = %q, err = %v; want 2006/01/02", database.DateLayout(), err)
+	}
+	dateFormat = "Monday"
+	var exitErr *ExitError
+	if err := applyConfigDefaults(validateCmd, nil); !errors.As(err, &exitErr) || exitErr.Type != ErrorTypeValidation {
+		t.Errorf("expected a validation error for a layout without a date, got %v", err)
+	}
+}
@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var scaffoldDryRun bool
+
+var scaffoldCmd = &cobra.Command{
+	Use:   "scaffold [REQ-ID...]",
+	Short: "Generate requirement spec files",
+	Long: `Generate a spec file for each requirement that does not have one.
+
+Requirements without a requirement_file get a path from
+requirement_file_template (default "{{.Category}}/{{.ID}}.md" under
+requirements_dir), and the database is updated. Existing spec files are
+never overwritten; one already at the template path is linked. With no arguments every requirement is scaffolded.
+
+Examples:
+    rtmx scaffold
+    rtmx scaffold REQ-AUTH-001 REQ-AUTH-002
+    rtmx scaffold --dry-run`,
+	RunE: runScaffold,
+}
+
+func init() {
+	scaffoldCmd.Flags().BoolVar(&scaffoldDryRun, "dry-run", false, "show files that would be created")
+
+	rootCmd.AddCommand(scaffoldCmd)
+}
+
+func runScaffold(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	dbPath := cfg.DatabasePath(cwd)
+	db, err := database.Load(dbPath)
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	var reqs []*database.Requirement
+	if len(args) == 0 {
+		reqs = db.All()
+	} else {
+		for _, id := range args {
+			req := db.Get(id)
+			if req == nil {
+				return fmt.Errorf("requirement %s not found", id)
+			}
+			reqs = append(reqs, req)
+		}
+	}
+
+	if scaffoldDryRun {
+		cmd.Println(output.Color("DRY RUN - no changes will be made", output.Yellow))
+		cmd.Println()
+	}
+
+	created, linked, err := scaffoldSpecs(cmd, cwd, cfg, reqs, scaffoldDryRun)
+	if err != nil {
+		return err
+	}
+
+	if scaffoldDryRun {
+		cmd.Printf("Would create %d spec file(s)\n", created)
+		return nil
+	}
+
+	if linked > 0 {
+		if err := db.Save(dbPath); err != nil {
+			return databaseSaveError(err)
+		}
+	}
+
+	cmd.Printf("%s Created %d spec file(s)\n", output.Color("✓", output.Green), created)
+	return nil
+}
+
+// scaffoldSpecs writes a spec file for each requirement whose spec is
+// missing, assigning requirement_file from the configured template when it
+// is empty. A spec already at the template path is linked, not rewritten.
+// It returns the number of files created and of requirements given a new
+// requirement_file.
+func scaffoldSpecs(cmd *cobra.Command, root string, cfg *config.Config, reqs []*database.Requirement, dryRun bool) (created, linked int, err error) {
+	for _, req := range reqs {
+		file := req.RequirementFile
+		if file == "" {
+			file, err = cfg.RequirementFile(req.Category, req.ReqID, req.Phase)
+			if err != nil {
+				return created, linked, err
+			}
+		}
+
+		specPath := filepath.Join(root, filepath.FromSlash(file))
+		if _, err := os.Stat(specPath); err == nil {
+			// Link a spec that already exists at the template path
+			if req.RequirementFile == "" {
+				cmd.Printf("  %s %s\n", output.Color("[LINK]", output.Cyan), file)
+				linked++
+				if !dryRun {
+					req.RequirementFile = file
+				}
+			}
+			continue
+		}
+
+		cmd.Printf("  %s %s\n", output.Color("[CREATE]", output.Green), file)
+		created++
+		if req.RequirementFile == "" {
+			linked++
+		}
+		if dryRun {
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(specPath), 0755); err != nil {
+			return created, linked, fmt.Errorf("failed to create %s: %w", filepath.Dir(file), err)
+		}
+		if err := os.WriteFile(specPath, []byte(specFileContent(req)), 0644); err != nil {
+			return created, linked, fmt.Errorf("failed to write %s: %w", file, err)
+		}
+		req.RequirementFile = file
+	}
+	return created, linked, nil
+}
+
+// specFileContent renders a starter spec file for a requirement.
+func specFileContent(req *database.Requirement) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s: %s\n\n", req.ReqID, req.RequirementText))
+	sb.WriteString("## Description\n")
+	sb.WriteString(req.RequirementText + "\n\n")
+	if req.TargetValue != "" {
+		sb.WriteString("## Target\n")
+		sb.WriteString(fmt.Sprintf("**Metric**: %s\n\n", req.TargetValue))
+	}
+	sb.WriteString("## Acceptance Criteria\n")
+	sb.WriteString("- [ ] Test implemented and passing\n\n")
+	sb.WriteString("## Implementation\n")
+	sb.WriteString(fmt.Sprintf("- **Status**: %s\n", req.Status))
+	sb.WriteString(fmt.Sprintf("- **Phase**: %d\n", req.Phase))
+	sb.WriteString(fmt.Sprintf("- **Priority**: %s\n", req.Priority))
+	if req.TestModule != "" {
+		sb.WriteString("\n## Validation\n")
+		sb.WriteString(fmt.Sprintf("- **Test**: %s::%s\n", req.TestModule, req.TestFunction))
+		sb.WriteString(fmt.Sprintf("- **Method**: %s\n", req.ValidationMethod))
+	}
+	return sb.String()
+}
+
+// specPathConfig returns the configuration used to lay out spec files for
+// a project whose requirements live in requirementsDir, keeping any
+// requirement_file_template already configured in root.
+func specPathConfig(root, requirementsDir string) *config.Config {
+	cfg := config.DefaultConfig()
+	if loaded, err := config.LoadFromDir(root); err == nil && loaded.RTMX.RequirementFileTemplate != "" {
+		cfg.RTMX.RequirementFileTemplate = loaded.RTMX.RequirementFileTemplate
+	}
+	cfg.RTMX.RequirementsDir = requirementsDir
+	return cfg
+}
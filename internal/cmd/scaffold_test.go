@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// setupScaffoldProject creates a project laying out spec files by phase.
+func setupScaffoldProject(t *testing.T) string {
+	t.Helper()
+	tmpDir := setupTestProject(t, "REQ-AUTH-001", "REQ-AUTH-002")
+	writeTestConfig(t, tmpDir, "  requirements_dir: .rtmx/requirements\n  requirement_file_template: \"phase-{{.Phase}}/{{.ID}}.md\"\n")
+	updateTestDatabase(t, tmpDir, func(db *database.Database) {
+		db.Get("REQ-AUTH-001").Phase = 2
+		db.Get("REQ-AUTH-002").Phase = 1
+		db.Get("REQ-AUTH-002").RequirementFile = ".rtmx/requirements/custom/auth.md"
+	})
+	return tmpDir
+}
+
+func TestScaffoldUsesRequirementFileTemplate(t *testing.T) {
+	tmpDir := setupScaffoldProject(t)
+
+	origDryRun := scaffoldDryRun
+	defer func() { scaffoldDryRun = origDryRun }()
+	scaffoldDryRun = false
+
+	var buf bytes.Buffer
+	scaffoldCmd.SetOut(&buf)
+	defer scaffoldCmd.SetOut(nil)
+
+	if err := runScaffold(scaffoldCmd, nil); err != nil {
+		t.Fatalf("runScaffold failed: %v", err)
+	}
+
+	want := filepath.Join(tmpDir, ".rtmx", "requirements", "phase-2", "REQ-AUTH-001.md")
+	content, err := os.ReadFile(want)
+	if err != nil {
+		t.Fatalf("expected spec at %s: %v", want, err)
+	}
+	if !strings.HasPrefix(string(content), "# REQ-AUTH-001: Requirement REQ-AUTH-001") {
+		t.Errorf("unexpected spec content:\n%s", content)
+	}
+
+	// An existing requirement_file path is kept
+	if _, err := os.Stat(filepath.Join(tmpDir, ".rtmx", "requirements", "custom", "auth.md")); err != nil {
+		t.Errorf("expected spec at existing requirement_file path: %v", err)
+	}
+
+	db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	if got := db.Get("REQ-AUTH-001").RequirementFile; got != ".rtmx/requirements/phase-2/REQ-AUTH-001.md" {
+		t.Errorf("requirement_file = %q, want phase-based path", got)
+	}
+
+	// A second run creates nothing
+	buf.Reset()
+	if err := runScaffold(scaffoldCmd, nil); err != nil {
+		t.Fatalf("second runScaffold failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Created 0 spec file(s)") {
+		t.Errorf("expected no new files on second run, got:\n%s", buf.String())
+	}
+}
+
+func TestScaffoldDryRun(t *testing.T) {
+	tmpDir := setupScaffoldProject(t)
+
+	origDryRun := scaffoldDryRun
+	defer func() { scaffoldDryRun = origDryRun }()
+	scaffoldDryRun = true
+
+	var buf bytes.Buffer
+	scaffoldCmd.SetOut(&buf)
+	defer scaffoldCmd.SetOut(nil)
+
+	if err := runScaffold(scaffoldCmd, []string{"REQ-AUTH-001"}); err != nil {
+		t.Fatalf("runScaffold failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), ".rtmx/requirements/phase-2/REQ-AUTH-001.md") {
+		t.Errorf("expected planned path in output, got:\n%s", buf.String())
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, ".rtmx", "requirements", "phase-2")); !os.IsNotExist(err) {
+		t.Error("dry run should not create files")
+	}
+}
+
+func TestScaffoldLinksExistingSpec(t *testing.T) {
+	tmpDir := setupScaffoldProject(t)
+	writeTestFile(t, tmpDir, ".rtmx/requirements/phase-2/REQ-AUTH-001.md", "# Written by hand\n")
+
+	origDryRun := scaffoldDryRun
+	defer func() { scaffoldDryRun = origDryRun }()
+	scaffoldDryRun = false
+
+	var buf bytes.Buffer
+	scaffoldCmd.SetOut(&buf)
+	defer scaffoldCmd.SetOut(nil)
+
+	if err := runScaffold(scaffoldCmd, []string{"REQ-AUTH-001"}); err != nil {
+		t.Fatalf("runScaffold failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "[LINK]") {
+		t.Errorf("expected the existing spec to be linked, got:\n%s", buf.String())
+	}
+
+	content, _ := os.ReadFile(filepath.Join(tmpDir, ".rtmx", "requirements", "phase-2", "REQ-AUTH-001.md"))
+	if string(content) != "# Written by hand\n" {
+		t.Errorf("existing spec was rewritten:\n%s", content)
+	}
+	db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	if got := db.Get("REQ-AUTH-001").RequirementFile; got != ".rtmx/requirements/phase-2/REQ-AUTH-001.md" {
+		t.Errorf("requirement_file = %q, want the existing spec", got)
+	}
+}
+
+func TestAddUsesRequirementFileTemplate(t *testing.T) {
+	tmpDir := setupScaffoldProject(t)
+
+	origCategory, origText, origPhase, origPriority := addCategory, addText, addPhase, addPriority
+	defer func() {
+		addCategory, addText, addPhase, addPriority = origCategory, origText, origPhase, origPriority
+	}()
+	addCategory, addText, addPhase, addPriority = "AUTH", "Sessions expire", 3, "MEDIUM"
+
+	var buf bytes.Buffer
+	addCmd.SetOut(&buf)
+	defer addCmd.SetOut(nil)
+
+	if err := runAdd(addCmd, []string{"REQ-AUTH-003"}); err != nil {
+		t.Fatalf("runAdd failed: %v", err)
+	}
+
+	db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	if got := db.Get("REQ-AUTH-003").RequirementFile; got != ".rtmx/requirements/phase-3/REQ-AUTH-003.md" {
+		t.Errorf("requirement_file = %q, want phase-based path", got)
+	}
+}
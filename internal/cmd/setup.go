@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
 	"github.com/rtmx-ai/rtmx-go/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -23,6 +24,7 @@ var (
 	setupBranch      bool
 	setupPR          bool
 	setupScaffold    bool
+	setupGit         bool
 )
 
 var setupCmd = &cobra.Command{
@@ -37,7 +39,8 @@ Examples:
     rtmx setup --minimal    # Just config and RTM database
     rtmx setup --branch     # Create git branch for review workflow
     rtmx setup --pr         # Create branch and pull request
-    rtmx setup --scaffold   # Generate spec files for all requirements`,
+    rtmx setup --scaffold   # Generate spec files for all requirements
+    rtmx setup --git        # Add the RTM to .gitattributes with the rtmx merge driver`,
 	RunE: runSetup,
 }
 
@@ -50,6 +53,7 @@ func init() {
 	setupCmd.Flags().BoolVar(&setupBranch, "branch", false, "create git branch for isolation")
 	setupCmd.Flags().BoolVar(&setupPR, "pr", false, "create pull request after setup (implies --branch)")
 	setupCmd.Flags().BoolVar(&setupScaffold, "scaffold", false, "auto-generate requirement spec files from database entries")
+	setupCmd.Flags().BoolVar(&setupGit, "git", false, "add the database to .gitattributes and register the rtmx merge driver")
 
 	rootCmd.AddCommand(setupCmd)
 }
@@ -229,13 +233,18 @@ rtmx:
 	docsDir := filepath.Join(cwd, "docs")
 	rtmPath := filepath.Join(docsDir, "rtm_database.csv")
 	reqDir := filepath.Join(docsDir, "requirements")
+	specCfg := specPathConfig(cwd, "docs/requirements")
+	sampleFile, err := specCfg.RequirementFile("SETUP", "REQ-INIT-001", 1)
+	if err != nil {
+		return NewTypedError(ErrorTypeConfig, "", err)
+	}
 
 	if detection["has_rtm_database"].(bool) && !setupForce {
 		cmd.Printf("  %s RTM database already exists\n", output.Color("[SKIP]", output.Dim))
 		result.StepsSkipped = append(result.StepsSkipped, "create_rtm")
 	} else {
 		rtmContent := `req_id,category,subcategory,requirement_text,target_value,test_module,test_function,validation_method,status,priority,phase,notes,effort_weeks,dependencies,blocks,assignee,sprint,started_date,completed_date,requirement_file
-REQ-INIT-001,SETUP,RTMX,RTMX integration complete,Fully configured,tests/test_rtmx.py,test_rtmx_configured,Unit Test,MISSING,HIGH,1,Auto-generated by rtmx setup,0.5,,,developer,v0.1,,,docs/requirements/SETUP/REQ-INIT-001.md
+REQ-INIT-001,SETUP,RTMX,RTMX integration complete,Fully configured,tests/test_rtmx.py,test_rtmx_configured,Unit Test,MISSING,HIGH,1,Auto-generated by rtmx setup,0.5,,,developer,v0.1,,,` + sampleFile + `
 `
 		if !setupDryRun {
 			_ = os.MkdirAll(docsDir, 0755)
@@ -254,9 +263,8 @@ REQ-INIT-001,SETUP,RTMX,RTMX integration complete,Fully configured,tests/test_rt
 			}
 
 			// Create sample requirement spec
-			specDir := filepath.Join(reqDir, "SETUP")
-			_ = os.MkdirAll(specDir, 0755)
-			specPath := filepath.Join(specDir, "REQ-INIT-001.md")
+			specPath := filepath.Join(cwd, filepath.FromSlash(sampleFile))
+			_ = os.MkdirAll(filepath.Dir(specPath), 0755)
 			specContent := `# REQ-INIT-001: RTMX Integration Complete
 
 ## Description
@@ -277,11 +285,63 @@ RTMX has been integrated into this project for requirements traceability.
 			}
 		}
 		cmd.Printf("  %s docs/rtm_database.csv\n", output.Color("[CREATE]", output.Green))
-		cmd.Printf("  %s docs/requirements/SETUP/REQ-INIT-001.md\n", output.Color("[CREATE]", output.Green))
+		cmd.Printf("  %s %s\n", output.Color("[CREATE]", output.Green), sampleFile)
 		result.StepsCompleted = append(result.StepsCompleted, "create_rtm")
 	}
 	cmd.Println()
 
+	// Phase 3.5: Scaffold requirement specs (if requested)
+	if setupScaffold {
+		cmd.Println(output.SubHeader("Phase 3.5: Requirement Specs", 60))
+		if err := setupScaffoldSpecs(cmd, cwd, specCfg, result); err != nil {
+			cmd.Printf("  %s %v\n", output.Color("[FAIL]", output.Red), err)
+			result.Errors = append(result.Errors, fmt.Sprintf("Scaffold failed: %v", err))
+		}
+		cmd.Println()
+	}
+
+	// Phase 3.6: Git attributes (if requested)
+	if setupGit {
+		cmd.Println(output.SubHeader("Phase 3.6: Git Attributes", 60))
+		gitattributes := filepath.Join(cwd, ".gitattributes")
+		_, statErr := os.Stat(gitattributes)
+		dbPath, dbErr := setupDatabasePath(cwd, detection["has_rtmx_config"].(bool) && !setupForce)
+		if dbErr != nil {
+			cmd.Printf("  %s %v\n", output.Color("[FAIL]", output.Red), dbErr)
+			result.Errors = append(result.Errors, dbErr.Error())
+		} else if setupDryRun {
+			cmd.Printf("  %s .gitattributes entry for %s\n", output.Color("[CREATE]", output.Green), dbPath)
+		} else if added, err := writeRTMGitAttributes(cwd, dbPath); err != nil {
+			cmd.Printf("  %s %v\n", output.Color("[FAIL]", output.Red), err)
+			result.Errors = append(result.Errors, err.Error())
+		} else if !added {
+			cmd.Printf("  %s .gitattributes already covers %s\n", output.Color("[SKIP]", output.Dim), dbPath)
+			result.StepsSkipped = append(result.StepsSkipped, "gitattributes")
+		} else {
+			if statErr == nil {
+				result.FilesModified = append(result.FilesModified, gitattributes)
+			} else {
+				result.FilesCreated = append(result.FilesCreated, gitattributes)
+			}
+			cmd.Printf("  %s .gitattributes entry for %s\n", output.Color("[CREATE]", output.Green), dbPath)
+			result.StepsCompleted = append(result.StepsCompleted, "gitattributes")
+		}
+
+		if !detection["is_git_repo"].(bool) {
+			cmd.Printf("  %s Merge driver: not a git repository\n", output.Color("[SKIP]", output.Dim))
+			result.Warnings = append(result.Warnings, "merge driver not registered: not a git repository")
+		} else if setupDryRun {
+			cmd.Printf("  %s Would register the %s merge driver\n", output.Color("[SKIP]", output.Dim), rtmGitDriver)
+		} else if err := installRTMGitDriver(cwd); err != nil {
+			cmd.Printf("  %s %v\n", output.Color("[FAIL]", output.Red), err)
+			result.Errors = append(result.Errors, err.Error())
+		} else {
+			cmd.Printf("  %s Merge driver: %s\n", output.Color("[CREATE]", output.Green), rtmGitDriver)
+			result.StepsCompleted = append(result.StepsCompleted, "git_merge_driver")
+		}
+		cmd.Println()
+	}
+
 	// Phase 4: Scan tests for markers (if tests exist)
 	if detection["has_tests"].(bool) && !setupMinimal {
 		cmd.Println(output.SubHeader("Phase 4: Test Marker Scan", 60))
@@ -653,6 +713,52 @@ func detectProject(path string) map[string]interface{} {
 	return detection
 }
 
+// setupScaffoldSpecs generates spec files for every requirement in the
+// setupDatabasePath returns the database path for .gitattributes,
+// relative to cwd: the configured one when setup kept an existing config,
+// otherwise the docs/rtm_database.csv that setup writes.
+func setupDatabasePath(cwd string, keptConfig bool) (string, error) {
+	if !keptConfig {
+		return "docs/rtm_database.csv", nil
+	}
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return "", configLoadError(err)
+	}
+	return gitRelativePath(cwd, cfg.DatabasePath(cwd))
+}
+
+// setup RTM database that does not have one yet.
+func setupScaffoldSpecs(cmd *cobra.Command, cwd string, cfg *config.Config, result *SetupResult) error {
+	rtmPath := filepath.Join(cwd, "docs", "rtm_database.csv")
+	db, err := database.Load(rtmPath)
+	if err != nil {
+		if setupDryRun && os.IsNotExist(err) {
+			cmd.Printf("  %s RTM database not created yet\n", output.Color("[SKIP]", output.Dim))
+			return nil
+		}
+		return databaseLoadError(err)
+	}
+
+	created, linked, err := scaffoldSpecs(cmd, cwd, cfg, db.All(), setupDryRun)
+	if err != nil {
+		return err
+	}
+	if created == 0 && linked == 0 {
+		cmd.Printf("  %s All requirements have spec files\n", output.Color("[SKIP]", output.Dim))
+		result.StepsSkipped = append(result.StepsSkipped, "scaffold_specs")
+		return nil
+	}
+	if !setupDryRun && linked > 0 {
+		if err := db.Save(rtmPath); err != nil {
+			return databaseSaveError(err)
+		}
+		result.FilesModified = append(result.FilesModified, rtmPath)
+	}
+	result.StepsCompleted = append(result.StepsCompleted, "scaffold_specs")
+	return nil
+}
+
 func backupFile(path string) string {
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return ""
@@ -290,3 +290,18 @@ func TestSetupCommand(t *testing.T) {
 		t.Errorf("Setup command should not error in dry-run mode: %v", err)
 	}
 }
+
+func TestSetupDatabasePathUsesConfig(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "rtmx.yaml"), []byte("rtmx:\n  database: rtm/requirements.csv\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := setupDatabasePath(tmpDir, true); err != nil || got != "rtm/requirements.csv" {
+		t.Errorf("setupDatabasePath with config = %q, %v; want rtm/requirements.csv", got, err)
+	}
+	// A config setup is about to overwrite does not count
+	if got, _ := setupDatabasePath(tmpDir, false); got != "docs/rtm_database.csv" {
+		t.Errorf("setupDatabasePath without config = %q, want docs/rtm_database.csv", got)
+	}
+}
@@ -1,9 +1,13 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/rtmx-ai/rtmx-go/internal/config"
 	"github.com/rtmx-ai/rtmx-go/internal/database"
@@ -11,7 +15,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var statusVerbosity int
+var (
+	statusVerbosity int
+	statusVelocity  float64
+	statusFormat    string
+	statusByRelease bool
+	statusArchived  bool
+)
+
+// statusNow is the clock used for the completion forecast.
+var statusNow = time.Now
 
 var statusCmd = &cobra.Command{
 	Use:   "status",
@@ -22,12 +35,36 @@ The verbosity level controls how much detail is shown:
   (default)  Summary statistics only
   -v         Show status by category
   -vv        Show status by category and phase
-  -vvv       Show individual requirement details`,
+  -vvv       Show individual requirement details
+
+Remaining effort sums effort_weeks over incomplete requirements, with
+PARTIAL requirements counted at forecast.partial_fraction of their
+estimate. Blocked requirements are reported separately. With --velocity
+(effort weeks completed per sprint), status also forecasts a completion
+date using forecast.sprint_weeks as the sprint length.
+
+Categories listed under archived_categories in the config are left out
+of every figure; a closing line gives the completion over all
+requirements. Use --include-archived to count them everywhere.
+
+With --by-release, status rolls completion and remaining effort up per
+release (set with "rtmx assign --release" or imported from GitHub
+milestones and Jira fix versions).
+
+Examples:
+    rtmx status
+    rtmx status --by-release
+    rtmx status --velocity 3
+    rtmx status --velocity 3 --format json`,
 	RunE: runStatus,
 }
 
 func init() {
 	statusCmd.Flags().CountVarP(&statusVerbosity, "verbose", "v", "increase verbosity (-v, -vv, -vvv)")
+	statusCmd.Flags().Float64Var(&statusVelocity, "velocity", 0, "effort weeks completed per sprint, for the completion forecast")
+	statusCmd.Flags().StringVar(&statusFormat, "format", "terminal", "output format: terminal, json")
+	statusCmd.Flags().BoolVar(&statusByRelease, "by-release", false, "show completion per release")
+	statusCmd.Flags().BoolVar(&statusArchived, "include-archived", false, "include requirements in archived categories")
 }
 
 func runStatus(cmd *cobra.Command, args []string) error {
@@ -44,14 +81,49 @@ func runStatus(cmd *cobra.Command, args []string) error {
 
 	cfg, err := config.LoadFromDir(cwd)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return configLoadError(err)
 	}
 
 	// Load database
 	dbPath := cfg.DatabasePath(cwd)
 	db, err := database.Load(dbPath)
 	if err != nil {
-		return fmt.Errorf("failed to load database: %w", err)
+		return databaseLoadError(err)
+	}
+
+	if statusVelocity < 0 {
+		return NewValidationError("--velocity must not be negative")
+	}
+
+	var archived *archivedRollup
+	if !statusArchived {
+		db, archived = withoutArchived(db, cfg)
+	}
+
+	defer startPager(cmd)()
+
+	switch statusFormat {
+	case "json":
+		return displayStatusJSON(cmd, db, cfg, archived)
+	case "terminal", "":
+	default:
+		return NewValidationError(fmt.Sprintf("invalid format %q (use terminal or json)", statusFormat))
+	}
+
+	if err := displayTerminalStatus(cmd, db, cfg); err != nil {
+		return err
+	}
+	if archived != nil {
+		cmd.Printf("\n%s\n", output.Color(fmt.Sprintf("%d requirement(s) in archived categories (%s) hidden; all requirements: %s complete (--include-archived to show)",
+			archived.Hidden, strings.Join(archived.Categories, ", "), output.Percent(archived.Completion)), output.Dim))
+	}
+	return nil
+}
+
+// displayTerminalStatus shows the status view selected by the flags.
+func displayTerminalStatus(cmd *cobra.Command, db *database.Database, cfg *config.Config) error {
+	if statusByRelease {
+		return displayReleaseStatus(cmd, db, cfg)
 	}
 
 	// Display status based on verbosity
@@ -130,14 +202,14 @@ func displaySummaryStatus(cmd *cobra.Command, db *database.Database, cfg *config
 
 			phaseDesc := cfg.PhaseDescription(phase)
 			if phaseDesc != "" && phaseDesc != fmt.Sprintf("Phase %d", phase) {
-				cmd.Printf("Phase %d (%s): %6.1f%%  %s  (%d%s %d%s %d%s)\n",
-					phase, phaseDesc, phasePct, statusText,
+				cmd.Printf("Phase %d (%s): %7s  %s  (%d%s %d%s %d%s)\n",
+					phase, phaseDesc, output.Percent(phasePct), statusText,
 					complete, output.Color("✓", output.Green),
 					partial, output.Color("⚠", output.Yellow),
 					missing, output.Color("✗", output.Red))
 			} else {
-				cmd.Printf("Phase %d: %6.1f%%  %s  (%d%s %d%s %d%s)\n",
-					phase, phasePct, statusText,
+				cmd.Printf("Phase %d: %7s  %s  (%d%s %d%s %d%s)\n",
+					phase, output.Percent(phasePct), statusText,
 					complete, output.Color("✓", output.Green),
 					partial, output.Color("⚠", output.Yellow),
 					missing, output.Color("✗", output.Red))
@@ -146,9 +218,11 @@ func displaySummaryStatus(cmd *cobra.Command, db *database.Database, cfg *config
 		cmd.Println()
 	}
 
+	displayRemainingWork(cmd, db, cfg, width)
+
 	// Footer
-	cmd.Println(output.Header(fmt.Sprintf("%d complete, %d partial, %d missing (%.1f%%)",
-		complete, partial, missing, pct), width))
+	cmd.Println(output.Header(fmt.Sprintf("%d complete, %d partial, %d missing (%s)",
+		complete, partial, missing, output.Percent(pct)), width))
 
 	return nil
 }
@@ -215,16 +289,97 @@ func displayCategoryStatus(cmd *cobra.Command, db *database.Database, cfg *confi
 		}
 
 		// Python-style format: "  ✓ CATEGORY        100.0%   N complete   N partial   N missing"
-		cmd.Printf("  %s %s %6.1f%%   %d complete   %d partial   %d missing\n",
+		cmd.Printf("  %s %s %7s   %d complete   %d partial   %d missing\n",
 			icon,
 			output.PadRight(cat, 16),
-			catPct,
+			output.Percent(catPct),
 			complete, partial, missing)
 	}
 
 	return nil
 }
 
+// releaseRollup is the completion of the requirements in one release.
+type releaseRollup struct {
+	Release        string  `json:"release"`
+	Total          int     `json:"total"`
+	Complete       int     `json:"complete"`
+	Partial        int     `json:"partial"`
+	Missing        int     `json:"missing"`
+	Completion     float64 `json:"completion"`
+	RemainingWeeks float64 `json:"remaining_weeks"`
+}
+
+// computeReleaseRollups rolls requirements up by release, in release
+// order, with requirements without a release last under "".
+func computeReleaseRollups(db *database.Database, partialFraction float64) []releaseRollup {
+	byRelease := db.ByRelease()
+	releases := db.Releases()
+	if len(byRelease[""]) > 0 {
+		releases = append(releases, "")
+	}
+
+	rollups := make([]releaseRollup, 0, len(releases))
+	for _, release := range releases {
+		reqs := byRelease[release]
+		r := releaseRollup{Release: release, Total: len(reqs), Completion: phaseCompletion(reqs)}
+		for _, req := range reqs {
+			switch req.Status {
+			case database.StatusComplete:
+				r.Complete++
+			case database.StatusPartial:
+				r.Partial++
+				r.RemainingWeeks += req.EffortWeeks * partialFraction
+			default:
+				r.Missing++
+				r.RemainingWeeks += req.EffortWeeks
+			}
+		}
+		rollups = append(rollups, r)
+	}
+	return rollups
+}
+
+func displayReleaseStatus(cmd *cobra.Command, db *database.Database, cfg *config.Config) error {
+	width := 80
+
+	cmd.Println(output.Header("RTM Status by Release", width))
+	cmd.Println()
+
+	pct := db.CompletionPercentage()
+	cmd.Printf("Requirements: %s  %s\n", output.ProgressBar(pct, 50), output.FormatPercent(pct))
+	cmd.Println()
+
+	rollups := computeReleaseRollups(db, cfg.RTMX.Forecast.PartialFraction)
+	if len(db.Releases()) == 0 {
+		cmd.Println("No requirements are assigned to a release (rtmx assign REQ-ID --release v1.0).")
+		return nil
+	}
+
+	for _, r := range rollups {
+		var icon string
+		switch {
+		case r.Completion >= 100:
+			icon = output.Color("✓", output.Green)
+		case r.Completion >= 50:
+			icon = output.Color("⚠", output.Yellow)
+		default:
+			icon = output.Color("✗", output.Red)
+		}
+
+		name := r.Release
+		if name == "" {
+			name = "(no release)"
+		}
+		cmd.Printf("  %s %s %7s   %d complete   %d partial   %d missing   %s weeks left\n",
+			icon,
+			output.PadRight(name, 16),
+			output.Percent(r.Completion),
+			r.Complete, r.Partial, r.Missing, output.Decimal(r.RemainingWeeks, 1))
+	}
+	return nil
+}
+
 func displayPhaseStatus(cmd *cobra.Command, db *database.Database, cfg *config.Config) error {
 	width := 80
 
@@ -294,14 +449,14 @@ func displayDetailedStatus(cmd *cobra.Command, db *database.Database, cfg *confi
 		phaseDesc := cfg.PhaseDescription(phase)
 		phasePct := phaseCompletion(phaseReqs)
 
-		cmd.Println(output.SubHeader(fmt.Sprintf("Phase %d: %s (%.1f%%)", phase, phaseDesc, phasePct), width))
+		cmd.Println(output.SubHeader(fmt.Sprintf("Phase %d: %s (%s)", phase, phaseDesc, output.Percent(phasePct)), width))
 
 		// Sort by category, then by ID
 		sort.Slice(phaseReqs, func(i, j int) bool {
 			if phaseReqs[i].Category != phaseReqs[j].Category {
 				return phaseReqs[i].Category < phaseReqs[j].Category
 			}
-			return phaseReqs[i].ReqID < phaseReqs[j].ReqID
+			return database.LessReqID(phaseReqs[i].ReqID, phaseReqs[j].ReqID)
 		})
 
 		for _, req := range phaseReqs {
@@ -336,3 +491,178 @@ func phaseCompletion(reqs []*database.Requirement) float64 {
 
 	return total / float64(len(reqs))
 }
+
+// effortRollup is the estimated effort left on incomplete requirements.
+type effortRollup struct {
+	// RemainingWeeks is the effort left on requirements that are not blocked.
+	RemainingWeeks float64 `json:"remaining_weeks"`
+	// BlockedWeeks is the effort left on requirements waiting on dependencies.
+	BlockedWeeks float64 `json:"blocked_weeks"`
+	Incomplete   int     `json:"incomplete"`
+	Blocked      int     `json:"blocked"`
+	Unestimated  int     `json:"unestimated"`
+}
+
+// TotalWeeks is the effort left including blocked requirements.
+func (e effortRollup) TotalWeeks() float64 {
+	return e.RemainingWeeks + e.BlockedWeeks
+}
+
+// computeEffort sums effort_weeks over incomplete requirements, counting
+// PARTIAL requirements at partialFraction of their estimate.
+func computeEffort(db *database.Database, partialFraction float64) effortRollup {
+	var e effortRollup
+	for _, req := range db.All() {
+		if !req.IsIncomplete() {
+			continue
+		}
+		e.Incomplete++
+
+		effort := req.EffortWeeks
+		if req.Status == database.StatusPartial {
+			effort *= partialFraction
+		}
+		if req.EffortWeeks == 0 {
+			e.Unestimated++
+		}
+
+		if req.IsBlocked(db) {
+			e.Blocked++
+			e.BlockedWeeks += effort
+		} else {
+			e.RemainingWeeks += effort
+		}
+	}
+	return e
+}
+
+// completionForecast is a naive completion date at a constant velocity.
+type completionForecast struct {
+	Velocity       float64 `json:"velocity"`
+	SprintWeeks    float64 `json:"sprint_weeks"`
+	Sprints        int     `json:"sprints"`
+	CompletionDate string  `json:"completion_date"`
+}
+
+// forecastCompletion estimates how many sprints the remaining effort needs
+// at velocity effort weeks per sprint, and the date the last one ends.
+func forecastCompletion(remainingWeeks, velocity, sprintWeeks float64, now time.Time) completionForecast {
+	f := completionForecast{Velocity: velocity, SprintWeeks: sprintWeeks}
+	if velocity > 0 {
+		f.Sprints = int(math.Ceil(remainingWeeks / velocity))
+	}
+	days := int(math.Round(float64(f.Sprints) * sprintWeeks * 7))
+	f.CompletionDate = now.AddDate(0, 0, days).Format("2006-01-02")
+	return f
+}
+
+// statusForecast returns the completion forecast for all remaining effort,
+// or nil when no velocity was given.
+func statusForecast(effort effortRollup, cfg *config.Config) *completionForecast {
+	if statusVelocity <= 0 {
+		return nil
+	}
+	f := forecastCompletion(effort.TotalWeeks(), statusVelocity, cfg.RTMX.Forecast.SprintWeeks, statusNow())
+	return &f
+}
+
+func displayRemainingWork(cmd *cobra.Command, db *database.Database, cfg *config.Config, width int) {
+	effort := computeEffort(db, cfg.RTMX.Forecast.PartialFraction)
+	if effort.Incomplete == 0 {
+		return
+	}
+
+	cmd.Println(output.Header("Remaining Work", width))
+	cmd.Println()
+	cmd.Printf("Remaining effort: %s weeks (%d requirements)\n",
+		output.Decimal(effort.RemainingWeeks, 1), effort.Incomplete-effort.Blocked)
+	if effort.Blocked > 0 {
+		cmd.Printf("Blocked:          %s weeks (%d requirements)\n", output.Decimal(effort.BlockedWeeks, 1), effort.Blocked)
+	}
+	if effort.Unestimated > 0 {
+		cmd.Printf("%s %d incomplete requirement(s) have no effort estimate\n",
+			output.Color("⚠", output.Yellow), effort.Unestimated)
+	}
+
+	if f := statusForecast(effort, cfg); f != nil {
+		cmd.Printf("Forecast:         %d sprint(s) at %s weeks/sprint → %s\n",
+			f.Sprints, output.Decimal(f.Velocity, 1), displayDate(f.CompletionDate))
+	}
+	cmd.Println()
+}
+
+// statusReport is the JSON shape of status --format json.
+type statusReport struct {
+	Total      int                 `json:"total"`
+	Complete   int                 `json:"complete"`
+	Partial    int                 `json:"partial"`
+	Missing    int                 `json:"missing"`
+	Completion float64             `json:"completion"`
+	Effort     effortRollup        `json:"effort"`
+	Forecast   *completionForecast `json:"forecast,omitempty"`
+	Releases   []releaseRollup     `json:"releases,omitempty"`
+	Archived   *archivedRollup     `json:"archived,omitempty"`
+}
+
+// archivedRollup describes the requirements in archived categories left
+// out of status and backlog, with the completion over all requirements.
+type archivedRollup struct {
+	Categories []string `json:"categories"`
+	Hidden     int      `json:"hidden"`
+	Total      int      `json:"total"`
+	Completion float64  `json:"completion"`
+}
+
+// withoutArchived returns db without the requirements in archived
+// categories. The rollup is nil when none were left out.
+func withoutArchived(db *database.Database, cfg *config.Config) (*database.Database, *archivedRollup) {
+	scoped := database.NewDatabase()
+	categories := make(map[string]bool)
+	for _, req := range db.All() {
+		if cfg.CategoryArchived(req.Category) {
+			categories[req.Category] = true
+			continue
+		}
+		_ = scoped.Add(req)
+	}
+	if len(categories) == 0 {
+		return db, nil
+	}
+
+	rollup := &archivedRollup{
+		Hidden:     db.Len() - scoped.Len(),
+		Total:      db.Len(),
+		Completion: db.CompletionPercentage(),
+	}
+	for category := range categories {
+		rollup.Categories = append(rollup.Categories, category)
+	}
+	sort.Strings(rollup.Categories)
+	return scoped, rollup
+}
+
+func displayStatusJSON(cmd *cobra.Command, db *database.Database, cfg *config.Config, archived *archivedRollup) error {
+	counts := db.StatusCounts()
+	effort := computeEffort(db, cfg.RTMX.Forecast.PartialFraction)
+
+	report := statusReport{
+		Total:      db.Len(),
+		Complete:   counts[database.StatusComplete],
+		Partial:    counts[database.StatusPartial],
+		Missing:    counts[database.StatusMissing] + counts[database.StatusNotStarted],
+		Completion: db.CompletionPercentage(),
+		Effort:     effort,
+		Forecast:   statusForecast(effort, cfg),
+		Archived:   archived,
+	}
+	if statusByRelease {
+		report.Releases = computeReleaseRollups(db, cfg.RTMX.Forecast.PartialFraction)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal status: %w", err)
+	}
+	cmd.Println(string(data))
+	return nil
+}
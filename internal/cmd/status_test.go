@@ -2,11 +2,14 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/rtmx-ai/rtmx-go/internal/database"
 	"github.com/spf13/cobra"
 )
 
@@ -211,3 +214,231 @@ func createStatusTestCmd() *cobra.Command {
 
 	return root
 }
+
+func effortTestDB() *database.Database {
+	db := database.NewDatabase()
+	for _, r := range []struct {
+		id     string
+		status database.Status
+		effort float64
+		dep    string
+	}{
+		{"REQ-EF-001", database.StatusComplete, 3, ""},
+		{"REQ-EF-002", database.StatusPartial, 2, ""},
+		{"REQ-EF-003", database.StatusMissing, 4, ""},
+		{"REQ-EF-004", database.StatusMissing, 1.5, "REQ-EF-003"}, // blocked
+		{"REQ-EF-005", database.StatusNotStarted, 0, ""},          // unestimated
+	} {
+		req := database.NewRequirement(r.id)
+		req.Category = "EFFORT"
+		req.RequirementText = r.id
+		req.Status = r.status
+		req.EffortWeeks = r.effort
+		if r.dep != "" {
+			req.Dependencies.Add(r.dep)
+		}
+		_ = db.Add(req)
+	}
+	return db
+}
+
+func TestComputeEffort(t *testing.T) {
+	e := computeEffort(effortTestDB(), 0.5)
+
+	// PARTIAL 2 weeks at 0.5 + MISSING 4 weeks; the blocked 1.5 is separate
+	if e.RemainingWeeks != 5 {
+		t.Errorf("RemainingWeeks = %v, want 5", e.RemainingWeeks)
+	}
+	if e.BlockedWeeks != 1.5 {
+		t.Errorf("BlockedWeeks = %v, want 1.5", e.BlockedWeeks)
+	}
+	if e.TotalWeeks() != 6.5 {
+		t.Errorf("TotalWeeks() = %v, want 6.5", e.TotalWeeks())
+	}
+	if e.Incomplete != 4 || e.Blocked != 1 || e.Unestimated != 1 {
+		t.Errorf("counts = %d incomplete, %d blocked, %d unestimated; want 4, 1, 1",
+			e.Incomplete, e.Blocked, e.Unestimated)
+	}
+
+	if full := computeEffort(effortTestDB(), 1); full.RemainingWeeks != 6 {
+		t.Errorf("RemainingWeeks with partial fraction 1 = %v, want 6", full.RemainingWeeks)
+	}
+}
+
+func TestForecastCompletion(t *testing.T) {
+	now := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		remaining   float64
+		velocity    float64
+		sprintWeeks float64
+		wantSprints int
+		wantDate    string
+	}{
+		{"exact", 6, 3, 2, 2, "2026-03-30"},
+		{"rounds up", 6.5, 3, 2, 3, "2026-04-13"},
+		{"one week sprints", 2, 1, 1, 2, "2026-03-16"},
+		{"nothing left", 0, 3, 2, 0, "2026-03-02"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := forecastCompletion(tt.remaining, tt.velocity, tt.sprintWeeks, now)
+			if f.Sprints != tt.wantSprints {
+				t.Errorf("Sprints = %d, want %d", f.Sprints, tt.wantSprints)
+			}
+			if f.CompletionDate != tt.wantDate {
+				t.Errorf("CompletionDate = %s, want %s", f.CompletionDate, tt.wantDate)
+			}
+		})
+	}
+}
+
+func TestStatusForecastOutput(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if err := effortTestDB().Save(filepath.Join(tmpDir, ".rtmx", "database.csv")); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	origVelocity, origFormat, origVerbosity, origNow := statusVelocity, statusFormat, statusVerbosity, statusNow
+	defer func() {
+		statusVelocity, statusFormat, statusVerbosity, statusNow = origVelocity, origFormat, origVerbosity, origNow
+	}()
+	statusVelocity = 3
+	statusVerbosity = 0
+	statusNow = func() time.Time { return time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC) }
+
+	t.Run("terminal", func(t *testing.T) {
+		statusFormat = "terminal"
+		var buf bytes.Buffer
+		statusCmd.SetOut(&buf)
+		defer statusCmd.SetOut(nil)
+
+		if err := runStatus(statusCmd, nil); err != nil {
+			t.Fatalf("runStatus failed: %v", err)
+		}
+		out := buf.String()
+		for _, want := range []string{
+			"Remaining Work",
+			"Remaining effort: 5.0 weeks (3 requirements)",
+			"Blocked:          1.5 weeks (1 requirements)",
+			"3 sprint(s) at 3.0 weeks/sprint → 2026-04-13",
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("output missing %q:\n%s", want, out)
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		statusFormat = "json"
+		var buf bytes.Buffer
+		statusCmd.SetOut(&buf)
+		defer statusCmd.SetOut(nil)
+
+		if err := runStatus(statusCmd, nil); err != nil {
+			t.Fatalf("runStatus failed: %v", err)
+		}
+		var report statusReport
+		if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+			t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+		}
+		if report.Total != 5 || report.Effort.RemainingWeeks != 5 || report.Effort.BlockedWeeks != 1.5 {
+			t.Errorf("unexpected report: %+v", report)
+		}
+		if report.Forecast == nil || report.Forecast.Sprints != 3 || report.Forecast.CompletionDate != "2026-04-13" {
+			t.Errorf("unexpected forecast: %+v", report.Forecast)
+		}
+	})
+}
+
+func releaseTestDB() *database.Database {
+	db := database.NewDatabase()
+	for _, r := range []struct {
+		id, release string
+		status      database.Status
+		effort      float64
+	}{
+		{"REQ-REL-001", "v1.0", database.StatusComplete, 1},
+		{"REQ-REL-002", "v1.0", database.StatusComplete, 1},
+		{"REQ-REL-003", "v1.1", database.StatusPartial, 2},
+		{"REQ-REL-004", "v1.1", database.StatusMissing, 3},
+		{"REQ-REL-005", "", database.StatusMissing, 1},
+	} {
+		req := database.NewRequirement(r.id)
+		req.Category = "REL"
+		req.Release = r.release
+		req.Status = r.status
+		req.EffortWeeks = r.effort
+		_ = db.Add(req)
+	}
+	return db
+}
+
+func TestComputeReleaseRollups(t *testing.T) {
+	rollups := computeReleaseRollups(releaseTestDB(), 0.5)
+
+	want := []releaseRollup{
+		{Release: "v1.0", Total: 2, Complete: 2, Completion: 100},
+		{Release: "v1.1", Total: 2, Partial: 1, Missing: 1, Completion: 25, RemainingWeeks: 4},
+		{Release: "", Total: 1, Missing: 1, Completion: 0, RemainingWeeks: 1},
+	}
+	if len(rollups) != len(want) {
+		t.Fatalf("got %d rollups, want %d: %+v", len(rollups), len(want), rollups)
+	}
+	for i := range want {
+		if rollups[i] != want[i] {
+			t.Errorf("rollup %d = %+v, want %+v", i, rollups[i], want[i])
+		}
+	}
+}
+
+func TestStatusByReleaseOutput(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if err := releaseTestDB().Save(filepath.Join(tmpDir, ".rtmx", "database.csv")); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	origByRelease, origFormat, origVelocity := statusByRelease, statusFormat, statusVelocity
+	defer func() { statusByRelease, statusFormat, statusVelocity = origByRelease, origFormat, origVelocity }()
+	statusByRelease, statusFormat, statusVelocity = true, "terminal", 0
+
+	var buf bytes.Buffer
+	statusCmd.SetOut(&buf)
+	defer statusCmd.SetOut(nil)
+
+	if err := runStatus(statusCmd, nil); err != nil {
+		t.Fatalf("runStatus failed: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"v1.0              100.0%   2 complete   0 partial   0 missing   0.0 weeks left",
+		"v1.1               25.0%   0 complete   1 partial   1 missing   4.0 weeks left",
+		"(no release)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -14,13 +18,23 @@ import (
 )
 
 var (
-	syncService      string
-	syncImport       bool
-	syncExport       bool
-	syncBidirect     bool
-	syncDryRun       bool
-	syncPreferLocal  bool
-	syncPreferRemote bool
+	syncService       string
+	syncImport        bool
+	syncExport        bool
+	syncBidirect      bool
+	syncDryRun        bool
+	syncPreferLocal   bool
+	syncPreferRemote  bool
+	syncBridge        string
+	syncAll           bool
+	syncDebug         bool
+	syncIncludePRs    bool
+	syncScaffold      bool
+	syncConflictsOnly bool
+	syncFormat        string
+
+	// syncProgress shows fetch progress on stderr; nil disables it.
+	syncProgress *output.Progress
 )
 
 // SyncResult holds the results of a sync operation
@@ -30,6 +44,9 @@ type SyncResult struct {
 	Skipped   []string
 	Conflicts []SyncConflict
 	Errors    []SyncError
+
+	// Watched lists the status changes to watched requirements.
+	Watched []watchedChange
 }
 
 // SyncConflict represents a conflict during sync
@@ -68,12 +85,29 @@ func (r *SyncResult) Summary() string {
 	return strings.Join(parts, ", ")
 }
 
+// PlannedChanges lists the writes a dry run found, one per line.
+func (r *SyncResult) PlannedChanges() []string {
+	var lines []string
+	for _, id := range r.Created {
+		lines = append(lines, "create "+id)
+	}
+	for _, id := range r.Updated {
+		lines = append(lines, "update "+id)
+	}
+	return lines
+}
+
 var syncCmd = &cobra.Command{
 	Use:   "sync",
 	Short: "Synchronize RTM with external services",
-	Long: `Synchronize requirements with GitHub Issues or Jira tickets.
+	Long: `Synchronize requirements with GitHub Issues, Jira tickets or Bitbucket issues.
 
 Supports bidirectional sync with conflict resolution strategies.
+Planned changes are previewed and must be confirmed before they are
+applied; pass --yes to skip the prompt in scripts.
+
+Sync refuses to run while two requirements link to the same issue in a
+service being synced; rtmx validate lists them.
 
 Examples:
   # Import issues from GitHub
@@ -82,45 +116,85 @@ Examples:
   # Export requirements to Jira
   rtmx sync --service jira --export
 
+  # Import issues from a Bitbucket repository
+  rtmx sync --service bitbucket --import
+
   # Bidirectional sync with local preference
   rtmx sync --service github --bidirectional --prefer-local
 
   # Preview changes without writing
-  rtmx sync --service github --import --dry-run`,
+  rtmx sync --service github --import --dry-run
+
+  # Keep GitHub and Jira in step, using the RTM as the bridge
+  rtmx sync --bridge github,jira --prefer-remote
+
+  # Import from every enabled adapter
+  rtmx sync --all --import
+
+  # Import GitHub pull requests too, which are skipped by default
+  rtmx sync --service github --import --include-prs
+
+  # Keep linked issues' descriptions in missing requirement spec files
+  rtmx sync --service github --import --scaffold-specs
+
+  # Log each HTTP request; tokens and Authorization headers show as ***
+  rtmx sync --service jira --import --debug
+
+  # Fail CI if a bidirectional sync would hit conflicts; writes nothing
+  rtmx sync --service github --preview-conflicts-only --format json`,
 	RunE: runSync,
 }
 
 func init() {
-	syncCmd.Flags().StringVarP(&syncService, "service", "s", "github", "service to sync with (github, jira)")
+	syncCmd.Flags().StringVarP(&syncService, "service", "s", "github", "service to sync with (github, jira, bitbucket)")
 	syncCmd.Flags().BoolVarP(&syncImport, "import", "i", false, "pull from service into RTM")
 	syncCmd.Flags().BoolVarP(&syncExport, "export", "e", false, "push RTM to service")
 	syncCmd.Flags().BoolVarP(&syncBidirect, "bidirectional", "b", false, "two-way sync")
 	syncCmd.Flags().BoolVar(&syncDryRun, "dry-run", false, "preview changes without writing")
 	syncCmd.Flags().BoolVar(&syncPreferLocal, "prefer-local", false, "RTM wins on conflicts")
 	syncCmd.Flags().BoolVar(&syncPreferRemote, "prefer-remote", false, "service wins on conflicts")
+	syncCmd.Flags().StringVar(&syncBridge, "bridge", "", "comma-separated services to keep in step through the RTM (e.g. github,jira)")
+	syncCmd.Flags().BoolVar(&syncAll, "all", false, "sync every enabled adapter")
+	syncCmd.Flags().BoolVar(&syncDebug, "debug", false, "log HTTP requests to stderr (secrets redacted)")
+	syncCmd.Flags().BoolVar(&syncIncludePRs, "include-prs", false, "import GitHub pull requests as well as issues")
+	syncCmd.Flags().BoolVar(&syncScaffold, "scaffold-specs", false, "on import, write linked items' bodies to requirement spec files that do not exist yet")
+	syncCmd.Flags().BoolVar(&syncConflictsOnly, "preview-conflicts-only", false, "dry-run a bidirectional sync and exit 1 if it finds conflicts")
+	syncCmd.Flags().StringVar(&syncFormat, "format", "terminal", "output format for --preview-conflicts-only: terminal, json")
 
 	rootCmd.AddCommand(syncCmd)
 }
 
 func runSync(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+	syncProgress = output.NewStderrProgress()
+
+	if syncConflictsOnly {
+		if syncAll || syncBridge != "" {
+			return NewValidationError("--preview-conflicts-only cannot be combined with --all or --bridge")
+		}
+		return runSyncConflictPreview(cmd)
+	}
+
+	if syncBridge != "" {
+		if syncAll {
+			return NewValidationError("--all cannot be combined with --bridge")
+		}
+		return runSyncBridge()
+	}
+
 	// Validate flags
 	if !syncImport && !syncExport && !syncBidirect {
 		fmt.Printf("%sNo sync direction specified. Use --import, --export, or --bidirectional%s\n",
 			output.Yellow, output.Reset)
-		return NewExitError(1, "no sync direction specified")
+		return NewValidationError("no sync direction specified")
 	}
 
 	if syncPreferLocal && syncPreferRemote {
 		fmt.Printf("%sCannot use both --prefer-local and --prefer-remote%s\n",
 			output.Red, output.Reset)
-		return NewExitError(1, "conflicting preferences")
-	}
-
-	// Header
-	fmt.Printf("=== RTMX Sync: %s ===\n\n", strings.ToUpper(syncService))
-
-	if syncDryRun {
-		fmt.Printf("%sDRY RUN - no changes will be made%s\n\n", output.Yellow, output.Reset)
+		return NewValidationError("conflicting preferences")
 	}
 
 	// Determine mode
@@ -139,6 +213,17 @@ func runSync(cmd *cobra.Command, args []string) error {
 		conflictRes = "prefer-remote"
 	}
 
+	if syncAll {
+		return runSyncAll(mode, conflictRes)
+	}
+
+	// Header
+	fmt.Printf("=== RTMX Sync: %s ===\n\n", strings.ToUpper(syncService))
+
+	if syncDryRun {
+		fmt.Printf("%sDRY RUN - no changes will be made%s\n\n", output.Yellow, output.Reset)
+	}
+
 	fmt.Printf("Mode: %s\n", mode)
 	fmt.Printf("Conflict resolution: %s\n\n", conflictRes)
 
@@ -149,11 +234,15 @@ func runSync(cmd *cobra.Command, args []string) error {
 		cfg = config.DefaultConfig()
 	}
 
+	if err := checkDuplicateLinks(cfg, []string{syncService}); err != nil {
+		return err
+	}
+
 	// Get adapter
-	adapter, err := getAdapter(syncService, cfg)
+	adapter, err := getAdapter(syncService, cfg, syncPageProgress(syncService))
 	if err != nil {
 		fmt.Printf("%s✗%s %v\n", output.Red, output.Reset, err)
-		return NewExitError(1, err.Error())
+		return NewTypedError(ErrorTypeConfig, "", err)
 	}
 
 	// Test connection
@@ -161,44 +250,393 @@ func runSync(cmd *cobra.Command, args []string) error {
 	success, message := adapter.TestConnection()
 	if !success {
 		fmt.Printf("  %s✗%s %s\n", output.Red, output.Reset, message)
-		return NewExitError(1, "connection failed")
+		return NewTypedError(ErrorTypeNetwork, "connection failed", nil, message)
 	}
 	fmt.Printf("  %s✓%s %s\n\n", output.Green, output.Reset, message)
 
+	run := func(dryRun bool) *SyncResult {
+		return runSyncMode(adapter, cfg, mode, conflictRes, dryRun)
+	}
+
+	// Preview and confirm before writing, then apply what was previewed
+	if !syncDryRun && !assumeYes {
+		defer reuseFetches()()
+		plan := run(true)
+		fmt.Println()
+		if planned := plan.PlannedChanges(); len(planned) > 0 && !confirmChanges(os.Stdout, planned) {
+			return errAborted
+		}
+	}
+
 	// Run sync
-	var result *SyncResult
+	result := run(syncDryRun)
+
+	// Print summary
+	printSyncSummary(result)
+	notifySyncWatched(cfg, result)
+
+	if len(result.Errors) > 0 {
+		return NewExitError(1, "sync completed with errors")
+	}
+
+	return nil
+}
+
+// runSyncMode runs one sync direction against an adapter.
+func runSyncMode(adapter adapters.ServiceAdapter, cfg *config.Config, mode, conflictRes string, dryRun bool) *SyncResult {
 	switch mode {
 	case "import":
-		result = runImport(adapter, cfg, syncDryRun)
+		return runImport(adapter, cfg, dryRun)
 	case "export":
-		result = runExport(adapter, cfg, syncDryRun)
+		return runExport(adapter, cfg, dryRun)
 	default:
-		result = runBidirectional(adapter, cfg, conflictRes, syncDryRun)
+		return runBidirectional(os.Stdout, adapter, cfg, conflictRes, dryRun)
+	}
+}
+
+// serviceSync is one service's part of sync --all. Err is set when the
+// service could not be synced at all.
+type serviceSync struct {
+	Service string
+	Adapter adapters.ServiceAdapter
+	Result  *SyncResult
+	Err     error
+}
+
+// runSyncAll syncs every enabled adapter in turn. A service that fails
+// is reported and the others still run.
+func runSyncAll(mode, conflictRes string) error {
+	cfg, err := config.LoadFromDir(".")
+	if err != nil {
+		fmt.Printf("%sWarning: Could not load config, using defaults%s\n", output.Yellow, output.Reset)
+		cfg = config.DefaultConfig()
+	}
+
+	names := enabledServices(cfg)
+	if len(names) == 0 {
+		fmt.Printf("%sNo adapters enabled in rtmx.yaml%s\n", output.Yellow, output.Reset)
+		return NewTypedError(ErrorTypeConfig, "no adapters enabled", nil)
+	}
+	if err := checkDuplicateLinks(cfg, names); err != nil {
+		return err
+	}
+
+	fmt.Printf("=== RTMX Sync: %s ===\n\n", strings.ToUpper(strings.Join(names, ", ")))
+	if syncDryRun {
+		fmt.Printf("%sDRY RUN - no changes will be made%s\n\n", output.Yellow, output.Reset)
+	}
+	fmt.Printf("Mode: %s\n", mode)
+	fmt.Printf("Conflict resolution: %s\n\n", conflictRes)
+
+	fmt.Printf("%sTesting connections...%s\n", output.Bold, output.Reset)
+	var syncs []*serviceSync
+	for _, name := range names {
+		s := &serviceSync{Service: name}
+		s.Adapter, s.Err = getAdapter(name, cfg, syncPageProgress(name))
+		if s.Err == nil {
+			if ok, message := s.Adapter.TestConnection(); !ok {
+				s.Err = fmt.Errorf("connection failed: %s", message)
+			} else {
+				fmt.Printf("  %s✓%s %s\n", output.Green, output.Reset, message)
+			}
+		}
+		if s.Err != nil {
+			fmt.Printf("  %s✗%s %s: %v\n", output.Red, output.Reset, name, s.Err)
+		}
+		syncs = append(syncs, s)
+	}
+	fmt.Println()
+
+	run := func(dryRun bool) func(adapters.ServiceAdapter) *SyncResult {
+		return func(adapter adapters.ServiceAdapter) *SyncResult {
+			return runSyncMode(adapter, cfg, mode, conflictRes, dryRun)
+		}
+	}
+
+	// Preview every service and confirm once before writing, then apply
+	// what was previewed
+	if !syncDryRun && !assumeYes {
+		defer reuseFetches()()
+		var planned []string
+		for _, s := range runServiceSyncs(syncs, run(true)) {
+			if s.Result == nil {
+				continue
+			}
+			for _, line := range s.Result.PlannedChanges() {
+				planned = append(planned, s.Service+": "+line)
+			}
+		}
+		fmt.Println()
+		if len(planned) > 0 && !confirmChanges(os.Stdout, planned) {
+			return errAborted
+		}
+	}
+
+	total := printSyncAllSummary(runServiceSyncs(syncs, run(syncDryRun)))
+	notifySyncWatched(cfg, total)
+	if len(total.Errors) > 0 {
+		return NewExitError(1, "sync completed with errors")
+	}
+	return nil
+}
+
+// enabledServices lists the adapters enabled in cfg.
+func enabledServices(cfg *config.Config) []string {
+	var names []string
+	if cfg.RTMX.Adapters.GitHub.Enabled {
+		names = append(names, "github")
+	}
+	if cfg.RTMX.Adapters.Jira.Enabled {
+		names = append(names, "jira")
+	}
+	if cfg.RTMX.Adapters.Bitbucket.Enabled {
+		names = append(names, "bitbucket")
+	}
+	return names
+}
+
+// runServiceSyncs runs each connected service, returning per-service copies
+// so a preview pass leaves syncs untouched.
+func runServiceSyncs(syncs []*serviceSync, run func(adapters.ServiceAdapter) *SyncResult) []serviceSync {
+	results := make([]serviceSync, len(syncs))
+	for i, s := range syncs {
+		results[i] = *s
+		if s.Err == nil && s.Adapter != nil {
+			results[i].Result = run(s.Adapter)
+		}
+	}
+	return results
+}
+
+// aggregateSyncResults merges per-service results. Errors are prefixed
+// with their service, and a service that could not run counts as an error.
+func aggregateSyncResults(syncs []serviceSync) *SyncResult {
+	total := &SyncResult{}
+	for _, s := range syncs {
+		if s.Err != nil {
+			total.Errors = append(total.Errors, SyncError{ID: s.Service, Error: s.Err.Error()})
+			continue
+		}
+		if s.Result == nil {
+			continue
+		}
+		total.Created = append(total.Created, s.Result.Created...)
+		total.Updated = append(total.Updated, s.Result.Updated...)
+		total.Skipped = append(total.Skipped, s.Result.Skipped...)
+		total.Watched = append(total.Watched, s.Result.Watched...)
+		for _, c := range s.Result.Conflicts {
+			total.Conflicts = append(total.Conflicts, SyncConflict{ID: s.Service + ":" + c.ID, Reason: c.Reason})
+		}
+		for _, e := range s.Result.Errors {
+			id := s.Service
+			if e.ID != "" {
+				id += ":" + e.ID
+			}
+			total.Errors = append(total.Errors, SyncError{ID: id, Error: e.Error})
+		}
+	}
+	return total
+}
+
+// printSyncAllSummary prints each service's result and the aggregate,
+// returning the aggregate.
+func printSyncAllSummary(syncs []serviceSync) *SyncResult {
+	fmt.Printf("\n%sPer-service results:%s\n", output.Bold, output.Reset)
+	for _, s := range syncs {
+		switch {
+		case s.Err != nil:
+			fmt.Printf("  %s✗%s %s: %v\n", output.Red, output.Reset, s.Service, s.Err)
+		case s.Result != nil:
+			fmt.Printf("  %s: %s\n", s.Service, s.Result.Summary())
+		}
+	}
+
+	total := aggregateSyncResults(syncs)
+	printSyncSummary(total)
+	return total
+}
+
+// runSyncBridge runs a cross-service sync for the services named by --bridge.
+func runSyncBridge() error {
+	if syncPreferLocal && syncPreferRemote {
+		fmt.Printf("%sCannot use both --prefer-local and --prefer-remote%s\n",
+			output.Red, output.Reset)
+		return NewValidationError("conflicting preferences")
+	}
+
+	names, err := parseBridgeServices(syncBridge)
+	if err != nil {
+		return NewTypedError(ErrorTypeValidation, "", err)
+	}
+
+	fmt.Printf("=== RTMX Sync: %s ===\n\n", strings.ToUpper(strings.Join(names, " ⇄ ")))
+
+	if syncDryRun {
+		fmt.Printf("%sDRY RUN - no changes will be made%s\n\n", output.Yellow, output.Reset)
+	}
+
+	conflictRes := "ask"
+	if syncPreferLocal {
+		conflictRes = "prefer-local"
+	} else if syncPreferRemote {
+		conflictRes = "prefer-remote"
+	}
+	fmt.Printf("Conflict resolution: %s\n\n", conflictRes)
+
+	cfg, err := config.LoadFromDir(".")
+	if err != nil {
+		fmt.Printf("%sWarning: Could not load config, using defaults%s\n", output.Yellow, output.Reset)
+		cfg = config.DefaultConfig()
+	}
+
+	if err := checkDuplicateLinks(cfg, names); err != nil {
+		return err
+	}
+
+	var services []adapters.ServiceAdapter
+	for _, name := range names {
+		adapter, err := getAdapter(name, cfg, syncPageProgress(name))
+		if err != nil {
+			fmt.Printf("%s✗%s %v\n", output.Red, output.Reset, err)
+			return NewTypedError(ErrorTypeConfig, "", err)
+		}
+		success, message := adapter.TestConnection()
+		if !success {
+			fmt.Printf("  %s✗%s %s: %s\n", output.Red, output.Reset, name, message)
+			return NewTypedError(ErrorTypeNetwork, "connection failed", nil, message)
+		}
+		fmt.Printf("  %s✓%s %s\n", output.Green, output.Reset, message)
+		services = append(services, adapter)
+	}
+	fmt.Println()
+
+	dbPath := cfg.RTMX.Database
+	if dbPath == "" {
+		dbPath = ".rtmx/database.csv"
+	}
+	db, err := database.Load(dbPath)
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	// Preview and confirm before writing, then apply what was previewed
+	if !syncDryRun && !assumeYes {
+		defer reuseFetches()()
+		plan := runBridge(services, db, conflictRes, true)
+		fmt.Println()
+		if planned := plan.PlannedChanges(); len(planned) > 0 && !confirmChanges(os.Stdout, planned) {
+			return errAborted
+		}
+	}
+
+	result := runBridge(services, db, conflictRes, syncDryRun)
+
+	if !syncDryRun {
+		if err := db.Save(dbPath); err != nil {
+			return databaseSaveError(err)
+		}
 	}
 
-	// Print summary
 	printSyncSummary(result)
 
 	if len(result.Errors) > 0 {
 		return NewExitError(1, "sync completed with errors")
 	}
-
 	return nil
 }
 
-func getAdapter(service string, cfg *config.Config) (adapters.ServiceAdapter, error) {
+// checkDuplicateLinks refuses to sync services while several requirements
+// link to the same item in one of them, since each would overwrite the
+// item in turn. Legacy unprefixed IDs count for every service. A database
+// that does not load is left for the sync itself to report.
+func checkDuplicateLinks(cfg *config.Config, services []string) error {
+	dbPath := cfg.RTMX.Database
+	if dbPath == "" {
+		dbPath = ".rtmx/database.csv"
+	}
+	db, err := database.Load(dbPath)
+	if err != nil {
+		return nil
+	}
+
+	var details []string
+	for _, d := range db.DuplicateExternalIDs() {
+		for _, service := range services {
+			if d.Service == "" || d.Service == service {
+				details = append(details, fmt.Sprintf("%s is linked by %s", d, strings.Join(d.ReqIDs, ", ")))
+				break
+			}
+		}
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	for _, detail := range details {
+		fmt.Printf("  %s✗%s %s\n", output.Red, output.Reset, detail)
+	}
+	fmt.Println()
+	return NewValidationError("several requirements link to the same external item; fix the external_id values first (see rtmx validate)", details...)
+}
+
+// syncPageProgress reports per-page fetch progress for a service.
+func syncPageProgress(service string) adapters.AdapterOption {
+	return adapters.WithPageCallback(func(page, fetched int) {
+		syncProgress.Updatef("Fetching from %s: page %d, %d items", service, page, fetched)
+	})
+}
+
+// syncFetched, while set, holds the items already fetched from each service
+// so a confirmed sync applies the changes its preview showed.
+var syncFetched map[adapters.ServiceAdapter][]adapters.ExternalItem
+
+// reuseFetches makes fetchItems fetch from each service only once until the
+// returned func is called.
+func reuseFetches() func() {
+	syncFetched = make(map[adapters.ServiceAdapter][]adapters.ExternalItem)
+	return func() { syncFetched = nil }
+}
+
+// fetchItems fetches all items from a service while showing progress.
+func fetchItems(adapter adapters.ServiceAdapter) ([]adapters.ExternalItem, error) {
+	if items, ok := syncFetched[adapter]; ok {
+		return append([]adapters.ExternalItem(nil), items...), nil
+	}
+	syncProgress.Updatef("Fetching from %s...", adapter.Name())
+	defer syncProgress.Stop()
+	items, err := adapter.FetchItems(nil)
+	if err == nil && syncFetched != nil {
+		syncFetched[adapter] = append([]adapters.ExternalItem(nil), items...)
+	}
+	return items, err
+}
+
+func getAdapter(service string, cfg *config.Config, opts ...adapters.AdapterOption) (adapters.ServiceAdapter, error) {
+	opts = append(opts, adapters.WithRedaction(cfg.RTMX.Adapters.Redact), adapters.WithIDPrefix(cfg.IDPrefix()))
+	if syncDebug {
+		opts = append(opts, adapters.WithDebugLog(os.Stderr))
+	}
+	if syncIncludePRs {
+		opts = append(opts, adapters.WithPullRequests(true))
+	}
+
 	switch service {
 	case "github":
 		if !cfg.RTMX.Adapters.GitHub.Enabled {
 			return nil, fmt.Errorf("GitHub adapter not enabled in rtmx.yaml")
 		}
-		return adapters.NewGitHubAdapter(&cfg.RTMX.Adapters.GitHub)
+		return adapters.NewGitHubAdapter(&cfg.RTMX.Adapters.GitHub, opts...)
 
 	case "jira":
 		if !cfg.RTMX.Adapters.Jira.Enabled {
 			return nil, fmt.Errorf("Jira adapter not enabled in rtmx.yaml")
 		}
-		return adapters.NewJiraAdapter(&cfg.RTMX.Adapters.Jira)
+		return adapters.NewJiraAdapter(&cfg.RTMX.Adapters.Jira, opts...)
+
+	case "bitbucket":
+		if !cfg.RTMX.Adapters.Bitbucket.Enabled {
+			return nil, fmt.Errorf("Bitbucket adapter not enabled in rtmx.yaml")
+		}
+		return adapters.NewBitbucketAdapter(&cfg.RTMX.Adapters.Bitbucket, opts...)
 
 	default:
 		return nil, fmt.Errorf("unknown service: %s", service)
@@ -219,20 +657,24 @@ func runImport(adapter adapters.ServiceAdapter, cfg *config.Config, dryRun bool)
 	requirements := make(map[string]*database.Requirement)
 	externalIDMap := make(map[string]string) // external_id -> req_id
 
+	var db *database.Database
 	if _, err := os.Stat(dbPath); err == nil {
-		db, err := database.Load(dbPath)
+		loaded, err := database.Load(dbPath)
 		if err == nil {
+			db = loaded
 			for _, req := range db.All() {
 				requirements[req.ReqID] = req
-				if req.ExternalID != "" {
-					externalIDMap[req.ExternalID] = req.ReqID
+				if id := adapters.ExternalIDFor(req, adapter.Name()); id != "" {
+					externalIDMap[id] = req.ReqID
 				}
 			}
 		}
 	}
+	changed := false
+	watched, _ := loadWatchList(".")
 
 	// Fetch external items
-	items, err := adapter.FetchItems(nil)
+	items, err := fetchItems(adapter)
 	if err != nil {
 		result.Errors = append(result.Errors, SyncError{ID: "", Error: err.Error()})
 		return result
@@ -244,13 +686,47 @@ func runImport(adapter adapters.ServiceAdapter, cfg *config.Config, dryRun bool)
 			req := requirements[reqID]
 
 			// Update status from external
-			newStatus := adapter.MapStatusToRTMX(item.Status)
+			updated := false
+			newStatus := importedStatus(adapter, item)
 			if newStatus != req.Status {
+				label := result.watchStatusChange(watched, reqID, req.Status, newStatus)
 				if dryRun {
-					fmt.Printf("  Would update %s status: %s → %s\n", reqID, req.Status, newStatus)
+					fmt.Printf("  Would update %s status: %s → %s\n", label, req.Status, newStatus)
 				} else {
-					fmt.Printf("  %s↻%s %s: %s → %s\n", output.Blue, output.Reset, reqID, req.Status, newStatus)
+					fmt.Printf("  %s↻%s %s: %s → %s\n", output.Blue, output.Reset, label, req.Status, newStatus)
+					req.Status = newStatus
+					changed = true
 				}
+				updated = true
+			}
+			if importPriority(item, req, dryRun) {
+				changed = changed || !dryRun
+				updated = true
+			}
+			if importAssignee(adapter, item, req, dryRun) {
+				changed = changed || !dryRun
+				updated = true
+			}
+			if importRelease(item, req, dryRun) {
+				changed = changed || !dryRun
+				updated = true
+			}
+			if !dryRun && importURL(adapter.Name(), item.URL, req) {
+				changed = true
+			}
+			if importBlocked(adapter.Name(), item, req, dryRun) {
+				changed = changed || !dryRun
+				updated = true
+			}
+			if children := importSubtasks(item, requirements, req, dryRun); len(children) > 0 {
+				changed = changed || !dryRun
+				result.Updated = append(result.Updated, children...)
+			}
+			if syncScaffold && importSpec(cfg, item, req, dryRun, result) {
+				changed = changed || !dryRun
+				updated = true
+			}
+			if updated {
 				result.Updated = append(result.Updated, reqID)
 			} else {
 				result.Skipped = append(result.Skipped, item.ExternalID)
@@ -258,13 +734,24 @@ func runImport(adapter adapters.ServiceAdapter, cfg *config.Config, dryRun bool)
 
 		} else if item.RequirementID != "" {
 			// Item references a requirement we have
-			if _, ok := requirements[item.RequirementID]; ok {
+			if req, ok := requirements[item.RequirementID]; ok {
 				if dryRun {
 					fmt.Printf("  Would link %s to %s\n", item.RequirementID, item.ExternalID)
 				} else {
 					fmt.Printf("  %s⇄%s Linked %s ↔ %s\n", output.Green, output.Reset, item.RequirementID, item.ExternalID)
+					adapters.LinkExternalID(req, adapter.Name(), item.ExternalID)
+					importURL(adapter.Name(), item.URL, req)
+					changed = true
+				}
+				importPriority(item, req, dryRun)
+				importAssignee(adapter, item, req, dryRun)
+				importRelease(item, req, dryRun)
+				importBlocked(adapter.Name(), item, req, dryRun)
+				if syncScaffold {
+					importSpec(cfg, item, req, dryRun, result)
 				}
 				result.Updated = append(result.Updated, item.RequirementID)
+				result.Updated = append(result.Updated, importSubtasks(item, requirements, req, dryRun)...)
 			}
 		} else {
 			// New item - import candidate
@@ -283,9 +770,280 @@ func runImport(adapter adapters.ServiceAdapter, cfg *config.Config, dryRun bool)
 
 	fmt.Printf("\nFound %d items in %s\n", len(items), adapter.Name())
 
+	if changed && db != nil {
+		saveSyncDatabase(db, dbPath, result)
+	}
+
 	return result
 }
 
+// importedStatus returns the RTM status an item imports as: the status its
+// labels map to when that is a known status, otherwise the one its state
+// maps to.
+func importedStatus(adapter adapters.ServiceAdapter, item adapters.ExternalItem) database.Status {
+	if item.LabelStatus != "" {
+		if status, err := database.ParseStatus(item.LabelStatus); err == nil {
+			return status
+		}
+	}
+	return adapter.MapStatusToRTMX(item.Status)
+}
+
+// importPriority copies an item's priority onto req, reporting whether it
+// differs. Items without a priority, or with one that is not an RTM
+// priority, leave the local one alone.
+func importPriority(item adapters.ExternalItem, req *database.Requirement, dryRun bool) bool {
+	if item.Priority == "" {
+		return false
+	}
+	priority, err := database.ParsePriority(item.Priority)
+	if err != nil || priority == req.Priority {
+		return false
+	}
+	if dryRun {
+		fmt.Printf("  Would update %s priority: %s → %s\n", req.ReqID, req.Priority, priority)
+	} else {
+		fmt.Printf("  %s↻%s %s priority: %s → %s\n", output.Blue, output.Reset, req.ReqID, req.Priority, priority)
+		req.Priority = priority
+	}
+	return true
+}
+
+// importAssignee copies an item's assignee onto req through the adapter's
+// assignee mapping, reporting whether the assignee differs. Items without
+// an assignee leave the local one alone.
+func importAssignee(adapter adapters.ServiceAdapter, item adapters.ExternalItem, req *database.Requirement, dryRun bool) bool {
+	mapper, ok := adapter.(adapters.AssigneeMapper)
+	if !ok || item.Assignee == "" {
+		return false
+	}
+	assignee := mapper.MapAssigneeToRTMX(item)
+	if assignee == "" || assignee == req.Assignee {
+		return false
+	}
+	if dryRun {
+		fmt.Printf("  Would update %s assignee: %s → %s\n", req.ReqID, displayOptional(req.Assignee), assignee)
+		return true
+	}
+	fmt.Printf("  %s↻%s %s assignee: %s → %s\n", output.Blue, output.Reset, req.ReqID, displayOptional(req.Assignee), assignee)
+	req.Assignee = assignee
+	return true
+}
+
+// importSpec writes item's body, without its RTMX marker, to req's spec
+// file when that file does not exist yet, assigning requirement_file from
+// the configured template when it is empty. Items without a body and
+// existing spec files are left alone. It reports whether a spec was written.
+func importSpec(cfg *config.Config, item adapters.ExternalItem, req *database.Requirement, dryRun bool, result *SyncResult) bool {
+	body := adapters.StripMarker(item.Description, cfg.IDPrefix())
+	if body == "" {
+		return false
+	}
+	file := req.RequirementFile
+	if file == "" {
+		var err error
+		if file, err = cfg.RequirementFile(req.Category, req.ReqID, req.Phase); err != nil {
+			result.Errors = append(result.Errors, SyncError{ID: req.ReqID, Error: err.Error()})
+			return false
+		}
+	}
+	if _, err := os.Stat(filepath.FromSlash(file)); err == nil {
+		return false
+	}
+
+	if dryRun {
+		fmt.Printf("  Would write %s spec: %s\n", req.ReqID, file)
+		return true
+	}
+	if err := os.MkdirAll(filepath.Dir(filepath.FromSlash(file)), 0755); err != nil {
+		result.Errors = append(result.Errors, SyncError{ID: req.ReqID, Error: fmt.Sprintf("failed to create %s: %v", filepath.Dir(file), err)})
+		return false
+	}
+	if err := os.WriteFile(filepath.FromSlash(file), []byte(importedSpecContent(req, item, body)), 0644); err != nil {
+		result.Errors = append(result.Errors, SyncError{ID: req.ReqID, Error: fmt.Sprintf("failed to write %s: %v", file, err)})
+		return false
+	}
+	fmt.Printf("  %s+%s %s spec: %s\n", output.Green, output.Reset, req.ReqID, file)
+	req.RequirementFile = file
+	return true
+}
+
+// importedSpecContent renders a spec file holding an imported item's body.
+func importedSpecContent(req *database.Requirement, item adapters.ExternalItem, body string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s: %s\n\n", req.ReqID, req.RequirementText))
+	sb.WriteString("## Description\n")
+	sb.WriteString(body + "\n")
+	if item.URL != "" {
+		sb.WriteString(fmt.Sprintf("\nImported from %s\n", item.URL))
+	}
+	return sb.String()
+}
+
+// importRelease copies an item's milestone or fix version onto req,
+// reporting whether the release differs. Items without one leave the local
+// release alone.
+func importRelease(item adapters.ExternalItem, req *database.Requirement, dryRun bool) bool {
+	if item.Release == "" || item.Release == req.Release {
+		return false
+	}
+	if dryRun {
+		fmt.Printf("  Would update %s release: %s → %s\n", req.ReqID, displayOptional(req.Release), item.Release)
+		return true
+	}
+	fmt.Printf("  %s↻%s %s release: %s → %s\n", output.Blue, output.Reset, req.ReqID, displayOptional(req.Release), item.Release)
+	req.Release = item.Release
+	return true
+}
+
+// importURL records url as the web address of req's item in service,
+// reporting whether it changed. An empty url leaves the address alone.
+func importURL(service, url string, req *database.Requirement) bool {
+	if url == "" || url == req.ExternalURLFor(service) {
+		return false
+	}
+	req.SetExternalURLFor(service, url)
+	return true
+}
+
+// itemURL returns the web address of an item the adapter can link to, or
+// "".
+func itemURL(adapter adapters.ServiceAdapter, externalID string) string {
+	if linker, ok := adapter.(adapters.ItemLinker); ok {
+		return linker.ItemURL(externalID)
+	}
+	return ""
+}
+
+// importBlocked records on req that its item is blocked or flagged, as
+// "<service>:<id> <reason>", and clears a block this item recorded once
+// the item is no longer blocked. It reports whether the flag differs.
+func importBlocked(service string, item adapters.ExternalItem, req *database.Requirement, dryRun bool) bool {
+	source := service + ":" + item.ExternalID
+	blocked := ""
+	if item.Blocked != "" {
+		blocked = source + " " + item.Blocked
+	}
+	if blocked == req.BlockedExternal {
+		return false
+	}
+	if blocked == "" && !strings.HasPrefix(req.BlockedExternal, source+" ") {
+		// Blocked by something else, which this item cannot clear
+		return false
+	}
+	if dryRun {
+		fmt.Printf("  Would update %s blocked externally: %s → %s\n", req.ReqID, displayOptional(req.BlockedExternal), displayOptional(blocked))
+		return true
+	}
+	fmt.Printf("  %s↻%s %s blocked externally: %s → %s\n", output.Blue, output.Reset, req.ReqID, displayOptional(req.BlockedExternal), displayOptional(blocked))
+	req.BlockedExternal = blocked
+	return true
+}
+
+// importSubtasks updates the statuses of req's children from the checked
+// state of the item's task list and returns the children it changed. A
+// checked box completes a child; unchecking a COMPLETE child reopens it.
+func importSubtasks(item adapters.ExternalItem, requirements map[string]*database.Requirement, req *database.Requirement, dryRun bool) []string {
+	ids := make([]string, 0, len(item.Subtasks))
+	for id := range item.Subtasks {
+		ids = append(ids, id)
+	}
+	database.SortReqIDs(ids)
+
+	var updated []string
+	for _, id := range ids {
+		child := requirements[id]
+		if child == nil || database.ParentID(id) != req.ReqID {
+			continue
+		}
+		status := child.Status
+		if item.Subtasks[id] {
+			status = database.StatusComplete
+		} else if child.IsComplete() {
+			status = database.StatusMissing
+		}
+		if status == child.Status {
+			continue
+		}
+		if dryRun {
+			fmt.Printf("  Would update sub-task %s status: %s → %s\n", id, child.Status, status)
+		} else {
+			fmt.Printf("  %s↻%s sub-task %s: %s → %s\n", output.Blue, output.Reset, id, child.Status, status)
+			child.Status = status
+			if status == database.StatusComplete {
+				child.SetCompletedDate()
+			}
+		}
+		updated = append(updated, id)
+	}
+	return updated
+}
+
+// useSubtasks lets an adapter that supports task lists render children of
+// db's requirements in their parent's item. It reports whether the adapter
+// does. The children are indexed once rather than looked up per item.
+func useSubtasks(adapter adapters.ServiceAdapter, db *database.Database) bool {
+	s, ok := adapter.(adapters.SubtaskAdapter)
+	if !ok || db == nil {
+		return false
+	}
+	children := make(map[string][]*database.Requirement)
+	for _, req := range db.All() {
+		if parent := database.ParentID(req.ReqID); parent != "" {
+			children[parent] = append(children[parent], req)
+		}
+	}
+	s.SetSubtasks(func(reqID string) []*database.Requirement {
+		return children[reqID]
+	})
+	return true
+}
+
+// isSubtask reports whether req is the child of another requirement in db.
+func isSubtask(db *database.Database, req *database.Requirement) bool {
+	parent := database.ParentID(req.ReqID)
+	return parent != "" && db.Exists(parent)
+}
+
+// displayOptional shows an empty field as "(none)".
+func displayOptional(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
+
+// watchStatusChange records a status change to a watched requirement and
+// returns the requirement's label for output.
+func (r *SyncResult) watchStatusChange(watched watchList, reqID string, from, to database.Status) string {
+	if !watched[reqID] {
+		return reqID
+	}
+	r.Watched = append(r.Watched, watchedChange{ReqID: reqID, From: from.String(), To: to.String()})
+	return watchedLabel(reqID)
+}
+
+// notifySyncWatched sends the watched status changes of a sync that wrote
+// them to the watch webhook.
+func notifySyncWatched(cfg *config.Config, result *SyncResult) {
+	if syncDryRun {
+		return
+	}
+	if err := notifyWatchedChanges(cfg, "sync", result.Watched); err != nil {
+		fmt.Printf("%sWarning: %v%s\n", output.Yellow, err, output.Reset)
+	}
+}
+
+// saveSyncDatabase persists links and status changes made during a sync,
+// recording a failure in the result.
+func saveSyncDatabase(db *database.Database, dbPath string, result *SyncResult) {
+	// Sync changes statuses in place, so drop the cached counts
+	db.MarkDirty()
+	if err := db.Save(dbPath); err != nil {
+		result.Errors = append(result.Errors, SyncError{ID: "", Error: fmt.Sprintf("failed to save database: %v", err)})
+	}
+}
+
 func runExport(adapter adapters.ServiceAdapter, cfg *config.Config, dryRun bool) *SyncResult {
 	result := &SyncResult{}
 
@@ -309,21 +1067,34 @@ func runExport(adapter adapters.ServiceAdapter, cfg *config.Config, dryRun bool)
 		return result
 	}
 
+	subtasks := useSubtasks(adapter, db)
+	changed := false
 	for _, req := range db.All() {
-		if req.ExternalID != "" {
+		if externalID := adapters.ExternalIDFor(req, adapter.Name()); externalID != "" {
 			// Already exported - update
 			if dryRun {
-				fmt.Printf("  Would update: %s → %s\n", req.ReqID, req.ExternalID)
+				fmt.Printf("  Would update: %s → %s\n", req.ReqID, externalID)
 			} else {
-				success := adapter.UpdateItem(req.ExternalID, req)
+				success := adapter.UpdateItem(externalID, req)
 				if success {
-					fmt.Printf("  %s↻%s Updated %s → %s\n", output.Blue, output.Reset, req.ReqID, req.ExternalID)
+					fmt.Printf("  %s↻%s Updated %s → %s\n", output.Blue, output.Reset, req.ReqID, externalID)
+					if req.ExternalIDFor(adapter.Name()) == "" {
+						// Migrate a legacy unprefixed link
+						adapters.LinkExternalID(req, adapter.Name(), externalID)
+						changed = true
+					}
+					if importURL(adapter.Name(), itemURL(adapter, externalID), req) {
+						changed = true
+					}
 					result.Updated = append(result.Updated, req.ReqID)
 				} else {
 					fmt.Printf("  %s✗%s Failed to update %s\n", output.Red, output.Reset, req.ReqID)
 					result.Errors = append(result.Errors, SyncError{ID: req.ReqID, Error: "update failed"})
 				}
 			}
+		} else if subtasks && isSubtask(db, req) {
+			// Listed in the parent's task list
+			result.Skipped = append(result.Skipped, req.ReqID)
 		} else {
 			// New export
 			if dryRun {
@@ -335,19 +1106,26 @@ func runExport(adapter adapters.ServiceAdapter, cfg *config.Config, dryRun bool)
 					result.Errors = append(result.Errors, SyncError{ID: req.ReqID, Error: err.Error()})
 				} else {
 					fmt.Printf("  %s+%s Exported %s → %s\n", output.Green, output.Reset, req.ReqID, externalID)
+					adapters.LinkExternalID(req, adapter.Name(), externalID)
+					importURL(adapter.Name(), itemURL(adapter, externalID), req)
+					changed = true
 					result.Created = append(result.Created, req.ReqID)
 				}
 			}
 		}
 	}
 
+	if changed {
+		saveSyncDatabase(db, dbPath, result)
+	}
+
 	return result
 }
 
-func runBidirectional(adapter adapters.ServiceAdapter, cfg *config.Config, conflictRes string, dryRun bool) *SyncResult {
+func runBidirectional(out io.Writer, adapter adapters.ServiceAdapter, cfg *config.Config, conflictRes string, dryRun bool) *SyncResult {
 	result := &SyncResult{}
 
-	fmt.Printf("%sRunning bidirectional sync with %s...%s\n", output.Bold, adapter.Name(), output.Reset)
+	fmt.Fprintf(out, "%sRunning bidirectional sync with %s...%s\n", output.Bold, adapter.Name(), output.Reset)
 
 	// Load RTM
 	dbPath := cfg.RTMX.Database
@@ -358,21 +1136,26 @@ func runBidirectional(adapter adapters.ServiceAdapter, cfg *config.Config, confl
 	requirements := make(map[string]*database.Requirement)
 	externalIDMap := make(map[string]string)
 
+	var db *database.Database
 	if _, err := os.Stat(dbPath); err == nil {
-		db, err := database.Load(dbPath)
+		loaded, err := database.Load(dbPath)
 		if err == nil {
+			db = loaded
 			for _, req := range db.All() {
 				requirements[req.ReqID] = req
-				if req.ExternalID != "" {
-					externalIDMap[req.ExternalID] = req.ReqID
+				if id := adapters.ExternalIDFor(req, adapter.Name()); id != "" {
+					externalIDMap[id] = req.ReqID
 				}
 			}
 		}
 	}
+	subtasks := useSubtasks(adapter, db)
+	changed := false
+	watched, _ := loadWatchList(".")
 
 	// Fetch external items
-	fmt.Printf("\n%sFetching external items...%s\n", output.Dim, output.Reset)
-	items, err := adapter.FetchItems(nil)
+	fmt.Fprintf(out, "\n%sFetching external items...%s\n", output.Dim, output.Reset)
+	items, err := fetchItems(adapter)
 	if err != nil {
 		result.Errors = append(result.Errors, SyncError{ID: "", Error: err.Error()})
 		return result
@@ -383,37 +1166,43 @@ func runBidirectional(adapter adapters.ServiceAdapter, cfg *config.Config, confl
 		externalItems[item.ExternalID] = item
 	}
 
-	fmt.Printf("Found %d external items\n", len(externalItems))
-	fmt.Printf("Have %d local requirements\n\n", len(requirements))
+	fmt.Fprintf(out, "Found %d external items\n", len(externalItems))
+	fmt.Fprintf(out, "Have %d local requirements\n\n", len(requirements))
 
 	// Process linked items
 	for externalID, reqID := range externalIDMap {
 		if item, ok := externalItems[externalID]; ok {
 			req := requirements[reqID]
+			if !dryRun && importURL(adapter.Name(), item.URL, req) {
+				changed = true
+			}
 
 			// Check for status conflict
-			externalStatus := adapter.MapStatusToRTMX(item.Status)
+			externalStatus := importedStatus(adapter, item)
 			if externalStatus != req.Status {
 				switch conflictRes {
 				case "prefer-local":
 					if dryRun {
-						fmt.Printf("  Would update %s: %s → %s\n", externalID, item.Status, req.Status)
+						fmt.Fprintf(out, "  Would update %s: %s → %s\n", externalID, item.Status, req.Status)
 					} else {
 						adapter.UpdateItem(externalID, req)
-						fmt.Printf("  %s↻%s %s: Local wins (%s)\n", output.Blue, output.Reset, reqID, req.Status)
+						fmt.Fprintf(out, "  %s↻%s %s: Local wins (%s)\n", output.Blue, output.Reset, reqID, req.Status)
 					}
 					result.Updated = append(result.Updated, reqID)
 
 				case "prefer-remote":
+					label := result.watchStatusChange(watched, reqID, req.Status, externalStatus)
 					if dryRun {
-						fmt.Printf("  Would update %s: %s → %s\n", reqID, req.Status, externalStatus)
+						fmt.Fprintf(out, "  Would update %s: %s → %s\n", label, req.Status, externalStatus)
 					} else {
-						fmt.Printf("  %s↻%s %s: Remote wins (%s)\n", output.Blue, output.Reset, reqID, externalStatus)
+						fmt.Fprintf(out, "  %s↻%s %s: Remote wins (%s)\n", output.Blue, output.Reset, label, externalStatus)
+						req.Status = externalStatus
+						changed = true
 					}
 					result.Updated = append(result.Updated, reqID)
 
 				default:
-					fmt.Printf("  %s?%s Conflict: %s (local=%s, remote=%s)\n",
+					fmt.Fprintf(out, "  %s?%s Conflict: %s (local=%s, remote=%s)\n",
 						output.Yellow, output.Reset, reqID, req.Status, externalStatus)
 					result.Conflicts = append(result.Conflicts, SyncConflict{
 						ID:     reqID,
@@ -435,9 +1224,9 @@ func runBidirectional(adapter adapters.ServiceAdapter, cfg *config.Config, confl
 			title = title[:50] + "..."
 		}
 		if dryRun {
-			fmt.Printf("  Would import: [%s] %s\n", externalID, title)
+			fmt.Fprintf(out, "  Would import: [%s] %s\n", externalID, title)
 		} else {
-			fmt.Printf("  %s←%s Import candidate: [%s] %s\n", output.Green, output.Reset, externalID, title)
+			fmt.Fprintf(out, "  %s←%s Import candidate: [%s] %s\n", output.Green, output.Reset, externalID, title)
 		}
 		result.Created = append(result.Created, externalID)
 	}
@@ -448,19 +1237,116 @@ func runBidirectional(adapter adapters.ServiceAdapter, cfg *config.Config, confl
 		exportedIDs[reqID] = true
 	}
 
-	for reqID := range requirements {
+	for reqID, req := range requirements {
+		if subtasks && isSubtask(db, req) {
+			continue
+		}
 		if !exportedIDs[reqID] {
 			if dryRun {
-				fmt.Printf("  Would export: %s\n", reqID)
+				fmt.Fprintf(out, "  Would export: %s\n", reqID)
 			} else {
-				fmt.Printf("  %s→%s Export candidate: %s\n", output.Green, output.Reset, reqID)
+				fmt.Fprintf(out, "  %s→%s Export candidate: %s\n", output.Green, output.Reset, reqID)
 			}
 		}
 	}
 
+	if changed && db != nil {
+		saveSyncDatabase(db, dbPath, result)
+	}
+
 	return result
 }
 
+// syncConflictReport is the JSON output of sync --preview-conflicts-only.
+type syncConflictReport struct {
+	Service   string             `json:"service"`
+	Conflicts []syncConflictJSON `json:"conflicts"`
+}
+
+type syncConflictJSON struct {
+	ReqID  string `json:"req_id"`
+	Reason string `json:"reason"`
+}
+
+// runSyncConflictPreview runs sync --preview-conflicts-only against the
+// configured service.
+func runSyncConflictPreview(cmd *cobra.Command) error {
+	if syncFormat != "terminal" && syncFormat != "json" {
+		return NewValidationError(fmt.Sprintf("invalid format %q (use terminal or json)", syncFormat))
+	}
+
+	// With JSON the sync progress goes to stderr, so stdout holds only the
+	// report
+	out, progress := cmd.OutOrStdout(), cmd.OutOrStdout()
+	if syncFormat == "json" {
+		progress = cmd.ErrOrStderr()
+	}
+
+	cfg, err := config.LoadFromDir(".")
+	if err != nil {
+		fmt.Fprintf(progress, "%sWarning: Could not load config, using defaults%s\n", output.Yellow, output.Reset)
+		cfg = config.DefaultConfig()
+	}
+	if err := checkDuplicateLinks(cfg, []string{syncService}); err != nil {
+		return err
+	}
+
+	adapter, err := getAdapter(syncService, cfg, syncPageProgress(syncService))
+	if err != nil {
+		return NewTypedError(ErrorTypeConfig, "", err)
+	}
+	if ok, message := adapter.TestConnection(); !ok {
+		return NewTypedError(ErrorTypeNetwork, "connection failed", nil, message)
+	}
+
+	return previewSyncConflicts(out, progress, adapter, cfg)
+}
+
+// previewSyncConflicts dry-runs a bidirectional sync with no conflict
+// preference, so every status conflict is collected rather than
+// resolved, and reports them to out in requirement ID order, with the
+// sync's progress written to progress. It returns an exit code 1 error
+// when there are conflicts.
+func previewSyncConflicts(out, progress io.Writer, adapter adapters.ServiceAdapter, cfg *config.Config) error {
+	result := runBidirectional(progress, adapter, cfg, "ask", true)
+	sort.SliceStable(result.Conflicts, func(i, j int) bool {
+		return database.LessReqID(result.Conflicts[i].ID, result.Conflicts[j].ID)
+	})
+	if len(result.Errors) > 0 {
+		details := make([]string, len(result.Errors))
+		for i, e := range result.Errors {
+			details[i] = e.Error
+		}
+		return NewTypedError(ErrorTypeNetwork, "sync preview failed", nil, details...)
+	}
+
+	if syncFormat == "json" {
+		report := syncConflictReport{Service: adapter.Name(), Conflicts: []syncConflictJSON{}}
+		for _, c := range result.Conflicts {
+			report.Conflicts = append(report.Conflicts, syncConflictJSON{ReqID: c.ID, Reason: c.Reason})
+		}
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal report: %w", err)
+		}
+		fmt.Fprintln(out, string(data))
+		if len(result.Conflicts) > 0 {
+			return NewExitError(1, "")
+		}
+		return nil
+	}
+
+	if len(result.Conflicts) == 0 {
+		fmt.Fprintf(out, "\n%s✓%s No sync conflicts with %s\n", output.Green, output.Reset, adapter.Name())
+		return nil
+	}
+	fmt.Fprintf(out, "\n%sConflicts:%s\n", output.Yellow, output.Reset)
+	for _, c := range result.Conflicts {
+		fmt.Fprintf(out, "  • %s: %s\n", c.ID, c.Reason)
+	}
+	return NewExitError(1, fmt.Sprintf("%d sync conflict(s) with %s", len(result.Conflicts), adapter.Name()))
+}
+
 func printSyncSummary(result *SyncResult) {
 	fmt.Printf("\n%sSync Summary:%s\n", output.Bold, output.Reset)
 	fmt.Printf("  %s\n", result.Summary())
@@ -478,4 +1364,11 @@ func printSyncSummary(result *SyncResult) {
 			fmt.Printf("  • %s: %s\n", e.ID, e.Error)
 		}
 	}
+
+	if len(result.Watched) > 0 {
+		fmt.Printf("\n%sWatched requirements changed:%s\n", output.Magenta, output.Reset)
+		for _, c := range result.Watched {
+			fmt.Printf("  ★ %s: %s → %s\n", c.ReqID, c.From, c.To)
+		}
+	}
 }
@@ -0,0 +1,257 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/adapters"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+)
+
+// bridgeLink is an external item linked to a local requirement.
+type bridgeLink struct {
+	adapter adapters.ServiceAdapter
+	item    adapters.ExternalItem
+}
+
+// parseBridgeServices splits a --bridge value like "github,jira".
+func parseBridgeServices(value string) ([]string, error) {
+	var services []string
+	seen := make(map[string]bool)
+	for _, s := range strings.Split(value, ",") {
+		s = strings.ToLower(strings.TrimSpace(s))
+		if s == "" || seen[s] {
+			continue
+		}
+		seen[s] = true
+		services = append(services, s)
+	}
+	if len(services) < 2 {
+		return nil, fmt.Errorf("--bridge requires at least two services (e.g. github,jira)")
+	}
+	return services, nil
+}
+
+// runBridge synchronizes several services through the RTM as canonical store.
+//
+// Items are fetched from every service and linked to requirements by their
+// per-service external ID, or by the requirement marker in the item body.
+// A status change made in one or more services is applied to the RTM when
+// they agree; when they disagree the conflict strategy decides. Finally the resulting
+// status is pushed to every linked service, and requirements linked in one
+// service but not another are created there so both sides converge.
+func runBridge(services []adapters.ServiceAdapter, db *database.Database, conflictRes string, dryRun bool) *SyncResult {
+	result := &SyncResult{}
+
+	fmt.Printf("%sBridging %s through the RTM...%s\n", output.Bold, bridgeNames(services), output.Reset)
+
+	// Phase 1: fetch and link items from every service. Existing links,
+	// including legacy unprefixed ones, are looked up for every service
+	// before any new link is recorded.
+	type fetched struct {
+		adapter      adapters.ServiceAdapter
+		items        []adapters.ExternalItem
+		byExternalID map[string]*database.Requirement
+	}
+	var fetchedItems []fetched
+	for _, adapter := range services {
+		items, err := fetchItems(adapter)
+		if err != nil {
+			result.Errors = append(result.Errors, SyncError{ID: adapter.Name(), Error: err.Error()})
+			continue
+		}
+
+		byExternalID := make(map[string]*database.Requirement)
+		for _, req := range db.All() {
+			if id := adapters.ExternalIDFor(req, adapter.Name()); id != "" {
+				byExternalID[id] = req
+			}
+		}
+		fetchedItems = append(fetchedItems, fetched{adapter: adapter, items: items, byExternalID: byExternalID})
+	}
+
+	links := make(map[string][]bridgeLink) // req_id -> linked items, in service order
+	for _, f := range fetchedItems {
+		adapter := f.adapter
+		for _, item := range f.items {
+			req := f.byExternalID[item.ExternalID]
+			if req != nil && req.ExternalIDFor(adapter.Name()) == "" && !dryRun {
+				// Migrate a legacy unprefixed link
+				adapters.LinkExternalID(req, adapter.Name(), item.ExternalID)
+			}
+			if req == nil && item.RequirementID != "" {
+				req = db.Get(item.RequirementID)
+				// A legacy link that matched none of this service's items
+				// belongs to another service
+				if req != nil && req.ExternalIDFor(adapter.Name()) == "" {
+					fmt.Printf("  %s⇄%s Linked %s ↔ %s:%s\n", output.Green, output.Reset, req.ReqID, adapter.Name(), item.ExternalID)
+					if !dryRun {
+						adapters.LinkExternalID(req, adapter.Name(), item.ExternalID)
+					}
+				}
+			}
+			if req == nil {
+				result.Skipped = append(result.Skipped, adapter.Name()+":"+item.ExternalID)
+				continue
+			}
+			links[req.ReqID] = append(links[req.ReqID], bridgeLink{adapter: adapter, item: item})
+		}
+	}
+
+	// Phase 2: merge remote statuses into the RTM
+	for _, req := range db.All() {
+		linked := links[req.ReqID]
+		if len(linked) == 0 {
+			continue
+		}
+
+		newStatus, conflict := mergeBridgeStatus(req.Status, linked, conflictRes)
+		if conflict != "" {
+			fmt.Printf("  %s?%s Conflict: %s (%s)\n", output.Yellow, output.Reset, req.ReqID, conflict)
+			result.Conflicts = append(result.Conflicts, SyncConflict{ID: req.ReqID, Reason: conflict})
+			continue
+		}
+		if newStatus != req.Status {
+			if dryRun {
+				fmt.Printf("  Would update %s: %s → %s\n", req.ReqID, req.Status, newStatus)
+			} else {
+				fmt.Printf("  %s↻%s %s: %s → %s\n", output.Blue, output.Reset, req.ReqID, req.Status, newStatus)
+				req.Status = newStatus
+				db.MarkDirty()
+			}
+			result.Updated = append(result.Updated, req.ReqID)
+		}
+	}
+
+	conflicted := make(map[string]bool)
+	for _, c := range result.Conflicts {
+		conflicted[c.ID] = true
+	}
+
+	// Phase 3: push the canonical status back out to every service
+	for _, req := range db.All() {
+		linked := links[req.ReqID]
+		if len(linked) == 0 && !hasAnyExternalID(req, services) {
+			continue
+		}
+		if conflicted[req.ReqID] {
+			continue
+		}
+
+		for _, adapter := range services {
+			name := adapter.Name()
+			externalID := adapters.ExternalIDFor(req, name)
+
+			if externalID == "" {
+				// Linked elsewhere but not here - mirror it
+				if dryRun {
+					fmt.Printf("  Would create %s in %s\n", req.ReqID, name)
+					result.Created = append(result.Created, name+":"+req.ReqID)
+					continue
+				}
+				newID, err := adapter.CreateItem(req)
+				if err != nil {
+					result.Errors = append(result.Errors, SyncError{ID: req.ReqID, Error: fmt.Sprintf("%s: %v", name, err)})
+					continue
+				}
+				adapters.LinkExternalID(req, name, newID)
+				fmt.Printf("  %s+%s Created %s → %s:%s\n", output.Green, output.Reset, req.ReqID, name, newID)
+				result.Created = append(result.Created, name+":"+req.ReqID)
+				continue
+			}
+
+			item, ok := findBridgeItem(linked, name)
+			if ok && !remoteStatusDiffers(adapter, item.Status, req.Status) {
+				continue
+			}
+			if dryRun {
+				fmt.Printf("  Would update %s:%s → %s\n", name, externalID, req.Status)
+				continue
+			}
+			if !adapter.UpdateItem(externalID, req) {
+				result.Errors = append(result.Errors, SyncError{ID: req.ReqID, Error: fmt.Sprintf("%s: update failed", name)})
+				continue
+			}
+			fmt.Printf("  %s→%s %s:%s ← %s\n", output.Blue, output.Reset, name, externalID, req.Status)
+		}
+	}
+
+	return result
+}
+
+// mergeBridgeStatus decides the canonical status from the linked items,
+// taking the RTM status as the baseline each service is compared with.
+// It returns a non-empty conflict description when the strategy is "ask"
+// and the services that changed disagree with each other.
+func mergeBridgeStatus(local database.Status, linked []bridgeLink, conflictRes string) (database.Status, string) {
+	var changed []bridgeLink
+	for _, l := range linked {
+		if remoteStatusDiffers(l.adapter, l.item.Status, local) {
+			changed = append(changed, l)
+		}
+	}
+
+	if len(changed) == 0 {
+		return local, ""
+	}
+
+	// Every service that moved away from the RTM moved to the same status:
+	// that change is unambiguous and propagates.
+	distinct := make(map[database.Status]bool)
+	for _, l := range changed {
+		distinct[l.adapter.MapStatusToRTMX(l.item.Status)] = true
+	}
+	if len(distinct) == 1 {
+		return changed[0].adapter.MapStatusToRTMX(changed[0].item.Status), ""
+	}
+
+	switch conflictRes {
+	case "prefer-local":
+		return local, ""
+	case "prefer-remote":
+		// Services are ordered as given to --bridge; the first changed one wins
+		return changed[0].adapter.MapStatusToRTMX(changed[0].item.Status), ""
+	}
+
+	parts := []string{fmt.Sprintf("rtm=%s", local)}
+	for _, l := range linked {
+		parts = append(parts, fmt.Sprintf("%s=%s", l.adapter.Name(), l.adapter.MapStatusToRTMX(l.item.Status)))
+	}
+	return local, "Status conflict: " + strings.Join(parts, ", ")
+}
+
+// remoteStatusDiffers reports whether a remote status differs from what the
+// RTM status would look like in that service. Comparing through the adapter's
+// mapping avoids false changes when a service cannot represent every RTM
+// status (e.g. GitHub has no PARTIAL).
+func remoteStatusDiffers(adapter adapters.ServiceAdapter, remote string, local database.Status) bool {
+	expected := adapter.MapStatusToRTMX(adapter.MapStatusFromRTMX(local))
+	return adapter.MapStatusToRTMX(remote) != expected
+}
+
+func findBridgeItem(linked []bridgeLink, service string) (adapters.ExternalItem, bool) {
+	for _, l := range linked {
+		if l.adapter.Name() == service {
+			return l.item, true
+		}
+	}
+	return adapters.ExternalItem{}, false
+}
+
+func hasAnyExternalID(req *database.Requirement, services []adapters.ServiceAdapter) bool {
+	for _, adapter := range services {
+		if adapters.ExternalIDFor(req, adapter.Name()) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func bridgeNames(services []adapters.ServiceAdapter) string {
+	names := make([]string, len(services))
+	for i, s := range services {
+		names[i] = s.Name()
+	}
+	return strings.Join(names, " ⇄ ")
+}
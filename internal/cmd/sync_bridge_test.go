@@ -0,0 +1,295 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/adapters"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// mockSyncAdapter is an in-memory ServiceAdapter for sync tests.
+// Statuses use GitHub-style open/closed states.
+type mockSyncAdapter struct {
+	name     string
+	items    map[string]*adapters.ExternalItem
+	order    []string
+	nextID   int
+	fetchErr error
+	updates  int
+	fetches  int
+}
+
+func newMockSyncAdapter(name string) *mockSyncAdapter {
+	return &mockSyncAdapter{name: name, items: make(map[string]*adapters.ExternalItem), nextID: 100}
+}
+
+func (m *mockSyncAdapter) addItem(id, status, reqID string) {
+	m.items[id] = &adapters.ExternalItem{ExternalID: id, Title: id, Status: status, RequirementID: reqID}
+	m.order = append(m.order, id)
+}
+
+func (m *mockSyncAdapter) Name() string                   { return m.name }
+func (m *mockSyncAdapter) IsConfigured() bool             { return true }
+func (m *mockSyncAdapter) TestConnection() (bool, string) { return true, "connected to " + m.name }
+
+func (m *mockSyncAdapter) FetchItems(query map[string]interface{}) ([]adapters.ExternalItem, error) {
+	m.fetches++
+	if m.fetchErr != nil {
+		return nil, m.fetchErr
+	}
+	items := make([]adapters.ExternalItem, 0, len(m.order))
+	for _, id := range m.order {
+		items = append(items, *m.items[id])
+	}
+	return items, nil
+}
+
+func (m *mockSyncAdapter) GetItem(externalID string) (*adapters.ExternalItem, error) {
+	if item, ok := m.items[externalID]; ok {
+		copied := *item
+		return &copied, nil
+	}
+	return nil, fmt.Errorf("item %s not found", externalID)
+}
+
+func (m *mockSyncAdapter) CreateItem(req *database.Requirement) (string, error) {
+	m.nextID++
+	id := fmt.Sprintf("%s-%d", strings.ToUpper(m.name), m.nextID)
+	m.addItem(id, m.MapStatusFromRTMX(req.Status), req.ReqID)
+	return id, nil
+}
+
+func (m *mockSyncAdapter) UpdateItem(externalID string, req *database.Requirement) bool {
+	item, ok := m.items[externalID]
+	if !ok {
+		return false
+	}
+	item.Status = m.MapStatusFromRTMX(req.Status)
+	m.updates++
+	return true
+}
+
+func (m *mockSyncAdapter) MapStatusToRTMX(status string) database.Status {
+	switch status {
+	case "closed":
+		return database.StatusComplete
+	case "in progress":
+		return database.StatusPartial
+	}
+	return database.StatusMissing
+}
+
+func (m *mockSyncAdapter) MapStatusFromRTMX(status database.Status) string {
+	if status == database.StatusComplete {
+		return "closed"
+	}
+	return "open"
+}
+
+func newBridgeTestDB(t *testing.T) *database.Database {
+	t.Helper()
+	db := database.NewDatabase()
+	req := database.NewRequirement("REQ-BRG-001")
+	req.RequirementText = "Bridged requirement"
+	req.SetExternalIDFor("github", "42")
+	req.SetExternalIDFor("jira", "PROJ-7")
+	if err := db.Add(req); err != nil {
+		t.Fatalf("Failed to add requirement: %v", err)
+	}
+	return db
+}
+
+func TestBridgePropagatesStatusChange(t *testing.T) {
+	db := newBridgeTestDB(t)
+
+	github := newMockSyncAdapter("github")
+	github.addItem("42", "closed", "REQ-BRG-001") // closed in GitHub
+	jira := newMockSyncAdapter("jira")
+	jira.addItem("PROJ-7", "open", "REQ-BRG-001") // still open in Jira
+
+	result := runBridge([]adapters.ServiceAdapter{github, jira}, db, "ask", false)
+
+	if len(result.Conflicts) != 0 {
+		t.Fatalf("Expected no conflicts, got %v", result.Conflicts)
+	}
+	if got := db.Get("REQ-BRG-001").Status; got != database.StatusComplete {
+		t.Errorf("RTM status = %v, want COMPLETE", got)
+	}
+	if got := jira.items["PROJ-7"].Status; got != "closed" {
+		t.Errorf("Jira status = %q, want closed (propagated from GitHub)", got)
+	}
+	if github.updates != 0 {
+		t.Errorf("Expected no updates to GitHub, got %d", github.updates)
+	}
+}
+
+func TestBridgeCreatesMissingMirror(t *testing.T) {
+	db := database.NewDatabase()
+	req := database.NewRequirement("REQ-BRG-002")
+	req.SetExternalIDFor("github", "7")
+	_ = db.Add(req)
+
+	github := newMockSyncAdapter("github")
+	github.addItem("7", "open", "REQ-BRG-002")
+	jira := newMockSyncAdapter("jira")
+
+	result := runBridge([]adapters.ServiceAdapter{github, jira}, db, "ask", false)
+
+	jiraID := db.Get("REQ-BRG-002").ExternalIDFor("jira")
+	if jiraID == "" {
+		t.Fatal("Expected requirement to be linked to a new Jira item")
+	}
+	if _, ok := jira.items[jiraID]; !ok {
+		t.Errorf("Jira item %s was not created", jiraID)
+	}
+	if len(result.Created) != 1 {
+		t.Errorf("Expected 1 created, got %v", result.Created)
+	}
+}
+
+func TestBridgeKeepsLegacyLinks(t *testing.T) {
+	// A legacy unprefixed external_id still links the GitHub issue, even
+	// though Jira links its item by marker first
+	db := database.NewDatabase()
+	req := database.NewRequirement("REQ-BRG-003")
+	req.ExternalID = "42"
+	_ = db.Add(req)
+
+	jira := newMockSyncAdapter("jira")
+	jira.addItem("PROJ-9", "open", "REQ-BRG-003")
+	github := newMockSyncAdapter("github")
+	github.addItem("42", "open", "")
+
+	result := runBridge([]adapters.ServiceAdapter{jira, github}, db, "ask", false)
+
+	if len(result.Created) != 0 || len(github.order) != 1 {
+		t.Errorf("Expected no new items, created %v", result.Created)
+	}
+	got := db.Get("REQ-BRG-003")
+	if got.ExternalIDFor("github") != "42" || got.ExternalIDFor("jira") != "PROJ-9" || got.ExternalID != "" {
+		t.Errorf("links = %v (legacy %q), want github:42 migrated and jira:PROJ-9", got.ExternalIDs, got.ExternalID)
+	}
+}
+
+func TestBridgeConflictStrategies(t *testing.T) {
+	tests := []struct {
+		name          string
+		conflictRes   string
+		wantStatus    database.Status
+		wantConflicts int
+	}{
+		{"ask records conflict", "ask", database.StatusMissing, 1},
+		{"prefer-local keeps RTM", "prefer-local", database.StatusMissing, 0},
+		{"prefer-remote takes first service", "prefer-remote", database.StatusComplete, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newBridgeTestDB(t)
+
+			// Both services moved away from the RTM's MISSING, differently
+			github := newMockSyncAdapter("github")
+			github.addItem("42", "closed", "")
+			jira := newMockSyncAdapter("jira")
+			jira.addItem("PROJ-7", "in progress", "")
+
+			result := runBridge([]adapters.ServiceAdapter{github, jira}, db, tt.conflictRes, false)
+
+			if len(result.Conflicts) != tt.wantConflicts {
+				t.Errorf("Conflicts = %d, want %d", len(result.Conflicts), tt.wantConflicts)
+			}
+			if got := db.Get("REQ-BRG-001").Status; got != tt.wantStatus {
+				t.Errorf("RTM status = %v, want %v", got, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestBridgeAgreeingChangesAreNotConflicts(t *testing.T) {
+	// Both services closed the item while the RTM says PARTIAL
+	db := newBridgeTestDB(t)
+	db.Get("REQ-BRG-001").Status = database.StatusPartial
+	github := newMockSyncAdapter("github")
+	github.addItem("42", "closed", "")
+	jira := newMockSyncAdapter("jira")
+	jira.addItem("PROJ-7", "closed", "")
+
+	result := runBridge([]adapters.ServiceAdapter{github, jira}, db, "ask", false)
+	if len(result.Conflicts) != 0 || db.Get("REQ-BRG-001").Status != database.StatusComplete {
+		t.Errorf("conflicts = %v, status = %s; want COMPLETE without a conflict", result.Conflicts, db.Get("REQ-BRG-001").Status)
+	}
+
+	// A change in the only linked service is applied too
+	db = database.NewDatabase()
+	req := database.NewRequirement("REQ-BRG-004")
+	req.SetExternalIDFor("github", "8")
+	_ = db.Add(req)
+	github = newMockSyncAdapter("github")
+	github.addItem("8", "closed", "")
+	jira = newMockSyncAdapter("jira")
+
+	result = runBridge([]adapters.ServiceAdapter{github, jira}, db, "ask", false)
+	if len(result.Conflicts) != 0 || db.Get("REQ-BRG-004").Status != database.StatusComplete {
+		t.Errorf("conflicts = %v, status = %s; want COMPLETE from the only linked service", result.Conflicts, db.Get("REQ-BRG-004").Status)
+	}
+}
+
+func TestBridgeDryRunMakesNoChanges(t *testing.T) {
+	db := newBridgeTestDB(t)
+
+	github := newMockSyncAdapter("github")
+	github.addItem("42", "closed", "")
+	jira := newMockSyncAdapter("jira")
+	jira.addItem("PROJ-7", "open", "")
+
+	result := runBridge([]adapters.ServiceAdapter{github, jira}, db, "ask", true)
+
+	if len(result.Updated) != 1 {
+		t.Errorf("Expected 1 planned update, got %v", result.Updated)
+	}
+	if got := db.Get("REQ-BRG-001").Status; got != database.StatusMissing {
+		t.Errorf("Dry run changed RTM status to %v", got)
+	}
+	if jira.updates != 0 {
+		t.Errorf("Dry run updated Jira %d times", jira.updates)
+	}
+}
+
+func TestBridgeAppliesPreviewedFetch(t *testing.T) {
+	db := newBridgeTestDB(t)
+
+	github := newMockSyncAdapter("github")
+	github.addItem("42", "closed", "REQ-BRG-001")
+	jira := newMockSyncAdapter("jira")
+	jira.addItem("PROJ-7", "open", "REQ-BRG-001")
+
+	defer reuseFetches()()
+	plan := runBridge([]adapters.ServiceAdapter{github, jira}, db, "ask", true)
+	github.items["42"].Status = "open" // changed after the preview was shown
+	result := runBridge([]adapters.ServiceAdapter{github, jira}, db, "ask", false)
+
+	if github.fetches != 1 || jira.fetches != 1 {
+		t.Errorf("Fetches = github %d, jira %d, want 1 each", github.fetches, jira.fetches)
+	}
+	if len(result.Updated) != len(plan.Updated) {
+		t.Errorf("Applied updates %v, previewed %v", result.Updated, plan.Updated)
+	}
+	if got := jira.items["PROJ-7"].Status; got != "closed" {
+		t.Errorf("Jira status = %q, want the previewed closed", got)
+	}
+}
+
+func TestParseBridgeServices(t *testing.T) {
+	got, err := parseBridgeServices("GitHub, jira,github")
+	if err != nil {
+		t.Fatalf("parseBridgeServices failed: %v", err)
+	}
+	if strings.Join(got, ",") != "github,jira" {
+		t.Errorf("parseBridgeServices = %v, want [github jira]", got)
+	}
+	if _, err := parseBridgeServices("github"); err == nil {
+		t.Error("Expected error for a single service")
+	}
+}
@@ -1,8 +1,18 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/adapters"
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
 )
 
 func TestSyncResultSummary(t *testing.T) {
@@ -236,3 +246,617 @@ func TestSyncResultEmpty(t *testing.T) {
 		t.Error("Expected empty Errors slice")
 	}
 }
+
+func TestSyncPersistsPerServiceLinks(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "database.csv")
+
+	db := database.NewDatabase()
+	exported := database.NewRequirement("REQ-SYNC-001")
+	exported.RequirementText = "Exported requirement"
+	exported.SetExternalIDFor("jira", "PROJ-7")
+	_ = db.Add(exported)
+	marked := database.NewRequirement("REQ-SYNC-002")
+	marked.RequirementText = "Requirement referenced by an issue"
+	_ = db.Add(marked)
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Database = dbPath
+
+	// Export creates a GitHub item for each requirement not yet linked to GitHub
+	github := newMockSyncAdapter("github")
+	result := runExport(github, cfg, false)
+	if len(result.Errors) != 0 {
+		t.Fatalf("export errors: %v", result.Errors)
+	}
+
+	reloaded, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reload database: %v", err)
+	}
+	req := reloaded.Get("REQ-SYNC-001")
+	if req.ExternalIDFor("github") == "" {
+		t.Error("Expected GitHub link to be persisted after export")
+	}
+	if req.ExternalIDFor("jira") != "PROJ-7" {
+		t.Errorf("Jira link lost during GitHub export, ExternalIDs = %v", req.ExternalIDs)
+	}
+
+	// Import links a Jira ticket that references a requirement by marker
+	jira := newMockSyncAdapter("jira")
+	jira.addItem("PROJ-9", "open", "REQ-SYNC-002")
+	result = runImport(jira, cfg, false)
+	if len(result.Errors) != 0 {
+		t.Fatalf("import errors: %v", result.Errors)
+	}
+
+	reloaded, err = database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reload database: %v", err)
+	}
+	req = reloaded.Get("REQ-SYNC-002")
+	if req.ExternalIDFor("jira") != "PROJ-9" {
+		t.Errorf("ExternalIDFor(jira) = %q, want PROJ-9", req.ExternalIDFor("jira"))
+	}
+	if req.ExternalIDFor("github") == "" {
+		t.Error("GitHub link lost during Jira import")
+	}
+}
+
+func TestSyncAllContinuesAfterFailure(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "database.csv")
+
+	db := database.NewDatabase()
+	req := database.NewRequirement("REQ-ALL-001")
+	req.RequirementText = "Requirement synced everywhere"
+	_ = db.Add(req)
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Database = dbPath
+
+	// jira fails to fetch; github imports a new item
+	jira := newMockSyncAdapter("jira")
+	jira.fetchErr = errors.New("jira unavailable")
+	github := newMockSyncAdapter("github")
+	github.addItem("7", "open", "")
+
+	syncs := []*serviceSync{
+		{Service: "jira", Adapter: jira},
+		{Service: "github", Adapter: github},
+	}
+
+	var attempted []string
+	results := runServiceSyncs(syncs, func(adapter adapters.ServiceAdapter) *SyncResult {
+		attempted = append(attempted, adapter.Name())
+		return runImport(adapter, cfg, false)
+	})
+
+	if strings.Join(attempted, ",") != "jira,github" {
+		t.Errorf("Expected both connected adapters to be attempted in order, got %v", attempted)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected a result per service, got %d", len(results))
+	}
+	if len(results[0].Result.Errors) != 1 {
+		t.Errorf("Expected jira result to record its failure, got %+v", results[0].Result)
+	}
+	if len(results[1].Result.Created) != 1 {
+		t.Errorf("Expected github to import one item despite the jira failure, got %+v", results[1].Result)
+	}
+	if syncs[0].Result != nil {
+		t.Error("runServiceSyncs should not modify the input syncs")
+	}
+
+	total := aggregateSyncResults(results)
+	if len(total.Created) != 1 {
+		t.Errorf("Aggregate Created = %v", total.Created)
+	}
+	if len(total.Errors) != 1 {
+		t.Fatalf("Aggregate Errors = %v, want the jira failure", total.Errors)
+	}
+	if total.Errors[0].ID != "jira" || !strings.Contains(total.Errors[0].Error, "jira unavailable") {
+		t.Errorf("Errors[0] = %+v", total.Errors[0])
+	}
+	if !strings.Contains(total.Summary(), "1 errors") {
+		t.Errorf("Summary = %q", total.Summary())
+	}
+
+	// A service that could not connect is reported, not skipped
+	total = aggregateSyncResults(append(results, serviceSync{Service: "jira", Err: errors.New("connection failed: timeout")}))
+	if len(total.Errors) != 2 || total.Errors[1].Error != "connection failed: timeout" {
+		t.Errorf("Expected connection failure in aggregate, got %v", total.Errors)
+	}
+}
+
+func TestEnabledServices(t *testing.T) {
+	cfg := config.DefaultConfig()
+	if got := enabledServices(cfg); len(got) != 0 {
+		t.Errorf("Expected no services by default, got %v", got)
+	}
+	cfg.RTMX.Adapters.GitHub.Enabled = true
+	cfg.RTMX.Adapters.Jira.Enabled = true
+	if got := strings.Join(enabledServices(cfg), ","); got != "github,jira" {
+		t.Errorf("enabledServices = %q", got)
+	}
+}
+
+// mockAssigneeAdapter maps service users to RTM assignees.
+type mockAssigneeAdapter struct {
+	*mockSyncAdapter
+	mapping map[string]string // service user -> RTM assignee
+}
+
+func (m *mockAssigneeAdapter) MapAssigneeToRTMX(item adapters.ExternalItem) string {
+	if assignee, ok := m.mapping[item.Assignee]; ok {
+		return assignee
+	}
+	return item.Assignee
+}
+
+func (m *mockAssigneeAdapter) MapAssigneeFromRTMX(assignee string) string {
+	for user, a := range m.mapping {
+		if a == assignee {
+			return user
+		}
+	}
+	return ""
+}
+
+func TestSyncImportMapsAssigneeAndRelease(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "database.csv")
+
+	db := database.NewDatabase()
+	linked := database.NewRequirement("REQ-SYNC-001")
+	linked.SetExternalIDFor("github", "1")
+	_ = db.Add(linked)
+	marked := database.NewRequirement("REQ-SYNC-002")
+	marked.Assignee = "bob"
+	_ = db.Add(marked)
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Database = dbPath
+
+	adapter := &mockAssigneeAdapter{
+		mockSyncAdapter: newMockSyncAdapter("github"),
+		mapping:         map[string]string{"alice-gh": "alice"},
+	}
+	adapter.addItem("1", "open", "")
+	adapter.items["1"].Assignee = "alice-gh"
+	adapter.addItem("2", "open", "REQ-SYNC-002")
+	adapter.items["2"].Assignee = "carol"
+	adapter.items["2"].Release = "v1.1"
+
+	result := runImport(adapter, cfg, false)
+	if len(result.Errors) != 0 {
+		t.Fatalf("import errors: %v", result.Errors)
+	}
+	if len(result.Updated) != 2 {
+		t.Errorf("Updated = %v, want both requirements once", result.Updated)
+	}
+
+	reloaded, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reload database: %v", err)
+	}
+	if got := reloaded.Get("REQ-SYNC-001").Assignee; got != "alice" {
+		t.Errorf("REQ-SYNC-001 assignee = %q, want mapped alice", got)
+	}
+	if got := reloaded.Get("REQ-SYNC-002").Assignee; got != "carol" {
+		t.Errorf("REQ-SYNC-002 assignee = %q, want carol", got)
+	}
+	if got := reloaded.Get("REQ-SYNC-002").Release; got != "v1.1" {
+		t.Errorf("REQ-SYNC-002 release = %q, want v1.1 from the milestone", got)
+	}
+}
+
+// mockSubtaskAdapter keeps children in their parent's item.
+type mockSubtaskAdapter struct {
+	*mockSyncAdapter
+	children func(reqID string) []*database.Requirement
+}
+
+func (m *mockSubtaskAdapter) SetSubtasks(children func(reqID string) []*database.Requirement) {
+	m.children = children
+}
+
+func TestSyncSubtasks(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "database.csv")
+
+	db := database.NewDatabase()
+	parent := database.NewRequirement("REQ-SYNC-010")
+	_ = db.Add(parent)
+	for _, id := range []string{"REQ-SYNC-010.1", "REQ-SYNC-010.2"} {
+		_ = db.Add(database.NewRequirement(id))
+	}
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Database = dbPath
+	adapter := &mockSubtaskAdapter{mockSyncAdapter: newMockSyncAdapter("github")}
+
+	// Export creates only the parent; children go in its task list
+	result := runExport(adapter, cfg, false)
+	if len(result.Errors) != 0 {
+		t.Fatalf("export errors: %v", result.Errors)
+	}
+	if strings.Join(result.Created, ",") != "REQ-SYNC-010" {
+		t.Errorf("Created = %v, want only the parent", result.Created)
+	}
+	if adapter.children == nil || len(adapter.children("REQ-SYNC-010")) != 2 {
+		t.Fatal("Expected the adapter to be given the parent's children")
+	}
+
+	// Checking a box on the parent's issue completes that child
+	item := adapter.items[adapter.order[0]]
+	item.Subtasks = map[string]bool{"REQ-SYNC-010.1": true, "REQ-SYNC-010.2": false, "REQ-OTHER-001.1": true}
+	result = runImport(adapter, cfg, false)
+	if len(result.Errors) != 0 {
+		t.Fatalf("import errors: %v", result.Errors)
+	}
+	if strings.Join(result.Updated, ",") != "REQ-SYNC-010.1" {
+		t.Errorf("Updated = %v, want [REQ-SYNC-010.1]", result.Updated)
+	}
+
+	reloaded, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reload database: %v", err)
+	}
+	if got := reloaded.Get("REQ-SYNC-010.1").Status; got != database.StatusComplete {
+		t.Errorf("checked child status = %s, want COMPLETE", got)
+	}
+	if reloaded.Get("REQ-SYNC-010.1").CompletedDate == "" {
+		t.Error("Expected the completed child to get a completed_date")
+	}
+	if got := reloaded.Get("REQ-SYNC-010.2").Status; got != database.StatusMissing {
+		t.Errorf("unchecked child status = %s, want MISSING", got)
+	}
+}
+
+func TestSyncImportBlockedExternally(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "database.csv")
+
+	db := database.NewDatabase()
+	req := database.NewRequirement("REQ-SYNC-020")
+	req.SetExternalIDFor("github", "1")
+	_ = db.Add(req)
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Database = dbPath
+	adapter := newMockSyncAdapter("github")
+	adapter.addItem("1", "open", "")
+	adapter.items["1"].Blocked = "labeled blocked"
+
+	result := runImport(adapter, cfg, false)
+	if len(result.Errors) != 0 {
+		t.Fatalf("import errors: %v", result.Errors)
+	}
+	reloaded, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reload database: %v", err)
+	}
+	if got := reloaded.Get("REQ-SYNC-020").BlockedExternal; got != "github:1 labeled blocked" {
+		t.Errorf("BlockedExternal = %q, want the flagged issue", got)
+	}
+
+	// Unblocking the issue clears the flag
+	adapter.items["1"].Blocked = ""
+	if result := runImport(adapter, cfg, false); len(result.Errors) != 0 {
+		t.Fatalf("import errors: %v", result.Errors)
+	}
+	reloaded, err = database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reload database: %v", err)
+	}
+	if got := reloaded.Get("REQ-SYNC-020").BlockedExternal; got != "" {
+		t.Errorf("BlockedExternal = %q, want cleared", got)
+	}
+}
+
+func TestSyncImportScaffoldsSpecFromBody(t *testing.T) {
+	tmpDir := setupTestProject(t)
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+
+	db := database.NewDatabase()
+	req := database.NewRequirement("REQ-SYNC-030")
+	req.Category = "SYNC"
+	req.RequirementText = "Import issue descriptions"
+	req.SetExternalIDFor("github", "5")
+	_ = db.Add(req)
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	origScaffold := syncScaffold
+	defer func() { syncScaffold = origScaffold }()
+	syncScaffold = true
+
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Database = dbPath
+	adapter := newMockSyncAdapter("github")
+	adapter.addItem("5", "open", "")
+	body := "The importer keeps the whole description.\n\nA second paragraph with the details\nspread over two lines."
+	adapter.items["5"].Description = body + "\n\n---\nRTMX: REQ-SYNC-030"
+
+	if result := runImport(adapter, cfg, false); len(result.Errors) != 0 {
+		t.Fatalf("import errors: %v", result.Errors)
+	}
+
+	reloaded, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reload database: %v", err)
+	}
+	wantFile := ".rtmx/requirements/SYNC/REQ-SYNC-030.md"
+	if got := reloaded.Get("REQ-SYNC-030").RequirementFile; got != wantFile {
+		t.Errorf("RequirementFile = %q, want %q", got, wantFile)
+	}
+	spec, err := os.ReadFile(filepath.Join(tmpDir, filepath.FromSlash(wantFile)))
+	if err != nil {
+		t.Fatalf("Expected a spec file: %v", err)
+	}
+	if !strings.Contains(string(spec), body+"\n") {
+		t.Errorf("Expected the spec to hold the issue body, got:\n%s", spec)
+	}
+	if strings.Contains(string(spec), "RTMX:") || strings.Contains(string(spec), "---") {
+		t.Errorf("Expected the RTMX marker to be stripped, got:\n%s", spec)
+	}
+
+	// An existing spec is never overwritten
+	if err := os.WriteFile(filepath.Join(tmpDir, filepath.FromSlash(wantFile)), []byte("edited\n"), 0644); err != nil {
+		t.Fatalf("Failed to edit spec: %v", err)
+	}
+	if result := runImport(adapter, cfg, false); len(result.Errors) != 0 {
+		t.Fatalf("import errors: %v", result.Errors)
+	}
+	if spec, _ := os.ReadFile(filepath.Join(tmpDir, filepath.FromSlash(wantFile))); string(spec) != "edited\n" {
+		t.Errorf("Expected the edited spec to be kept, got:\n%s", spec)
+	}
+}
+
+func TestSyncPreviewConflictsOnly(t *testing.T) {
+	origFormat := syncFormat
+	defer func() { syncFormat = origFormat }()
+
+	tests := []struct {
+		name        string
+		localStatus database.Status
+		wantExit    int
+		wantCount   int
+	}{
+		{"conflicting states exit non-zero", database.StatusComplete, 1, 1},
+		{"clean state exits zero", database.StatusMissing, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			dbPath := filepath.Join(tmpDir, "database.csv")
+
+			db := database.NewDatabase()
+			req := database.NewRequirement("REQ-SYNC-001")
+			req.RequirementText = "Synced requirement"
+			req.Status = tt.localStatus
+			req.SetExternalIDFor("github", "42")
+			_ = db.Add(req)
+			if err := db.Save(dbPath); err != nil {
+				t.Fatalf("Failed to save database: %v", err)
+			}
+			before, _ := os.ReadFile(dbPath)
+
+			cfg := config.DefaultConfig()
+			cfg.RTMX.Database = dbPath
+
+			github := newMockSyncAdapter("github")
+			github.addItem("42", "open", "REQ-SYNC-001")
+
+			syncFormat = "json"
+			var buf, progress bytes.Buffer
+			err := previewSyncConflicts(&buf, &progress, github, cfg)
+			if got := ExitCode(err); got != tt.wantExit {
+				t.Errorf("exit code = %d, want %d (err: %v)", got, tt.wantExit, err)
+			}
+
+			var report syncConflictReport
+			if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+				t.Fatalf("Failed to parse report: %v\n%s", err, buf.String())
+			}
+			if len(report.Conflicts) != tt.wantCount {
+				t.Errorf("conflicts = %v, want %d", report.Conflicts, tt.wantCount)
+			}
+			if tt.wantCount > 0 && report.Conflicts[0].ReqID != "REQ-SYNC-001" {
+				t.Errorf("conflict req_id = %q, want REQ-SYNC-001", report.Conflicts[0].ReqID)
+			}
+
+			after, _ := os.ReadFile(dbPath)
+			if !bytes.Equal(before, after) || github.updates != 0 {
+				t.Error("Expected the preview to change nothing")
+			}
+		})
+	}
+}
+
+func TestSyncPreviewConflictsSorted(t *testing.T) {
+	origFormat := syncFormat
+	defer func() { syncFormat = origFormat }()
+
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "database.csv")
+	ids := []string{"REQ-SYNC-010", "REQ-SYNC-002", "REQ-SYNC-001", "REQ-AUTH-003", "REQ-SYNC-100"}
+	db := database.NewDatabase()
+	github := newMockSyncAdapter("github")
+	for i, id := range ids {
+		req := database.NewRequirement(id)
+		req.Status = database.StatusComplete
+		req.SetExternalIDFor("github", fmt.Sprint(i+1))
+		_ = db.Add(req)
+		github.addItem(fmt.Sprint(i+1), "open", id)
+	}
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Database = dbPath
+
+	syncFormat = "terminal"
+	var buf, progress bytes.Buffer
+	if err := previewSyncConflicts(&buf, &progress, github, cfg); ExitCode(err) != 1 {
+		t.Fatalf("Expected exit code 1, got %v", err)
+	}
+
+	var got []string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if id, _, ok := strings.Cut(strings.TrimPrefix(line, "  • "), ":"); ok && strings.HasPrefix(line, "  • ") {
+			got = append(got, id)
+		}
+	}
+	want := []string{"REQ-AUTH-003", "REQ-SYNC-001", "REQ-SYNC-002", "REQ-SYNC-010", "REQ-SYNC-100"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("conflicts listed as %v, want %v", got, want)
+	}
+	if !strings.Contains(progress.String(), "Running bidirectional sync") || strings.Contains(buf.String(), "Running bidirectional sync") {
+		t.Errorf("Expected the sync progress on the progress writer only, got:\n%s", buf.String())
+	}
+}
+
+func TestSyncImportLabelStatusAndPriority(t *testing.T) {
+	database.SetCustomStatuses([]string{"DEPRECATED"})
+	defer database.SetCustomStatuses(nil)
+
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "database.csv")
+
+	db := database.NewDatabase()
+	for i, id := range []string{"REQ-SYNC-030", "REQ-SYNC-031"} {
+		req := database.NewRequirement(id)
+		req.SetExternalIDFor("github", fmt.Sprint(i+1))
+		_ = db.Add(req)
+	}
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Database = dbPath
+
+	// The adapter maps labels: a closed wontfix issue and an open p0 one
+	adapter := newMockSyncAdapter("github")
+	adapter.addItem("1", "closed", "")
+	adapter.items["1"].LabelStatus = "DEPRECATED"
+	adapter.addItem("2", "open", "")
+	adapter.items["2"].Priority = "P0"
+
+	if result := runImport(adapter, cfg, false); len(result.Errors) != 0 {
+		t.Fatalf("import errors: %v", result.Errors)
+	}
+	reloaded, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reload database: %v", err)
+	}
+	if got := reloaded.Get("REQ-SYNC-030").Status; got != "DEPRECATED" {
+		t.Errorf("wontfix status = %s, want DEPRECATED rather than COMPLETE", got)
+	}
+	urgent := reloaded.Get("REQ-SYNC-031")
+	if urgent.Priority != database.PriorityP0 || urgent.Status != database.StatusMissing {
+		t.Errorf("p0 issue imported as %s/%s, want P0/MISSING", urgent.Priority, urgent.Status)
+	}
+}
+
+func TestSyncImportExternalURL(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+
+	db := database.NewDatabase()
+	linked := database.NewRequirement("REQ-SYNC-040")
+	linked.SetExternalIDFor("github", "1")
+	_ = db.Add(linked)
+	_ = db.Add(database.NewRequirement("REQ-SYNC-041"))
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Database = dbPath
+
+	// One item is already linked, the other names its requirement
+	adapter := newMockSyncAdapter("github")
+	adapter.addItem("1", "open", "")
+	adapter.items["1"].URL = "https://github.com/acme/app/issues/1"
+	adapter.addItem("2", "open", "REQ-SYNC-041")
+	adapter.items["2"].URL = "https://github.com/acme/app/issues/2"
+
+	if result := runImport(adapter, cfg, false); len(result.Errors) != 0 {
+		t.Fatalf("import errors: %v", result.Errors)
+	}
+	reloaded, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reload database: %v", err)
+	}
+	for id, want := range map[string]string{
+		"REQ-SYNC-040": "https://github.com/acme/app/issues/1",
+		"REQ-SYNC-041": "https://github.com/acme/app/issues/2",
+	} {
+		if got := reloaded.Get(id).ExternalURLFor("github"); got != want {
+			t.Errorf("%s github URL = %q, want %q", id, got, want)
+		}
+	}
+
+	// A second service's URL is kept alongside the first
+	jira := newMockSyncAdapter("jira")
+	jira.addItem("PROJ-7", "open", "REQ-SYNC-041")
+	jira.items["PROJ-7"].URL = "https://acme.atlassian.net/browse/PROJ-7"
+	if result := runImport(jira, cfg, false); len(result.Errors) != 0 {
+		t.Fatalf("jira import errors: %v", result.Errors)
+	}
+	reloaded, err = database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reload database: %v", err)
+	}
+	both := reloaded.Get("REQ-SYNC-041")
+	if both.ExternalURLFor("github") != "https://github.com/acme/app/issues/2" || both.ExternalURLFor("jira") != "https://acme.atlassian.net/browse/PROJ-7" {
+		t.Errorf("external URLs = %v, want both the github and jira items", both.ExternalURLs)
+	}
+
+	var buf bytes.Buffer
+	traceCmd.SetOut(&buf)
+	defer traceCmd.SetOut(nil)
+	if err := runTrace(traceCmd, []string{"REQ-SYNC-041"}); err != nil {
+		t.Fatalf("trace failed: %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "https://github.com/acme/app/issues/2") {
+		t.Errorf("Expected trace to show the external URL:\n%s", out)
+	}
+
+	out, err := executeCommand(createBacklogTestCmd(), "backlog", "--view", "list", "--links")
+	if err != nil {
+		t.Fatalf("backlog failed: %v", err)
+	}
+	if !strings.Contains(out, "LINKS") || !strings.Contains(out, "https://github.com/acme/app/issues/1") {
+		t.Errorf("Expected backlog --links to list the URLs:\n%s", out)
+	}
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/markers"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var traceCmd = &cobra.Command{
+	Use:   "trace REQ-ID",
+	Short: "Show traceability for a requirement",
+	Long: `Show everything a requirement is traced to: its tests, the source
+files marked as implementing it (e.g. "// implements REQ-AUTH-001"), its
+dependencies, external tracker items, and the commits and pull requests
+that implemented it.
+
+Examples:
+    rtmx trace REQ-AUTH-001`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTrace,
+}
+
+func init() {
+	rootCmd.AddCommand(traceCmd)
+}
+
+func runTrace(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	db, err := database.Load(cfg.DatabasePath(cwd))
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	req := db.Get(args[0])
+	if req == nil {
+		return fmt.Errorf("requirement %q not found", args[0])
+	}
+
+	impl, err := implementationMarkers(cwd, cfg)
+	if err != nil {
+		return err
+	}
+
+	defer startPager(cmd)()
+
+	width := 80
+	cmd.Println(output.Header("Traceability: "+req.ReqID, width))
+	cmd.Println()
+	cmd.Printf("%s %s\n", output.StatusIcon(req.Status.String()), req.RequirementText)
+	cmd.Printf("Status: %s  Priority: %s  Phase: %d\n", req.Status, req.Priority, req.Phase)
+	cmd.Println()
+
+	section := func(title string, items []string) {
+		cmd.Println(output.Color(title, output.Bold))
+		if len(items) == 0 {
+			cmd.Printf("  %s\n", output.Color("(none)", output.Dim))
+		}
+		for _, item := range items {
+			cmd.Printf("  %s\n", item)
+		}
+		cmd.Println()
+	}
+
+	var tests []string
+	if req.HasTest() {
+		tests = append(tests, req.TestModule+"::"+req.TestFunction)
+	}
+	section("Tests", tests)
+	section("Implementation", markers.Locations(impl[req.ReqID]))
+	section("Dependencies", req.Dependencies.Slice())
+	section("Blocks", req.Blocks.Slice())
+
+	external := database.ParseStringSet(database.FormatExternalIDs(req.ExternalID, req.ExternalIDs)).Slice()
+	external = append(external, externalURLs(req)...)
+	section("External Items", external)
+
+	section("Commits", req.Commits.Slice())
+	section("Pull Requests", req.PullRequests.Slice())
+
+	return nil
+}
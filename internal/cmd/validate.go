@@ -0,0 +1,302 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var (
+	validateFix         bool
+	validateAnnotations string
+)
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check requirements for definition of done gaps and inconsistent data",
+	Long: `Check every COMPLETE requirement against the definition of done
+configured in definition_of_done.checks, and every requirement for
+inconsistent data, and report the problems per requirement. Exits with
+code 1 if any requirement falls short.
+
+Available checks:
+  spec_file            requirement_file is set and the file exists
+  acceptance_criteria  the spec's Acceptance Criteria items are all checked
+  test_linked          test_module and test_function are set
+  field:<column>       the column is not empty, e.g. field:reviewer
+
+Set definition_of_done.enforce_in_verify to have verify keep such
+requirements PARTIAL even when their tests pass.
+
+Whatever the configured checks, a COMPLETE requirement whose spec lists
+unchecked Acceptance Criteria items is reported. Use verify --autocheck to
+tick them when the linked tests pass.
+
+Inconsistent data is a status, priority, phase or effort that does not
+parse, a negative effort or phase, a started_date without a phase, a
+completed_date before the started_date, a date in neither the configured
+locale.date_format nor YYYY-MM-DD, or a COMPLETE requirement without a
+completed_date. --fix corrects the safe
+cases: a missing completed_date is set to today.
+
+An external_id that several requirements link to, for the same service,
+is reported on each of them, since sync would fight over the item.
+
+When rtmx.yaml lists the allowed categories, a requirement in any other
+category is reported with the closest allowed one.
+
+--annotations reports each problem at the requirement's line of the
+database file for CI: "github" prints GitHub Actions ::error commands and
+"gitlab" prints a GitLab Code Quality report.
+
+Examples:
+    rtmx validate
+    rtmx validate --fix
+    rtmx validate --annotations github
+    rtmx validate --annotations gitlab > gl-code-quality-report.json`,
+	RunE: runValidate,
+}
+
+func init() {
+	validateCmd.Flags().BoolVar(&validateFix, "fix", false, "fix inconsistencies that have a safe correction")
+	validateCmd.Flags().StringVar(&validateAnnotations, "annotations", "", "report problems as CI annotations: github, gitlab")
+	rootCmd.AddCommand(validateCmd)
+}
+
+func runValidate(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	if validateAnnotations != "" && validateAnnotations != "github" && validateAnnotations != "gitlab" {
+		return NewValidationError(fmt.Sprintf("invalid annotations format %q (use github or gitlab)", validateAnnotations))
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	dod, err := newDefinitionOfDone(cfg.RTMX.DefinitionOfDone.Checks, cwd)
+	if err != nil {
+		return NewTypedError(ErrorTypeConfig, "", err)
+	}
+
+	dbPath := cfg.DatabasePath(cwd)
+	db, err := database.Load(dbPath)
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	// A COMPLETE requirement never leaves acceptance criteria unchecked,
+	// whether or not the definition of done lists them
+	dod.requireCheckedCriteria()
+
+	duplicates := duplicateLinkProblems(db)
+
+	var details []string
+	var problems []validationProblem
+	checked, fixed := 0, 0
+	for _, req := range db.All() {
+		unmet := duplicates[req.ReqID]
+		if problem := categoryProblem(cfg, req.Category); problem != "" {
+			unmet = append(unmet, problem)
+		}
+		for _, problem := range req.Inconsistencies() {
+			if validateFix && problem.Fix != nil {
+				problem.Fix()
+				fixed++
+				if validateAnnotations == "" {
+					cmd.Printf("  %s %s: fixed %s\n", output.Color("↻", output.Blue), req.ReqID, problem.Message)
+				}
+				continue
+			}
+			unmet = append(unmet, problem.Message)
+		}
+		if req.IsComplete() {
+			checked++
+			unmet = append(dod.Unmet(req), unmet...)
+		}
+		if len(unmet) == 0 {
+			continue
+		}
+		if validateAnnotations == "" {
+			cmd.Printf("  %s %s\n", output.Color("✗", output.Red), output.Color(req.ReqID, output.Cyan))
+		}
+		for _, item := range unmet {
+			if validateAnnotations == "" {
+				cmd.Printf("      - %s\n", item)
+			}
+			details = append(details, fmt.Sprintf("%s: %s", req.ReqID, item))
+			problems = append(problems, validationProblem{ReqID: req.ReqID, Line: req.Line(), Message: item})
+		}
+	}
+
+	if validateAnnotations != "" {
+		if err := printAnnotations(cmd, validateAnnotations, annotationPath(cwd, dbPath), problems); err != nil {
+			return err
+		}
+	}
+
+	if fixed > 0 {
+		if err := db.Save(dbPath); err != nil {
+			return databaseSaveError(err)
+		}
+	}
+
+	if len(details) > 0 {
+		if validateAnnotations == "" {
+			cmd.Println()
+		}
+		return NewValidationError("requirements failed validation", details...)
+	}
+
+	if validateAnnotations == "" {
+		cmd.Printf("%s %d COMPLETE requirement(s) meet the definition of done\n",
+			output.Color("✓", output.Green), checked)
+	}
+	return nil
+}
+
+// categoryProblem describes why category is not allowed by the categories
+// allow-list in cfg, suggesting the closest allowed one, or returns "".
+// Requirements without a category are left alone.
+func categoryProblem(cfg *config.Config, category string) string {
+	if category == "" || cfg.CategoryAllowed(category) {
+		return ""
+	}
+	return fmt.Sprintf("category %q is not in the allowed categories (did you mean %q?)", category, cfg.SuggestCategory(category))
+}
+
+// duplicateLinkProblems describes, per requirement, each external ID it
+// shares with other requirements.
+func duplicateLinkProblems(db *database.Database) map[string][]string {
+	problems := make(map[string][]string)
+	for _, d := range db.DuplicateExternalIDs() {
+		for _, id := range d.ReqIDs {
+			var others []string
+			for _, other := range d.ReqIDs {
+				if other != id {
+					others = append(others, other)
+				}
+			}
+			problems[id] = append(problems[id], fmt.Sprintf("external_id %s is also linked by %s", d, strings.Join(others, ", ")))
+		}
+	}
+	return problems
+}
+
+// dodCheck is one definition-of-done item.
+type dodCheck struct {
+	name string
+	met  func(req *database.Requirement) bool
+}
+
+// definitionOfDone checks requirements against the configured checklist.
+type definitionOfDone struct {
+	root   string
+	checks []dodCheck
+}
+
+// newDefinitionOfDone parses the configured check names. Spec files are
+// resolved relative to root.
+func newDefinitionOfDone(names []string, root string) (*definitionOfDone, error) {
+	dod := &definitionOfDone{root: root}
+	for _, name := range names {
+		check := dodCheck{name: name}
+		switch {
+		case name == "spec_file":
+			check.met = dod.specFileExists
+		case name == "acceptance_criteria":
+			check.met = dod.acceptanceCriteriaChecked
+		case name == "test_linked":
+			check.met = func(req *database.Requirement) bool { return req.HasTest() }
+		case strings.HasPrefix(name, "field:") && len(name) > len("field:"):
+			column := strings.TrimPrefix(name, "field:")
+			check.met = func(req *database.Requirement) bool { return strings.TrimSpace(req.Field(column)) != "" }
+		default:
+			return nil, fmt.Errorf("unknown definition_of_done check %q", name)
+		}
+		dod.checks = append(dod.checks, check)
+	}
+	return dod, nil
+}
+
+// Unmet returns the names of the checks req does not pass.
+func (d *definitionOfDone) Unmet(req *database.Requirement) []string {
+	var unmet []string
+	for _, check := range d.checks {
+		if !check.met(req) {
+			unmet = append(unmet, check.name)
+		}
+	}
+	return unmet
+}
+
+func (d *definitionOfDone) specPath(req *database.Requirement) string {
+	return specFilePath(d.root, req)
+}
+
+func (d *definitionOfDone) specFileExists(req *database.Requirement) bool {
+	path := d.specPath(req)
+	if path == "" {
+		return false
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// acceptanceCriteriaChecked reports whether the spec has an Acceptance
+// Criteria section whose checklist items are all checked.
+func (d *definitionOfDone) acceptanceCriteriaChecked(req *database.Requirement) bool {
+	path := d.specPath(req)
+	if path == "" {
+		return false
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	items := parseAcceptanceCriteria(string(content))
+	for _, item := range items {
+		if !item.Checked {
+			return false
+		}
+	}
+	return len(items) > 0
+}
+
+// has reports whether the named check is configured.
+func (d *definitionOfDone) has(name string) bool {
+	for _, check := range d.checks {
+		if check.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// requireCheckedCriteria adds a check that fails when the spec lists
+// unchecked acceptance criteria. Unlike acceptance_criteria, it passes
+// requirements without a spec or without criteria.
+func (d *definitionOfDone) requireCheckedCriteria() {
+	if d.has("acceptance_criteria") {
+		return
+	}
+	d.checks = append(d.checks, dodCheck{
+		name: "acceptance_criteria",
+		met: func(req *database.Requirement) bool {
+			return len(uncheckedCriteria(d.specPath(req))) == 0
+		},
+	})
+}
@@ -81,7 +81,7 @@ func runValidateStaged(cmd *cobra.Command, args []string) error {
 		for _, err := range allErrors {
 			cmd.Printf("  %s %s\n", output.Color("✗", output.Red), err)
 		}
-		return NewExitError(1, "validation failed")
+		return NewValidationError("validation failed", allErrors...)
 	}
 
 	if validateStagedVerbose {
@@ -108,7 +108,7 @@ func validateCSVFile(filePath string) []string {
 	}
 	defer file.Close()
 
-	reader := csv.NewReader(file)
+	reader := csv.NewReader(database.SkipBOM(file))
 
 	// Read header
 	header, err := reader.Read()
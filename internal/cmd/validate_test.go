@@ -0,0 +1,359 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// setupDoDProject creates a project with a definition of done and two
+// COMPLETE requirements, only one of which has its spec file.
+func setupDoDProject(t *testing.T) string {
+	t.Helper()
+	tmpDir := setupTestProject(t, "REQ-DOD-001", "REQ-DOD-002")
+	writeTestConfig(t, tmpDir, "  definition_of_done:\n    checks: [spec_file, acceptance_criteria, test_linked, \"field:reviewer\"]\n")
+	writeTestFile(t, tmpDir, ".rtmx/requirements/DOD/REQ-DOD-001.md", "# REQ-DOD-001\n\n## Acceptance Criteria\n- [x] Works\n- [x] Documented\n\n## Notes\n- [ ] Unrelated follow-up\n")
+	updateTestDatabase(t, tmpDir, func(db *database.Database) {
+		for _, req := range db.All() {
+			req.Status = database.StatusComplete
+			req.CompletedDate = "2025-01-15"
+			req.TestModule = "dod_test.go"
+			req.TestFunction = "TestDoD"
+			req.RequirementFile = ".rtmx/requirements/DOD/" + req.ReqID + ".md"
+			req.Extra["reviewer"] = "alice"
+		}
+	})
+	return tmpDir
+}
+
+func TestValidateDefinitionOfDone(t *testing.T) {
+	setupDoDProject(t)
+	var buf bytes.Buffer
+	validateCmd.SetOut(&buf)
+	defer validateCmd.SetOut(nil)
+
+	err := runValidate(validateCmd, nil)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Type != ErrorTypeValidation {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	want := []string{"REQ-DOD-002: spec_file", "REQ-DOD-002: acceptance_criteria"}
+	if strings.Join(exitErr.Details, "|") != strings.Join(want, "|") {
+		t.Errorf("Details = %v, want %v", exitErr.Details, want)
+	}
+	if strings.Contains(buf.String(), "REQ-DOD-001") {
+		t.Errorf("REQ-DOD-001 meets the definition of done and should not be reported:\n%s", buf.String())
+	}
+}
+
+func TestDefinitionOfDoneChecks(t *testing.T) {
+	root := t.TempDir()
+	specs := map[string]string{
+		"done.md":    "## Acceptance Criteria\n- [x] One\n- [X] Two\n",
+		"pending.md": "## Acceptance Criteria\n- [x] One\n- [ ] Two\n",
+		"empty.md":   "## Acceptance Criteria\nNothing listed\n",
+	}
+	for name, content := range specs {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	dod, err := newDefinitionOfDone([]string{"acceptance_criteria", "field:reviewer"}, root)
+	if err != nil {
+		t.Fatalf("newDefinitionOfDone failed: %v", err)
+	}
+
+	tests := []struct {
+		file     string
+		reviewer string
+		want     []string
+	}{
+		{"done.md", "bob", nil},
+		{"pending.md", "bob", []string{"acceptance_criteria"}},
+		{"empty.md", "", []string{"acceptance_criteria", "field:reviewer"}},
+		{"missing.md", "bob", []string{"acceptance_criteria"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			req := database.NewRequirement("REQ-DOD-001")
+			req.RequirementFile = tt.file
+			if tt.reviewer != "" {
+				req.Extra["reviewer"] = tt.reviewer
+			}
+			if got := dod.Unmet(req); strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Unmet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := newDefinitionOfDone([]string{"signed_off"}, root); err == nil {
+		t.Error("Expected error for unknown check")
+	}
+}
+
+func TestValidateUncheckedCriteria(t *testing.T) {
+	tmpDir := setupDoDProject(t)
+	// No definition of done is configured
+	writeTestConfig(t, tmpDir, "")
+	writeTestFile(t, tmpDir, ".rtmx/requirements/DOD/REQ-DOD-002.md", "# REQ-DOD-002\n\n## Acceptance Criteria\n- [x] Works\n- [ ] Documented\n")
+
+	var buf bytes.Buffer
+	validateCmd.SetOut(&buf)
+	defer validateCmd.SetOut(nil)
+
+	err := runValidate(validateCmd, nil)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Type != ErrorTypeValidation {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	if want := "REQ-DOD-002: acceptance_criteria"; strings.Join(exitErr.Details, "|") != want {
+		t.Errorf("Details = %v, want [%s]", exitErr.Details, want)
+	}
+
+	// health warns about the same requirement
+	db, err := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	result := runHealthChecks(db, tmpDir, config.CompletionThresholdsConfig{})
+	var found bool
+	for _, check := range result.Checks {
+		if check.Name == "acceptance_criteria" {
+			found = true
+			if check.Status != CheckWarn || !strings.Contains(check.Message, "1 COMPLETE") {
+				t.Errorf("acceptance_criteria check = %+v, want a warning for 1 requirement", check)
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected an acceptance_criteria health check")
+	}
+}
+
+func TestValidateInconsistentData(t *testing.T) {
+	origFix := validateFix
+	defer func() { validateFix = origFix }()
+
+	tmpDir := setupTestProject(t)
+	db := database.NewDatabase()
+	add := func(id string, edit func(req *database.Requirement)) {
+		req := database.NewRequirement(id)
+		req.Category = "DATA"
+		req.RequirementText = id
+		req.Phase = 1
+		edit(req)
+		_ = db.Add(req)
+	}
+	add("REQ-DATA-001", func(req *database.Requirement) { req.EffortWeeks = -2 })
+	add("REQ-DATA-002", func(req *database.Requirement) {
+		req.Status = database.StatusComplete
+		req.StartedDate = "2025-03-01"
+		req.CompletedDate = "2025-02-01"
+	})
+	add("REQ-DATA-003", func(req *database.Requirement) { req.Status = database.StatusComplete })
+	add("REQ-DATA-004", func(req *database.Requirement) {
+		req.Phase = 0
+		req.StartedDate = "2025-03-01"
+	})
+	add("REQ-DATA-005", func(req *database.Requirement) { req.StartedDate = "2025-03-01" })
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	if err := db.Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	var buf bytes.Buffer
+	validateCmd.SetOut(&buf)
+	defer validateCmd.SetOut(nil)
+
+	validateFix = false
+	err := runValidate(validateCmd, nil)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Type != ErrorTypeValidation {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	want := []string{
+		"REQ-DATA-001: negative effort_weeks -2",
+		"REQ-DATA-002: completed_date 2025-02-01 is before started_date 2025-03-01",
+		"REQ-DATA-003: status is COMPLETE but completed_date is empty",
+		"REQ-DATA-004: started_date 2025-03-01 is set but no phase is assigned",
+	}
+	if strings.Join(exitErr.Details, "|") != strings.Join(want, "|") {
+		t.Errorf("Details = %v, want %v", exitErr.Details, want)
+	}
+
+	result := runHealthChecks(db, tmpDir, config.CompletionThresholdsConfig{})
+	var found bool
+	for _, check := range result.Checks {
+		if check.Name == "consistency" {
+			found = true
+			if check.Status != CheckWarn || !strings.Contains(check.Message, "4 requirement(s)") {
+				t.Errorf("consistency check = %+v, want a warning for 4 requirements", check)
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected a consistency health check")
+	}
+
+	// --fix sets the missing completed_date and leaves the rest reported
+	validateFix = true
+	buf.Reset()
+	err = runValidate(validateCmd, nil)
+	if !errors.As(err, &exitErr) || len(exitErr.Details) != 3 {
+		t.Fatalf("expected 3 remaining problems after --fix, got %v", err)
+	}
+	fixed, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	if fixed.Get("REQ-DATA-003").CompletedDate == "" {
+		t.Error("Expected --fix to set completed_date on REQ-DATA-003")
+	}
+	if !strings.Contains(buf.String(), "REQ-DATA-003: fixed") {
+		t.Errorf("Expected the fix to be reported, got:\n%s", buf.String())
+	}
+}
+
+func TestValidateAnnotations(t *testing.T) {
+	origAnnotations := validateAnnotations
+	defer func() { validateAnnotations = origAnnotations }()
+
+	tmpDir := setupTestProject(t)
+	writeTestFile(t, tmpDir, ".rtmx/database.csv", "req_id,category,requirement_text,status,phase\n"+
+		"REQ-ANN-001,ANN,Fine,MISSING,1\n"+
+		"REQ-ANN-002,ANN,Bad status,FINISHED,1\n")
+
+	run := func(format string) string {
+		var buf bytes.Buffer
+		validateCmd.SetOut(&buf)
+		defer validateCmd.SetOut(nil)
+		validateAnnotations = format
+		if err := runValidate(validateCmd, nil); err == nil {
+			t.Fatalf("expected validate --annotations %s to fail", format)
+		}
+		return buf.String()
+	}
+
+	got := strings.TrimSpace(run("github"))
+	want := `::error file=.rtmx/database.csv,line=3,title=REQ-ANN-002::REQ-ANN-002: invalid status "FINISHED" (read as MISSING)`
+	if got != want {
+		t.Errorf("github annotations =\n%s\nwant\n%s", got, want)
+	}
+
+	var report []codeQualityIssue
+	if err := json.Unmarshal([]byte(run("gitlab")), &report); err != nil {
+		t.Fatalf("gitlab report is not JSON: %v", err)
+	}
+	if len(report) != 1 || report[0].Location.Path != ".rtmx/database.csv" || report[0].Location.Lines.Begin != 3 {
+		t.Errorf("gitlab report = %+v, want one issue at .rtmx/database.csv:3", report)
+	}
+}
+
+func TestValidateDuplicateExternalIDs(t *testing.T) {
+	tests := []struct {
+		name  string
+		links map[string]string // req ID -> external_id column
+		want  []string
+	}{
+		{
+			name:  "clean",
+			links: map[string]string{"REQ-LINK-001": "github:1", "REQ-LINK-002": "github:2|jira:PROJ-1", "REQ-LINK-003": "jira:1"},
+		},
+		{
+			name:  "shared issue",
+			links: map[string]string{"REQ-LINK-001": "github:7", "REQ-LINK-002": "github:7|jira:PROJ-1", "REQ-LINK-003": "99"},
+			want: []string{
+				"REQ-LINK-001: external_id github:7 is also linked by REQ-LINK-002",
+				"REQ-LINK-002: external_id github:7 is also linked by REQ-LINK-001",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := setupTestProject(t, "REQ-LINK-001", "REQ-LINK-002", "REQ-LINK-003")
+			updateTestDatabase(t, tmpDir, func(db *database.Database) {
+				for _, req := range db.All() {
+					req.ExternalID, req.ExternalIDs = database.ParseExternalIDs(tt.links[req.ReqID])
+				}
+			})
+
+			var buf bytes.Buffer
+			validateCmd.SetOut(&buf)
+			defer validateCmd.SetOut(nil)
+
+			err := runValidate(validateCmd, nil)
+			db, loadErr := database.Load(filepath.Join(tmpDir, ".rtmx", "database.csv"))
+			if loadErr != nil {
+				t.Fatalf("Failed to load database: %v", loadErr)
+			}
+			health := runHealthChecks(db, tmpDir, config.CompletionThresholdsConfig{})
+			var linkCheck HealthCheck
+			for _, check := range health.Checks {
+				if check.Name == "external_ids" {
+					linkCheck = check
+				}
+			}
+			syncErr := checkDuplicateLinks(&config.Config{RTMX: config.RTMXConfig{Database: ".rtmx/database.csv"}}, []string{"github"})
+
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Errorf("validate failed on a clean database: %v", err)
+				}
+				if linkCheck.Status != CheckPass {
+					t.Errorf("external_ids check = %+v, want pass", linkCheck)
+				}
+				if syncErr != nil {
+					t.Errorf("Expected sync to proceed, got %v", syncErr)
+				}
+				return
+			}
+			var exitErr *ExitError
+			if !errors.As(err, &exitErr) || strings.Join(exitErr.Details, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("validate error = %v, want details %v", err, tt.want)
+			}
+			if linkCheck.Status != CheckWarn || !strings.Contains(linkCheck.Message, "github:7 linked by REQ-LINK-001, REQ-LINK-002") {
+				t.Errorf("external_ids check = %+v, want a warning for github:7", linkCheck)
+			}
+			if syncErr == nil {
+				t.Error("Expected sync to refuse duplicate GitHub links")
+			}
+		})
+	}
+}
+
+func TestValidateCategoryAllowList(t *testing.T) {
+	tmpDir := setupDoDProject(t)
+	// Without definition of done checks only the allow-list can fail
+	writeConfig := func(categories string) {
+		writeTestConfig(t, tmpDir, "  categories: "+categories+"\n")
+	}
+
+	var buf bytes.Buffer
+	validateCmd.SetOut(&buf)
+	defer validateCmd.SetOut(nil)
+
+	writeConfig("[DOD, API]")
+	if err := runValidate(validateCmd, nil); err != nil {
+		t.Fatalf("validate failed for allowed categories: %v\n%s", err, buf.String())
+	}
+
+	writeConfig("[DONE, API]")
+	err := runValidate(validateCmd, nil)
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Type != ErrorTypeValidation {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	want := `REQ-DOD-001: category "DOD" is not in the allowed categories (did you mean "DONE"?)`
+	if len(exitErr.Details) == 0 || exitErr.Details[0] != want {
+		t.Errorf("Details = %v, want first %q", exitErr.Details, want)
+	}
+}
@@ -2,23 +2,50 @@ package cmd
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"os/signal"
+	"path"
+	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/rtmx-ai/rtmx-go/internal/config"
 	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/markers"
 	"github.com/rtmx-ai/rtmx-go/internal/output"
 	"github.com/spf13/cobra"
 )
 
+// verifyWaitDelay bounds how long verify waits for the test command's
+// output to close after the command was killed.
+const verifyWaitDelay = 2 * time.Second
+
 var (
-	verifyUpdate  bool
-	verifyDryRun  bool
-	verifyVerbose bool
-	verifyCommand string
+	verifyUpdate    bool
+	verifyDryRun    bool
+	verifyVerbose   bool
+	verifyCommand   string
+	verifyStrategy  string
+	verifyThreshold float64
+	verifyFailFast  bool
+	verifyOnlyFail  bool
+	verifyAutocheck bool
+	verifyTimeout   time.Duration
+	verifyFormat    string
+	verifyBaseline  string
+	verifyFailRegr  bool
+	verifyFromRTM   bool
+	verifyCategory  string
+	verifyJUnit     string
+	verifyJUnitUpd  string
+	verifyFromFile  string
 )
 
 var verifyCmd = &cobra.Command{
@@ -32,17 +59,90 @@ is automatically updated based on pass/fail results.
 The command runs "go test -json ./..." by default, but you can
 specify a custom test command with --command.
 
-Status update rules:
-  - All tests pass → COMPLETE
-  - Any test fails → Downgrade COMPLETE to PARTIAL
-  - No tests → Keep current status
+Status update rules depend on the --strategy (or verify.strategy in config):
+  all-pass (default)
+    - All tests pass → COMPLETE
+    - Any test fails → Downgrade COMPLETE to PARTIAL
+    - No tests → Keep current status
+  pass-rate
+    - Pass rate >= threshold → COMPLETE
+    - Some tests pass → PARTIAL
+  coverage
+    - All tests pass and coverage >= threshold → COMPLETE
+    - All tests pass but coverage too low → PARTIAL
+
+Each requirement is reported as soon as every package running its tests
+has finished. With --fail-fast the run stops at the first failing
+requirement; --update still saves the statuses determined before then.
+
+Each run records the requirements whose tests failed in
+.rtmx/cache/verify-last.json. With --only-failing, only the tests linked
+to those requirements are run and only they are updated; without a
+recorded run, everything is verified.
+
+With --autocheck, every requirement whose linked tests all pass has the
+Acceptance Criteria checkboxes in its spec file ticked (--dry-run only
+reports how many would be). This happens before the definition of done is
+enforced, so acceptance_criteria no longer holds such requirements back.
+
+With --timeout, the test command is killed, with every process it
+started, once the duration has passed; Ctrl-C does the same. Requirements
+whose tests had not finished are reported as undetermined and keep their
+status, even with --update.
+
+With --dry-run the tests still run, but nothing is written: not the
+database, the spec files or the run history. A table shows each status
+change --update would apply. With --format json, the changes are printed
+as a JSON report instead of the terminal output.
+
+With --packages-from-rtm, only the test modules linked to requirements
+in the RTM are run instead of the whole suite: the packages of Go test
+modules are passed to go test, and with --command every distinct test
+module is appended to the custom command, e.g. pytest tests/a.py
+tests/b.py. --category narrows verification, and so the derived
+targets, to one category.
+
+With --compare-baseline, the statuses derived from the run are compared
+with a baseline database, as rtmx diff does, without saving them unless
+--update is also given. With --fail-on-regression the command then exits
+non-zero only when a requirement regressed against the baseline (or the
+run did not finish); failing tests of requirements that were not further
+along in the baseline do not fail it.
+
+With --junit, the results are also written as a JUnit XML report, one
+test suite per requirement. Combined with --update, or given as
+--write-junit-and-update, the report and the database are written
+together once the whole run is done: both are staged in temporary files
+and renamed into place only if both could be written, and only if the
+test command ran to the end. Otherwise neither file changes.
+
+With --results, no tests are run: the outcomes are read from a results
+file produced elsewhere, e.g. by a CI job, and the statuses derived from
+them with the chosen strategy. The file is either a JSON array of counts
+per requirement,
+  [{"req_id": "REQ-AUTH-001", "passed": 3, "failed": 0, "skipped": 1}]
+or a JSON object mapping test names to pass, fail or skip,
+  {"TestLogin": "pass", "tests/test_api.py::test_get": "fail"}
+whose tests belong to the requirements naming them as test_function or
+marked with them in the project's test files.
 
 Examples:
   rtmx verify                    # Run tests, show results
   rtmx verify --update           # Run tests and update RTM
   rtmx verify ./internal/... --update  # Verify specific package
   rtmx verify --dry-run          # Show what would change
-  rtmx verify --command "pytest -v"    # Use custom test command`,
+  rtmx verify --dry-run --format json  # The same, as JSON
+  rtmx verify --command "pytest -v"    # Use custom test command
+  rtmx verify --strategy pass-rate --threshold 90
+  rtmx verify --fail-fast --update     # Stop at the first failing requirement
+  rtmx verify --only-failing --update  # Re-check what failed last time
+  rtmx verify --autocheck --update     # Tick criteria of passing requirements
+  rtmx verify --timeout 10m --update   # Give up on a hung test run
+  rtmx verify --packages-from-rtm --category AUTH
+  rtmx verify --packages-from-rtm --command "pytest -v"
+  rtmx verify --compare-baseline baseline.csv --fail-on-regression
+  rtmx verify --write-junit-and-update report.xml
+  rtmx verify --results results.json --update`,
 	RunE: runVerify,
 }
 
@@ -51,6 +151,20 @@ func init() {
 	verifyCmd.Flags().BoolVar(&verifyDryRun, "dry-run", false, "show changes without updating")
 	verifyCmd.Flags().BoolVarP(&verifyVerbose, "verbose", "v", false, "verbose output")
 	verifyCmd.Flags().StringVar(&verifyCommand, "command", "", "custom test command (default: go test -json)")
+	verifyCmd.Flags().StringVar(&verifyStrategy, "strategy", "", "status strategy: all-pass, pass-rate, coverage (default from config)")
+	verifyCmd.Flags().Float64Var(&verifyThreshold, "threshold", 0, "percentage threshold for pass-rate or coverage strategies (default from config)")
+	verifyCmd.Flags().BoolVar(&verifyFailFast, "fail-fast", false, "stop at the first failing requirement")
+	verifyCmd.Flags().BoolVar(&verifyOnlyFail, "only-failing", false, "re-verify only the requirements that failed in the last run")
+	verifyCmd.Flags().BoolVar(&verifyAutocheck, "autocheck", false, "tick the acceptance criteria of requirements whose tests pass")
+	verifyCmd.Flags().DurationVar(&verifyTimeout, "timeout", 0, "kill the test command after this long, e.g. 10m (default: no limit)")
+	verifyCmd.Flags().StringVar(&verifyFormat, "format", "terminal", "output format: terminal, json")
+	verifyCmd.Flags().StringVar(&verifyBaseline, "compare-baseline", "", "compare the derived statuses with this baseline database")
+	verifyCmd.Flags().BoolVar(&verifyFailRegr, "fail-on-regression", false, "exit non-zero only on a regression against --compare-baseline")
+	verifyCmd.Flags().BoolVar(&verifyFromRTM, "packages-from-rtm", false, "run only the test modules linked to requirements in the RTM")
+	verifyCmd.Flags().StringVar(&verifyCategory, "category", "", "verify only requirements in this category")
+	verifyCmd.Flags().StringVar(&verifyJUnit, "junit", "", "write the results as a JUnit XML report to this file")
+	verifyCmd.Flags().StringVar(&verifyJUnitUpd, "write-junit-and-update", "", "write a JUnit XML report to this file and update the RTM, all or nothing")
+	verifyCmd.Flags().StringVar(&verifyFromFile, "results", "", "derive statuses from this test results file instead of running tests")
 
 	rootCmd.AddCommand(verifyCmd)
 }
@@ -72,6 +186,9 @@ type TestResult struct {
 	Passed  bool
 	Failed  bool
 	Skipped bool
+
+	// Coverage is the statement coverage of the test's package, or -1 if unknown.
+	Coverage float64
 }
 
 // VerificationResult represents the verification outcome for a requirement
@@ -84,6 +201,17 @@ type VerificationResult struct {
 	PreviousStatus database.Status
 	NewStatus      database.Status
 	Updated        bool
+
+	// UnmetDoD lists the definition-of-done checks that kept the
+	// requirement from COMPLETE.
+	UnmetDoD []string
+	// CriteriaTicked counts the acceptance criteria --autocheck ticked.
+	CriteriaTicked int
+
+	// Strategy names the deriver that chose NewStatus, and Tests are the
+	// linked test results it was derived from.
+	Strategy string
+	Tests    []*TestResult
 }
 
 func runVerify(cmd *cobra.Command, args []string) error {
@@ -91,6 +219,26 @@ func runVerify(cmd *cobra.Command, args []string) error {
 		output.DisableColor()
 	}
 
+	if verifyFormat != "terminal" && verifyFormat != "json" {
+		return NewValidationError(fmt.Sprintf("invalid format %q (use terminal or json)", verifyFormat))
+	}
+	if verifyFailRegr && verifyBaseline == "" {
+		return NewValidationError("--fail-on-regression requires --compare-baseline")
+	}
+	if verifyFromRTM && (len(args) > 0 || verifyOnlyFail) {
+		return NewValidationError("--packages-from-rtm cannot be combined with a test path or --only-failing")
+	}
+	if verifyFromFile != "" && (len(args) > 0 || verifyCommand != "" || verifyFromRTM) {
+		return NewValidationError("--results cannot be combined with a test path, --command or --packages-from-rtm")
+	}
+	junitPath, update := verifyJUnit, verifyUpdate
+	if verifyJUnitUpd != "" {
+		if junitPath != "" && junitPath != verifyJUnitUpd {
+			return NewValidationError("--junit and --write-junit-and-update name different files")
+		}
+		junitPath, update = verifyJUnitUpd, true
+	}
+
 	cwd, err := os.Getwd()
 	if err != nil {
 		return fmt.Errorf("failed to get working directory: %w", err)
@@ -98,13 +246,41 @@ func runVerify(cmd *cobra.Command, args []string) error {
 
 	cfg, err := config.LoadFromDir(cwd)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return configLoadError(err)
 	}
 
 	dbPath := cfg.DatabasePath(cwd)
 	db, err := database.Load(dbPath)
 	if err != nil {
-		return fmt.Errorf("failed to load database: %w", err)
+		return databaseLoadError(err)
+	}
+
+	var fromFile *verifyResultsFile
+	if verifyFromFile != "" {
+		fromFile, err = loadVerifyResults(verifyFromFile)
+		if err != nil {
+			return NewValidationError(err.Error())
+		}
+	}
+
+	var baselineDB *database.Database
+	if verifyBaseline != "" {
+		baselineDB, err = database.Load(verifyBaseline)
+		if err != nil {
+			return fmt.Errorf("failed to load baseline: %w", err)
+		}
+	}
+
+	// In JSON mode the report is the only output
+	out := cmd.OutOrStdout()
+	if verifyFormat == "json" {
+		cmd.SetOut(io.Discard)
+		defer cmd.SetOut(out)
+	}
+
+	deriver, err := verifyDeriver(cfg)
+	if err != nil {
+		return err
 	}
 
 	// Determine test path
@@ -112,86 +288,289 @@ func runVerify(cmd *cobra.Command, args []string) error {
 	if len(args) > 0 {
 		testPath = args[0]
 	}
+	testArgs := []string{testPath}
 
-	cmd.Println("Running tests and collecting requirement coverage...")
+	history, err := loadVerifyHistory(cwd)
+	if err != nil {
+		cmd.Printf("%s %v\n", output.Color("!", output.Yellow), err)
+	}
+
+	var only map[string]bool
+	if verifyOnlyFail {
+		switch {
+		case history == nil:
+			cmd.Println("No previous verify run recorded; verifying all requirements")
+		case len(history.Failing) == 0:
+			cmd.Println("No requirements failed in the last verify run")
+			return nil
+		default:
+			only = make(map[string]bool, len(history.Failing))
+			for _, id := range history.Failing {
+				only[id] = true
+			}
+			if scoped := verifyScope(db, history.Failing, testPath); scoped != nil {
+				testArgs = scoped
+			}
+			cmd.Printf("Re-verifying %d requirement(s) that failed in the last run\n", len(history.Failing))
+		}
+	}
+	if verifyCategory != "" {
+		only = categoryScope(db, verifyCategory, only)
+	}
+	if verifyFromRTM {
+		testArgs = verifyTargets(db, only, verifyCommand == "")
+		if len(testArgs) == 0 {
+			cmd.Println("No test modules are linked to the requirements to verify")
+			return nil
+		}
+		cmd.Printf("Running the tests in %d module(s) linked from the RTM\n", len(testArgs))
+	}
+
+	if fromFile != nil {
+		cmd.Printf("Reading test results from %s...\n", verifyFromFile)
+		for _, id := range fromFile.unknownRequirements(db) {
+			cmd.Printf("%s %s is not in the RTM\n", output.Color("!", output.Yellow), id)
+		}
+	} else {
+		cmd.Println("Running tests and collecting requirement coverage...")
+	}
 	cmd.Println()
 
-	// Run tests and get results
-	testResults, err := runTests(cmd, testPath)
-	if err != nil {
-		cmd.Printf("%s Failed to run tests: %v\n", output.Color("!", output.Red), err)
+	// Run tests, resolving requirements as their packages finish
+	_, wantCoverage := deriver.(CoverageDeriver)
+	stream := newVerifyStream(db, deriver, verifyFailFast)
+	stream.only = only
+	if dodCfg := cfg.RTMX.DefinitionOfDone; dodCfg.EnforceInVerify && len(dodCfg.Checks) > 0 {
+		stream.dod, err = newDefinitionOfDone(dodCfg.Checks, cwd)
+		if err != nil {
+			return NewTypedError(ErrorTypeConfig, "", err)
+		}
+	}
+	if verifyAutocheck {
+		stream.autocheck = func(req *database.Requirement) int {
+			ticked, err := autocheckSpec(cwd, req, !verifyDryRun)
+			if err != nil {
+				cmd.Printf("%s %s: %v\n", output.Color("!", output.Yellow), req.ReqID, err)
+			}
+			return ticked
+		}
+	}
+	stream.onResolve = func(r VerificationResult) { printVerifyResolution(cmd, r) }
+	var runErr error
+	if fromFile != nil {
+		extractor, err := markers.ForConfig(cfg)
+		if err != nil {
+			return NewTypedError(ErrorTypeConfig, "invalid marker rules", err)
+		}
+		markedBy, err := fromFile.markedTestFunctions(cwd, extractor)
+		if err != nil {
+			return err
+		}
+		stream.resolveEach(fromFile.lookup(markedBy))
+	} else if runErr = runTests(cmd, testArgs, wantCoverage, stream); runErr != nil {
+		cmd.Printf("%s Failed to run tests: %v\n", output.Color("!", output.Red), runErr)
 		// Continue to show what we can
 	}
+	if stream.Aborted {
+		cmd.Printf("\n%s Stopped after the first failing requirement (--fail-fast)\n", output.Color("!", output.Red))
+	}
+	if stream.Interrupted != "" {
+		printVerifyInterrupted(cmd, stream)
+	}
+	if len(stream.Resolved) > 0 {
+		cmd.Println()
+	}
+
+	verifyResults := stream.Resolved
 
-	// Map tests to requirements
-	verifyResults := mapTestsToRequirements(db, testResults)
+	complete := len(args) == 0 && only == nil && !verifyFromRTM && fromFile == nil && !stream.Aborted && stream.Interrupted == ""
+	if !verifyDryRun {
+		if err := saveVerifyHistory(cwd, recordVerifyRun(history, verifyResults, complete, time.Now())); err != nil {
+			cmd.Printf("%s %v\n", output.Color("!", output.Yellow), err)
+		}
+	}
 
 	// Print results
 	printVerifyResults(cmd, verifyResults)
+	watched, err := loadWatchList(cwd)
+	if err != nil {
+		cmd.Printf("%s %v\n", output.Color("!", output.Yellow), err)
+	}
+	watchedChanges := watchedVerifyChanges(verifyResults, watched)
+	printWatchedChanges(cmd, watchedChanges)
+	if verifyAutocheck {
+		printAutocheck(cmd, verifyResults)
+	}
 
 	// Update database if requested
-	if verifyUpdate && !verifyDryRun {
-		updateCount := 0
-		for _, r := range verifyResults {
-			if r.Updated {
-				req := db.Get(r.ReqID)
-				if req != nil {
-					req.Status = r.NewStatus
-					updateCount++
+	changes := verifyChanges(verifyResults)
+	applied := false
+	if update && !verifyDryRun {
+		updateCount := applyVerifyResults(db, verifyResults)
+		switch {
+		case junitPath == "":
+			if updateCount > 0 {
+				if err := db.Save(dbPath); err != nil {
+					return databaseSaveError(err)
 				}
+				applied = true
 			}
-		}
-		if updateCount > 0 {
-			if err := db.Save(dbPath); err != nil {
-				return fmt.Errorf("failed to save database: %w", err)
+		case runErr != nil || stream.Interrupted != "":
+			// Leave no partial state: the report and the RTM are written
+			// only from a run that finished
+			cmd.Printf("\n%s The test run did not finish; neither %s nor the RTM was written\n", output.Color("!", output.Red), junitPath)
+		default:
+			var saveDB *database.Database
+			if updateCount > 0 {
+				saveDB = db
 			}
+			if err := writeVerifyOutputs(junitPath, verifyResults, saveDB, dbPath); err != nil {
+				return NewTypedError(ErrorTypeIO, "failed to write the JUnit report and RTM; neither was changed", err)
+			}
+			applied = updateCount > 0
+			cmd.Printf("\n%s Wrote JUnit report to %s\n", output.Color("✓", output.Green), junitPath)
+		}
+		if applied {
 			cmd.Printf("\n%s Updated %d requirement(s)\n", output.Color("✓", output.Green), updateCount)
-		} else {
+			if err := notifyWatchedChanges(cfg, "verify", watchedChanges); err != nil {
+				cmd.Printf("%s %v\n", output.Color("!", output.Yellow), err)
+			}
+		} else if updateCount == 0 {
 			cmd.Println("\nNo status changes needed")
 		}
 	} else if verifyDryRun {
-		cmd.Printf("\n%s\n", output.Color("Dry run - no changes made", output.Yellow))
+		printVerifyDryRun(cmd, changes)
+	} else if junitPath != "" {
+		if err := writeVerifyOutputs(junitPath, verifyResults, nil, ""); err != nil {
+			return NewTypedError(ErrorTypeIO, "failed to write the JUnit report", err)
+		}
+		cmd.Printf("\n%s Wrote JUnit report to %s\n", output.Color("✓", output.Green), junitPath)
+	}
+
+	// Compare the derived statuses, saved or not, with the baseline
+	var comparison *DiffResult
+	if baselineDB != nil {
+		applyVerifyResults(db, verifyResults)
+		comparison = compareDatabases(baselineDB, db)
+		printVerifyBaseline(cmd, verifyBaseline, comparison)
 	}
 
-	// Exit with error if any tests failed
+	if verifyFormat == "json" {
+		report := verifyReport{
+			DryRun:       verifyDryRun,
+			Applied:      applied,
+			Verified:     len(verifyResults),
+			Changes:      changes,
+			Undetermined: stream.Undetermined,
+			Baseline:     comparison,
+		}
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize verify report: %w", err)
+		}
+		cmd.SetOut(out)
+		cmd.Println(string(data))
+	}
+
+	// Exit with error if any tests failed or the run did not finish
+	if stream.Interrupted != "" {
+		return NewExitError(1, "")
+	}
+	if verifyFailRegr {
+		if comparison.Regressed > 0 {
+			return NewExitError(1, "")
+		}
+		return nil
+	}
 	for _, r := range verifyResults {
 		if r.TestsFailed > 0 {
-			os.Exit(1)
+			return NewExitError(1, "")
 		}
 	}
 
 	return nil
 }
 
-func runTests(cmd *cobra.Command, testPath string) (map[string]*TestResult, error) {
-	results := make(map[string]*TestResult)
+func runTests(cmd *cobra.Command, testArgs []string, withCoverage bool, stream *verifyStream) error {
+	// Ctrl-C and the timeout kill the test command rather than the CLI
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if verifyTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, verifyTimeout)
+		defer cancel()
+	}
 
 	var testCmd *exec.Cmd
 	if verifyCommand != "" {
 		// Use custom command
 		parts := strings.Fields(verifyCommand)
 		if len(parts) == 0 {
-			return nil, fmt.Errorf("empty test command")
+			return fmt.Errorf("empty test command")
 		}
-		testCmd = exec.Command(parts[0], parts[1:]...)
+		if verifyFromRTM {
+			parts = append(parts, testArgs...)
+		}
+		testCmd = exec.CommandContext(ctx, parts[0], parts[1:]...)
 	} else {
 		// Default: go test -json
-		testCmd = exec.Command("go", "test", "-json", testPath)
+		goArgs := []string{"test", "-json"}
+		if withCoverage {
+			goArgs = append(goArgs, "-cover")
+		}
+		testCmd = exec.CommandContext(ctx, "go", append(goArgs, testArgs...)...)
 	}
 
 	testCmd.Dir, _ = os.Getwd()
+	setProcessGroup(testCmd)
+	// Don't wait on output held open by processes outliving the command
+	testCmd.WaitDelay = verifyWaitDelay
 
 	stdout, err := testCmd.StdoutPipe()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get stdout pipe: %w", err)
+		return fmt.Errorf("failed to get stdout pipe: %w", err)
 	}
 
 	if err := testCmd.Start(); err != nil {
-		return nil, fmt.Errorf("failed to start test command: %w", err)
+		return fmt.Errorf("failed to start test command: %w", err)
+	}
+
+	// Stream progress to stderr; verbose mode prints every test instead
+	if !verifyVerbose {
+		stream.progress = output.NewStderrProgress()
+		defer stream.progress.Stop()
 	}
 
-	// Parse JSON output
-	scanner := bufio.NewScanner(stdout)
+	if !consumeTestOutput(cmd, stdout, stream) {
+		_ = killProcessGroup(testCmd)
+	}
+	_ = testCmd.Wait() // Ignore error - we already have results
+
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		stream.interrupt(fmt.Sprintf("timed out after %s", verifyTimeout))
+	case ctx.Err() != nil:
+		stream.interrupt("interrupted")
+	default:
+		stream.finish()
+	}
+	return nil
+}
+
+// printVerifyInterrupted reports a killed test command and the
+// requirements it left undetermined.
+func printVerifyInterrupted(cmd *cobra.Command, stream *verifyStream) {
+	cmd.Printf("\n%s Test command %s and was killed; %d requirement(s) undetermined, status unchanged\n",
+		output.Color("!", output.Red), stream.Interrupted, len(stream.Undetermined))
+	for _, id := range stream.Undetermined {
+		cmd.Printf("  %s %s\n", output.Color("?", output.Yellow), id)
+	}
+}
+
+// consumeTestOutput feeds test output to stream line by line. It returns
+// false if the stream stopped early because of --fail-fast.
+func consumeTestOutput(cmd *cobra.Command, r io.Reader, stream *verifyStream) bool {
+	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		line := scanner.Text()
 
@@ -204,107 +583,395 @@ func runTests(cmd *cobra.Command, testPath string) (map[string]*TestResult, erro
 			continue
 		}
 
-		// Only care about test pass/fail/skip events
-		if event.Test == "" {
-			continue
+		if event.Test != "" {
+			switch event.Action {
+			case "run":
+				stream.progress.Updatef("Running %s (%d passed, %d failed, %d skipped)",
+					event.Test, stream.passed, stream.failed, stream.skipped)
+			case "pass":
+				if verifyVerbose {
+					cmd.Printf("  %s %s\n", output.Color("✓", output.Green), event.Test)
+				}
+			case "fail":
+				if verifyVerbose {
+					cmd.Printf("  %s %s\n", output.Color("✗", output.Red), event.Test)
+				}
+			case "skip":
+				if verifyVerbose {
+					cmd.Printf("  %s %s (skipped)\n", output.Color("-", output.Yellow), event.Test)
+				}
+			}
 		}
 
-		key := event.Package + "/" + event.Test
+		if !stream.handle(event) {
+			return false
+		}
+	}
+	return true
+}
+
+// verifyStream consumes test events as they arrive and resolves each
+// requirement once the package holding its tests has finished, or at the
+// end of the run when no package is known to hold them.
+type verifyStream struct {
+	db       *database.Database
+	deriver  StatusDeriver
+	failFast bool
 
+	results  map[string]*TestResult
+	coverage map[string]float64
+	resolved map[string]bool
+
+	passed, failed, skipped int
+
+	// Resolved holds requirement outcomes in the order they were determined.
+	Resolved []VerificationResult
+	// Aborted is set when fail-fast stopped the run after a failure.
+	Aborted bool
+	// Interrupted says why the test command was killed, if it was.
+	Interrupted string
+	// Undetermined lists the requirements left unresolved when the test
+	// command was killed.
+	Undetermined []string
+
+	// dod, when set, keeps requirements that fail the definition of done
+	// from COMPLETE.
+	dod *definitionOfDone
+	// only, when set, limits resolution to these requirement IDs.
+	only map[string]bool
+	// autocheck, when set, ticks the acceptance criteria of a requirement
+	// whose tests all passed and returns how many it ticked.
+	autocheck func(req *database.Requirement) int
+
+	onResolve func(VerificationResult)
+	progress  *output.Progress
+}
+
+func newVerifyStream(db *database.Database, deriver StatusDeriver, failFast bool) *verifyStream {
+	return &verifyStream{
+		db:       db,
+		deriver:  deriver,
+		failFast: failFast,
+		results:  make(map[string]*TestResult),
+		coverage: make(map[string]float64),
+		resolved: make(map[string]bool),
+	}
+}
+
+// handle records one test event. It returns false once the run should stop.
+func (s *verifyStream) handle(event TestEvent) bool {
+	// Package-level events carry the coverage summary and completion
+	if event.Test == "" {
+		if pct, ok := parseCoverage(event.Output); ok {
+			s.coverage[event.Package] = pct
+		}
 		switch event.Action {
-		case "pass":
-			results[key] = &TestResult{
-				Package: event.Package,
-				Test:    event.Test,
-				Passed:  true,
-			}
-			if verifyVerbose {
-				cmd.Printf("  %s %s\n", output.Color("✓", output.Green), event.Test)
-			}
-		case "fail":
-			results[key] = &TestResult{
-				Package: event.Package,
-				Test:    event.Test,
-				Failed:  true,
-			}
-			if verifyVerbose {
-				cmd.Printf("  %s %s\n", output.Color("✗", output.Red), event.Test)
-			}
-		case "skip":
-			results[key] = &TestResult{
-				Package: event.Package,
-				Test:    event.Test,
-				Skipped: true,
-			}
-			if verifyVerbose {
-				cmd.Printf("  %s %s (skipped)\n", output.Color("-", output.Yellow), event.Test)
-			}
+		case "pass", "fail", "skip":
+			return s.packageDone(event.Package)
 		}
+		return true
 	}
 
-	_ = testCmd.Wait() // Ignore error - we already have results
-
-	return results, nil
+	result := &TestResult{Package: event.Package, Test: event.Test, Coverage: -1}
+	switch event.Action {
+	case "pass":
+		result.Passed = true
+		s.passed++
+	case "fail":
+		result.Failed = true
+		s.failed++
+	case "skip":
+		result.Skipped = true
+		s.skipped++
+	default:
+		return true
+	}
+	s.results[event.Package+"/"+event.Test] = result
+	return true
 }
 
-func mapTestsToRequirements(db *database.Database, testResults map[string]*TestResult) []VerificationResult {
-	var results []VerificationResult
+// packageDone resolves the requirements whose tests live in pkg, as named
+// by their Go test module. Other requirements wait for finish, since a
+// test of the same name may still run in a later package.
+func (s *verifyStream) packageDone(pkg string) bool {
+	pct, hasCoverage := s.coverage[pkg]
+	functions := make(map[string]bool)
+	for _, r := range s.results {
+		if r.Package != pkg {
+			continue
+		}
+		if hasCoverage {
+			r.Coverage = pct
+		}
+		functions[testFunctionName(r.Test)] = true
+	}
+	return s.resolve(func(req *database.Requirement) bool {
+		dir := testPackageDir(req.TestModule)
+		return functions[req.TestFunction] && dir != "" && inTestPackage(pkg, dir)
+	})
+}
 
-	// Build a map of test function -> results
-	testByFunction := make(map[string]*TestResult)
-	for _, r := range testResults {
-		// Index by just function name for matching
-		testByFunction[r.Test] = r
+// finish resolves requirements whose packages never reported completion,
+// such as tests run by a custom command.
+func (s *verifyStream) finish() {
+	if s.Aborted {
+		return
+	}
+	for _, r := range s.results {
+		if pct, ok := s.coverage[r.Package]; ok {
+			r.Coverage = pct
+		}
 	}
+	s.resolve(func(*database.Requirement) bool { return true })
+}
 
-	// For each requirement with a test defined
-	for _, req := range db.All() {
-		if req.TestFunction == "" {
+// interrupt records that the test command was killed for reason. Only the
+// requirements resolved so far are kept; the others with linked tests are
+// undetermined, since their tests may not all have run.
+func (s *verifyStream) interrupt(reason string) {
+	s.Interrupted = reason
+	for _, req := range s.db.All() {
+		if req.TestFunction == "" || s.resolved[req.ReqID] {
+			continue
+		}
+		if s.only != nil && !s.only[req.ReqID] {
 			continue
 		}
+		s.Undetermined = append(s.Undetermined, req.ReqID)
+	}
+}
+
+func (s *verifyStream) resolve(match func(*database.Requirement) bool) bool {
+	testByFunction := groupTestsByFunction(s.results)
+	return s.resolveEach(func(req *database.Requirement) (TestEvidence, []*TestResult, bool) {
+		if req.TestFunction == "" || !match(req) {
+			return TestEvidence{}, nil, false
+		}
+		matched := requirementTests(req, testByFunction[req.TestFunction])
+		return collectEvidence(matched), matched, len(matched) > 0
+	})
+}
 
-		// Try to find matching test result
-		testFunc := req.TestFunction
-		result := testByFunction[testFunc]
+// testPackageDir returns the directory of a Go test module, which the
+// import path of the package holding its tests ends with, or "" when the
+// module names no such directory.
+func testPackageDir(module string) string {
+	module = filepath.ToSlash(module)
+	if !strings.HasSuffix(module, "_test.go") {
+		return ""
+	}
+	if dir := path.Dir(module); dir != "." {
+		return dir
+	}
+	return ""
+}
+
+// inTestPackage reports whether pkg is the package in directory dir.
+func inTestPackage(pkg, dir string) bool {
+	return pkg == dir || strings.HasSuffix(pkg, "/"+dir)
+}
+
+// requirementTests narrows the results of a requirement's test function
+// to the package its Go test module names. Results are kept as they are
+// when the module names no package or none of them ran there, as with
+// tests run by a custom command.
+func requirementTests(req *database.Requirement, results []*TestResult) []*TestResult {
+	dir := testPackageDir(req.TestModule)
+	if dir == "" {
+		return results
+	}
+	var inPackage []*TestResult
+	for _, r := range results {
+		if inTestPackage(r.Package, dir) {
+			inPackage = append(inPackage, r)
+		}
+	}
+	if len(inPackage) == 0 {
+		return results
+	}
+	return inPackage
+}
 
-		if result == nil {
-			// No matching test found
+// resolveEach resolves every requirement not resolved yet for which
+// lookup finds evidence. It returns false once the run should stop.
+func (s *verifyStream) resolveEach(lookup func(*database.Requirement) (TestEvidence, []*TestResult, bool)) bool {
+	for _, req := range s.db.All() {
+		if s.resolved[req.ReqID] {
+			continue
+		}
+		if s.only != nil && !s.only[req.ReqID] {
+			continue
+		}
+		evidence, matched, ok := lookup(req)
+		if !ok {
 			continue
 		}
 
-		// Determine new status
-		newStatus := determineNewStatus(result, req.Status)
+		r := deriveRequirement(req, evidence, matched, s.deriver)
+		if s.autocheck != nil && r.TestsPassed > 0 && r.TestsFailed == 0 {
+			r.CriteriaTicked = s.autocheck(req)
+		}
+		if s.dod != nil {
+			enforceDefinitionOfDone(&r, req, s.dod)
+		}
+		s.resolved[req.ReqID] = true
+		s.Resolved = append(s.Resolved, r)
+		if s.onResolve != nil {
+			s.progress.Stop()
+			s.onResolve(r)
+		}
+		// Finish the current batch so every determined requirement is kept
+		if s.failFast && r.TestsFailed > 0 {
+			s.Aborted = true
+		}
+	}
+	return !s.Aborted
+}
 
-		results = append(results, VerificationResult{
-			ReqID:          req.ReqID,
-			TestsTotal:     1,
-			TestsPassed:    boolToInt(result.Passed),
-			TestsFailed:    boolToInt(result.Failed),
-			TestsSkipped:   boolToInt(result.Skipped),
-			PreviousStatus: req.Status,
-			NewStatus:      newStatus,
-			Updated:        newStatus != req.Status,
-		})
+// applyVerifyResults sets the new status of each changed requirement and
+// returns how many were updated.
+func applyVerifyResults(db *database.Database, results []VerificationResult) int {
+	updateCount := 0
+	for _, r := range results {
+		if r.Updated {
+			req := db.Get(r.ReqID)
+			if req != nil {
+				req.Status = r.NewStatus
+				db.MarkDirty()
+				updateCount++
+			}
+		}
 	}
+	return updateCount
+}
 
-	return results
+// parseCoverage extracts the percentage from a "coverage: 85.0% of statements" line.
+func parseCoverage(line string) (float64, bool) {
+	idx := strings.Index(line, "coverage: ")
+	if idx < 0 {
+		return 0, false
+	}
+	rest := line[idx+len("coverage: "):]
+	end := strings.Index(rest, "%")
+	if end < 0 {
+		return 0, false
+	}
+	var pct float64
+	if _, err := fmt.Sscanf(rest[:end], "%g", &pct); err != nil {
+		return 0, false
+	}
+	return pct, true
 }
 
-func determineNewStatus(result *TestResult, currentStatus database.Status) database.Status {
-	if result.Failed {
-		// Downgrade COMPLETE to PARTIAL on failure
-		if currentStatus == database.StatusComplete {
-			return database.StatusPartial
+// verifyDeriver builds the status deriver from flags, falling back to config.
+func verifyDeriver(cfg *config.Config) (StatusDeriver, error) {
+	strategy := verifyStrategy
+	if strategy == "" {
+		strategy = cfg.RTMX.Verify.Strategy
+	}
+
+	passRate := cfg.RTMX.Verify.PassRateThreshold
+	minCoverage := cfg.RTMX.Verify.MinCoverage
+	if verifyThreshold > 0 {
+		passRate = verifyThreshold
+		minCoverage = verifyThreshold
+	}
+
+	deriver, err := newStatusDeriver(strategy, passRate, minCoverage)
+	if err != nil {
+		return nil, err
+	}
+	return deriver, nil
+}
+
+// groupTestsByFunction maps each test function to its results, including
+// subtests ("TestX/case") under their parent function name.
+func groupTestsByFunction(testResults map[string]*TestResult) map[string][]*TestResult {
+	testByFunction := make(map[string][]*TestResult)
+	for _, r := range testResults {
+		name := testFunctionName(r.Test)
+		testByFunction[name] = append(testByFunction[name], r)
+	}
+	return testByFunction
+}
+
+// testFunctionName strips any subtest path from a test name.
+func testFunctionName(test string) string {
+	if idx := strings.Index(test, "/"); idx >= 0 {
+		return test[:idx]
+	}
+	return test
+}
+
+// deriveRequirement derives a requirement's outcome from evidence, which
+// the matched tests, if any, add up to.
+func deriveRequirement(req *database.Requirement, evidence TestEvidence, matched []*TestResult, deriver StatusDeriver) VerificationResult {
+	newStatus := deriver.Derive(evidence, req.Status)
+
+	return VerificationResult{
+		ReqID:          req.ReqID,
+		TestsTotal:     evidence.Total,
+		TestsPassed:    evidence.Passed,
+		TestsFailed:    evidence.Failed,
+		TestsSkipped:   evidence.Skipped,
+		PreviousStatus: req.Status,
+		NewStatus:      newStatus,
+		Updated:        newStatus != req.Status,
+		Strategy:       deriver.Name(),
+		Tests:          matched,
+	}
+}
+
+// collectEvidence aggregates test results into evidence for a deriver.
+// Coverage is the lowest known coverage across the matched packages.
+func collectEvidence(matched []*TestResult) TestEvidence {
+	evidence := TestEvidence{Coverage: -1}
+	for _, r := range matched {
+		evidence.Total++
+		evidence.Passed += boolToInt(r.Passed)
+		evidence.Failed += boolToInt(r.Failed)
+		evidence.Skipped += boolToInt(r.Skipped)
+		if r.Coverage >= 0 && (evidence.Coverage < 0 || r.Coverage < evidence.Coverage) {
+			evidence.Coverage = r.Coverage
 		}
-		return currentStatus
 	}
+	return evidence
+}
+
+// determineNewStatus applies the default all-pass rule to a single test result.
+func determineNewStatus(result *TestResult, currentStatus database.Status) database.Status {
+	return AllPassDeriver{}.Derive(collectEvidence([]*TestResult{result}), currentStatus)
+}
 
-	if result.Passed {
-		return database.StatusComplete
+// enforceDefinitionOfDone downgrades a COMPLETE outcome to PARTIAL when
+// the requirement does not meet the definition of done.
+func enforceDefinitionOfDone(r *VerificationResult, req *database.Requirement, dod *definitionOfDone) {
+	if r.NewStatus != database.StatusComplete {
+		return
+	}
+	if unmet := dod.Unmet(req); len(unmet) > 0 {
+		r.NewStatus = database.StatusPartial
+		r.UnmetDoD = unmet
+		r.Updated = r.NewStatus != r.PreviousStatus
 	}
+}
 
-	// Skipped - keep current status
-	return currentStatus
+// printVerifyResolution prints a requirement's outcome as soon as it is known.
+func printVerifyResolution(cmd *cobra.Command, r VerificationResult) {
+	switch {
+	case r.TestsFailed > 0:
+		cmd.Printf("  %s %s  %d failed, %d passed\n",
+			output.Color("✗", output.Red), output.Color(r.ReqID, output.Cyan), r.TestsFailed, r.TestsPassed)
+	case len(r.UnmetDoD) > 0:
+		cmd.Printf("  %s %s  %d/%d passed, definition of done not met: %s\n",
+			output.Color("⚠", output.Yellow), output.Color(r.ReqID, output.Cyan), r.TestsPassed, r.TestsTotal,
+			strings.Join(r.UnmetDoD, ", "))
+	case r.TestsPassed > 0:
+		cmd.Printf("  %s %s  %d/%d passed\n",
+			output.Color("✓", output.Green), output.Color(r.ReqID, output.Cyan), r.TestsPassed, r.TestsTotal)
+	default:
+		cmd.Printf("  %s %s  skipped\n", output.Color("-", output.Yellow), output.Color(r.ReqID, output.Cyan))
+	}
 }
 
 func printVerifyResults(cmd *cobra.Command, results []VerificationResult) {
@@ -317,9 +984,11 @@ func printVerifyResults(cmd *cobra.Command, results []VerificationResult) {
 	cmd.Println(output.Header("Verification Results", width))
 	cmd.Println()
 
-	var passing, failing, toUpdate int
+	var passing, failing, undone, toUpdate int
 	for _, r := range results {
-		if r.TestsPassed > 0 && r.TestsFailed == 0 {
+		if len(r.UnmetDoD) > 0 {
+			undone++
+		} else if r.TestsPassed > 0 && r.TestsFailed == 0 {
 			passing++
 		}
 		if r.TestsFailed > 0 {
@@ -336,8 +1005,12 @@ func printVerifyResults(cmd *cobra.Command, results []VerificationResult) {
 	if failing > 0 {
 		cmd.Printf("  %s FAILING: %d requirements\n", output.Color("✗", output.Red), failing)
 	}
+	if undone > 0 {
+		cmd.Printf("  %s NOT DONE: %d requirements (definition of done)\n", output.Color("⚠", output.Yellow), undone)
+	}
 
-	if toUpdate > 0 {
+	// A dry run lists the changes in a table instead
+	if toUpdate > 0 && !verifyDryRun {
 		cmd.Println()
 		cmd.Println(output.SubHeader("Status Changes", width))
 		for _, r := range results {
@@ -361,6 +1034,150 @@ func printVerifyResults(cmd *cobra.Command, results []VerificationResult) {
 	}
 }
 
+// verifyChange is a status change verify derived, as --update would apply
+// it.
+type verifyChange struct {
+	ReqID       string   `json:"req_id"`
+	From        string   `json:"from"`
+	To          string   `json:"to"`
+	Direction   string   `json:"direction"`
+	TestsTotal  int      `json:"tests_total"`
+	TestsPassed int      `json:"tests_passed"`
+	TestsFailed int      `json:"tests_failed"`
+	UnmetDoD    []string `json:"unmet_dod,omitempty"`
+}
+
+// verifyReport is the --format json output of verify.
+type verifyReport struct {
+	DryRun       bool           `json:"dry_run"`
+	Applied      bool           `json:"applied"`
+	Verified     int            `json:"verified"`
+	Changes      []verifyChange `json:"changes"`
+	Undetermined []string       `json:"undetermined,omitempty"`
+
+	// Baseline is the comparison with --compare-baseline.
+	Baseline *DiffResult `json:"baseline_comparison,omitempty"`
+}
+
+// verifyChanges returns the status changes among results. A change towards
+// COMPLETE is a promotion and one away from it a demotion.
+func verifyChanges(results []VerificationResult) []verifyChange {
+	changes := make([]verifyChange, 0)
+	for _, r := range results {
+		if !r.Updated {
+			continue
+		}
+		direction := "demotion"
+		if r.NewStatus.Weight() < r.PreviousStatus.Weight() {
+			direction = "promotion"
+		}
+		changes = append(changes, verifyChange{
+			ReqID:       r.ReqID,
+			From:        r.PreviousStatus.String(),
+			To:          r.NewStatus.String(),
+			Direction:   direction,
+			TestsTotal:  r.TestsTotal,
+			TestsPassed: r.TestsPassed,
+			TestsFailed: r.TestsFailed,
+			UnmetDoD:    r.UnmetDoD,
+		})
+	}
+	return changes
+}
+
+// printVerifyDryRun shows the status changes --update would apply.
+func printVerifyDryRun(cmd *cobra.Command, changes []verifyChange) {
+	cmd.Println()
+	if len(changes) == 0 {
+		cmd.Println("No status changes would be made")
+	} else {
+		cmd.Println(output.SubHeader("Status Changes (dry run)", 60))
+		table := output.NewTable("Requirement", "Current", "New", "Change", "Tests")
+		for _, c := range changes {
+			newStatus := output.Color(c.To, output.Green)
+			if c.Direction == "demotion" {
+				newStatus = output.Color(c.To, output.Yellow)
+			}
+			table.AddRow(c.ReqID, c.From, newStatus, c.Direction,
+				fmt.Sprintf("%d/%d passed", c.TestsPassed, c.TestsTotal))
+		}
+		cmd.Print(table.Render())
+	}
+	cmd.Printf("\n%s\n", output.Color("Dry run - no changes made", output.Yellow))
+}
+
+// printVerifyBaseline shows the result of comparing the derived statuses
+// with the baseline at path, listing the requirements that regressed.
+func printVerifyBaseline(cmd *cobra.Command, path string, result *DiffResult) {
+	cmd.Println()
+	cmd.Println(output.SubHeader("Baseline Comparison", 60))
+	cmd.Printf("Baseline: %s\n", path)
+
+	for _, c := range result.Changed {
+		if c.Field != "status" {
+			continue
+		}
+		from, errFrom := database.ParseStatus(c.OldValue)
+		to, errTo := database.ParseStatus(c.NewValue)
+		if errFrom == nil && errTo == nil && to.CompletionPercent() < from.CompletionPercent() {
+			cmd.Printf("  %s %s: %s → %s\n", output.Color("✗", output.Red), c.ReqID, c.OldValue, output.Color(c.NewValue, output.Yellow))
+		}
+	}
+
+	summaryColor := output.Green
+	if result.Regressed > 0 {
+		summaryColor = output.Red
+	}
+	cmd.Printf("Result: %s (%d improved, %d regressed, %d added, %d removed)\n",
+		output.Color(result.Summary, summaryColor), result.Improved, result.Regressed, len(result.Added), len(result.Removed))
+}
+
+// watchedVerifyChanges returns the status changes verify derived for
+// watched requirements.
+func watchedVerifyChanges(results []VerificationResult, watched watchList) []watchedChange {
+	var changes []watchedChange
+	for _, r := range results {
+		if r.Updated && watched[r.ReqID] {
+			changes = append(changes, watchedChange{ReqID: r.ReqID, From: r.PreviousStatus.String(), To: r.NewStatus.String()})
+		}
+	}
+	return changes
+}
+
+// printWatchedChanges lists status changes to watched requirements.
+func printWatchedChanges(cmd *cobra.Command, changes []watchedChange) {
+	if len(changes) == 0 {
+		return
+	}
+	cmd.Println()
+	cmd.Println(output.SubHeader("Watched Requirements", 60))
+	for _, c := range changes {
+		cmd.Printf("  %s: %s → %s\n", watchedLabel(c.ReqID), c.From, c.To)
+	}
+}
+
+// printAutocheck reports the acceptance criteria --autocheck ticked.
+func printAutocheck(cmd *cobra.Command, results []VerificationResult) {
+	verb := "Ticked"
+	if verifyDryRun {
+		verb = "Would tick"
+	}
+	total := 0
+	for _, r := range results {
+		if r.CriteriaTicked > 0 {
+			if total == 0 {
+				cmd.Println()
+			}
+			cmd.Printf("  %s %s: %s %d acceptance criteria\n",
+				output.Color("☑", output.Green), output.Color(r.ReqID, output.Cyan), verb, r.CriteriaTicked)
+			total += r.CriteriaTicked
+		}
+	}
+	if total == 0 {
+		cmd.Println("\nNo acceptance criteria to tick")
+	}
+}
+
 func boolToInt(b bool) int {
 	if b {
 		return 1
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// verifyHistoryFile records the outcome of the last verify run, relative to
+// the project root. It lives in the gitignored cache directory.
+const verifyHistoryFile = ".rtmx/cache/verify-last.json"
+
+// verifyHistory is what verify remembers between runs.
+type verifyHistory struct {
+	Time    string   `json:"time"`
+	Failing []string `json:"failing"`
+
+	// Evidence holds the latest verification of each requirement, kept
+	// across runs that did not resolve it.
+	Evidence map[string]*verifyEvidence `json:"evidence,omitempty"`
+}
+
+// verifyEvidence is the outcome of the last run that resolved a
+// requirement.
+type verifyEvidence struct {
+	Time     string              `json:"time"`
+	Strategy string              `json:"strategy"`
+	Status   database.Status     `json:"status"`
+	Passed   int                 `json:"passed"`
+	Failed   int                 `json:"failed"`
+	Skipped  int                 `json:"skipped"`
+	Tests    []verifyTestOutcome `json:"tests,omitempty"`
+}
+
+// verifyTestOutcome is one linked test's result.
+type verifyTestOutcome struct {
+	Package string `json:"package,omitempty"`
+	Test    string `json:"test"`
+	Result  string `json:"result"`
+}
+
+// loadVerifyHistory reads the last run's history under root. It returns
+// nil without an error when no run has been recorded.
+func loadVerifyHistory(root string) (*verifyHistory, error) {
+	data, err := os.ReadFile(filepath.Join(root, verifyHistoryFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read verify history: %w", err)
+	}
+	var history verifyHistory
+	if err := json.Unmarshal(data, &history); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", verifyHistoryFile, err)
+	}
+	return &history, nil
+}
+
+// saveVerifyHistory writes the history under root.
+func saveVerifyHistory(root string, history *verifyHistory) error {
+	path := filepath.Join(root, verifyHistoryFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	data, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write verify history: %w", err)
+	}
+	return nil
+}
+
+// recordVerifyRun builds the history after a run. A complete run replaces
+// the failing set; a partial one (scoped or stopped early) only updates the
+// requirements it resolved. Evidence is updated for the resolved
+// requirements either way.
+func recordVerifyRun(previous *verifyHistory, resolved []VerificationResult, complete bool, now time.Time) *verifyHistory {
+	failing := make(map[string]bool)
+	if previous != nil && !complete {
+		for _, id := range previous.Failing {
+			failing[id] = true
+		}
+	}
+	for _, r := range resolved {
+		failing[r.ReqID] = r.TestsFailed > 0
+	}
+
+	history := &verifyHistory{
+		Time:     now.Format(time.RFC3339),
+		Failing:  []string{},
+		Evidence: make(map[string]*verifyEvidence),
+	}
+	if previous != nil {
+		for id, e := range previous.Evidence {
+			history.Evidence[id] = e
+		}
+	}
+	for _, r := range resolved {
+		history.Evidence[r.ReqID] = newVerifyEvidence(r, history.Time)
+	}
+	for id, failed := range failing {
+		if failed {
+			history.Failing = append(history.Failing, id)
+		}
+	}
+	sort.Strings(history.Failing)
+	return history
+}
+
+// newVerifyEvidence records a resolved requirement's outcome at the given time.
+func newVerifyEvidence(r VerificationResult, at string) *verifyEvidence {
+	e := &verifyEvidence{
+		Time:     at,
+		Strategy: r.Strategy,
+		Status:   r.NewStatus,
+		Passed:   r.TestsPassed,
+		Failed:   r.TestsFailed,
+		Skipped:  r.TestsSkipped,
+	}
+	for _, t := range r.Tests {
+		outcome := verifyTestOutcome{Package: t.Package, Test: t.Test, Result: "pass"}
+		switch {
+		case t.Failed:
+			outcome.Result = "fail"
+		case t.Skipped:
+			outcome.Result = "skip"
+		}
+		e.Tests = append(e.Tests, outcome)
+	}
+	return e
+}
+
+// verifyScope returns the go test arguments that run only the tests linked
+// to the given requirements: a -run pattern for their test functions and
+// the packages of their test modules. Requirements whose test module is not
+// a Go file are searched for across fallbackPath.
+func verifyScope(db *database.Database, ids []string, fallbackPath string) []string {
+	functions := make(map[string]bool)
+	packages := make(map[string]bool)
+	for _, id := range ids {
+		req := db.Get(id)
+		if req == nil || req.TestFunction == "" {
+			continue
+		}
+		functions[req.TestFunction] = true
+		if pkg := testPackage(req.TestModule); pkg != "" {
+			packages[pkg] = true
+		} else {
+			packages[fallbackPath] = true
+		}
+	}
+	if len(functions) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, regexp.QuoteMeta(name))
+	}
+	sort.Strings(names)
+	args := []string{"-run", "^(" + strings.Join(names, "|") + ")$"}
+
+	pkgs := make([]string, 0, len(packages))
+	for pkg := range packages {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+	return append(args, pkgs...)
+}
+
+// categoryScope returns the requirements of only, or of the whole
+// database when only is nil, that are in category.
+func categoryScope(db *database.Database, category string, only map[string]bool) map[string]bool {
+	scoped := make(map[string]bool)
+	for _, req := range db.All() {
+		if strings.EqualFold(req.Category, category) && (only == nil || only[req.ReqID]) {
+			scoped[req.ReqID] = true
+		}
+	}
+	return scoped
+}
+
+// verifyTargets returns the distinct test targets linked to the
+// requirements in only, or to all requirements when only is nil, sorted.
+// For go test these are the packages of Go test modules; otherwise they are
+// the test modules themselves, such as "tests/test_auth.py".
+func verifyTargets(db *database.Database, only map[string]bool, goTest bool) []string {
+	targets := make(map[string]bool)
+	for _, req := range db.All() {
+		if only != nil && !only[req.ReqID] {
+			continue
+		}
+		module := strings.TrimSpace(req.TestModule)
+		if module == "" {
+			continue
+		}
+		if goTest {
+			if pkg := testPackage(module); pkg != "" {
+				targets[pkg] = true
+			}
+		} else {
+			targets[path.Clean(filepath.ToSlash(module))] = true
+		}
+	}
+
+	sorted := make([]string, 0, len(targets))
+	for target := range targets {
+		sorted = append(sorted, target)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// testPackage returns the go package path for a requirement's test module,
+// such as "./internal/cmd" for "internal/cmd/verify_test.go", or "" when
+// the module is not a Go file.
+func testPackage(module string) string {
+	module = filepath.ToSlash(strings.TrimSpace(module))
+	if !strings.HasSuffix(module, ".go") || filepath.IsAbs(module) {
+		return ""
+	}
+	dir := path.Dir(module)
+	if dir == "." {
+		return "."
+	}
+	return "./" + strings.TrimPrefix(dir, "./")
+}
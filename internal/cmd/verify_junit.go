@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// junitTestSuites is the root of a JUnit XML report. Each requirement
+// verified is one test suite holding its linked tests.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Cases      []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+}
+
+type junitSkipped struct{}
+
+// buildJUnitReport returns the JUnit report of the verify results, with the
+// status each requirement had and was derived as recorded as properties.
+func buildJUnitReport(results []VerificationResult) junitTestSuites {
+	report := junitTestSuites{Name: "rtmx verify", Suites: []junitTestSuite{}}
+	for _, r := range results {
+		suite := junitTestSuite{
+			Name:     r.ReqID,
+			Tests:    r.TestsTotal,
+			Failures: r.TestsFailed,
+			Skipped:  r.TestsSkipped,
+			Properties: []junitProperty{
+				{Name: "previous_status", Value: string(r.PreviousStatus)},
+				{Name: "status", Value: string(r.NewStatus)},
+			},
+			Cases: []junitTestCase{},
+		}
+		for _, t := range r.Tests {
+			tc := junitTestCase{Name: t.Test, ClassName: t.Package}
+			switch {
+			case t.Failed:
+				tc.Failure = &junitFailure{Message: "test failed"}
+			case t.Skipped:
+				tc.Skipped = &junitSkipped{}
+			}
+			suite.Cases = append(suite.Cases, tc)
+		}
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Skipped += suite.Skipped
+		report.Suites = append(report.Suites, suite)
+	}
+	return report
+}
+
+// writeJUnit writes the verify results as a JUnit XML report.
+func writeJUnit(w io.Writer, results []VerificationResult) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(buildJUnitReport(results)); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// writeVerifyOutputs writes the JUnit report to junitPath and, when db is
+// not nil, the database to dbPath, as one transaction: both files are
+// staged before either is replaced, so on error neither has changed.
+func writeVerifyOutputs(junitPath string, results []VerificationResult, db *database.Database, dbPath string) error {
+	var tx database.Transaction
+	defer tx.Abort()
+
+	if err := tx.Stage(junitPath, func(w io.Writer) error {
+		return writeJUnit(w, results)
+	}); err != nil {
+		return err
+	}
+	if db != nil {
+		if err := tx.Stage(dbPath, db.WriteCSV); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
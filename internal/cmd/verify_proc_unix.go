@@ -0,0 +1,24 @@
+//go:build !windows
+
+package cmd
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup starts c in its own process group, so that cancelling
+// it kills the test binaries it spawned along with it.
+func setProcessGroup(c *exec.Cmd) {
+	c.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	c.Cancel = func() error { return killProcessGroup(c) }
+}
+
+// killProcessGroup kills c and every process in its group.
+func killProcessGroup(c *exec.Cmd) error {
+	if c.Process == nil {
+		return nil
+	}
+	// A negative PID signals the whole group
+	return syscall.Kill(-c.Process.Pid, syscall.SIGKILL)
+}
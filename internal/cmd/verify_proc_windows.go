@@ -0,0 +1,14 @@
+package cmd
+
+import "os/exec"
+
+// setProcessGroup leaves c as is; on Windows cancelling kills the process.
+func setProcessGroup(c *exec.Cmd) {}
+
+// killProcessGroup kills c.
+func killProcessGroup(c *exec.Cmd) error {
+	if c.Process == nil {
+		return nil
+	}
+	return c.Process.Kill()
+}
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/markers"
+)
+
+// requirementOutcome is one entry of a results file listing test counts
+// per requirement.
+type requirementOutcome struct {
+	ReqID   string `json:"req_id"`
+	Passed  int    `json:"passed"`
+	Failed  int    `json:"failed"`
+	Skipped int    `json:"skipped"`
+}
+
+// verifyResultsFile holds the test outcomes read by verify --results:
+// either counts per requirement, or the outcome of each named test.
+type verifyResultsFile struct {
+	ByRequirement map[string]TestEvidence
+	Tests         []*TestResult
+}
+
+// loadVerifyResults reads a results file. A JSON array lists counts per
+// requirement, [{"req_id": ..., "passed": n, "failed": n, "skipped": n}];
+// a JSON object maps test names to "pass", "fail" or "skip".
+func loadVerifyResults(path string) (*verifyResultsFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read results: %w", err)
+	}
+	data = bytes.TrimSpace(data)
+
+	results := &verifyResultsFile{}
+	switch {
+	case bytes.HasPrefix(data, []byte("[")):
+		var outcomes []requirementOutcome
+		if err := json.Unmarshal(data, &outcomes); err != nil {
+			return nil, fmt.Errorf("invalid results file %s: %w", path, err)
+		}
+		results.ByRequirement = make(map[string]TestEvidence, len(outcomes))
+		for _, o := range outcomes {
+			if o.ReqID == "" {
+				return nil, fmt.Errorf("invalid results file %s: entry without req_id", path)
+			}
+			e := results.ByRequirement[o.ReqID]
+			e.Passed += o.Passed
+			e.Failed += o.Failed
+			e.Skipped += o.Skipped
+			e.Total = e.Passed + e.Failed + e.Skipped
+			e.Coverage = -1
+			results.ByRequirement[o.ReqID] = e
+		}
+	case bytes.HasPrefix(data, []byte("{")):
+		var outcomes map[string]string
+		if err := json.Unmarshal(data, &outcomes); err != nil {
+			return nil, fmt.Errorf("invalid results file %s: %w", path, err)
+		}
+		names := make([]string, 0, len(outcomes))
+		for name := range outcomes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			outcome := outcomes[name]
+			pkg, test := splitTestName(name)
+			result := &TestResult{Package: pkg, Test: test, Coverage: -1}
+			switch strings.ToLower(strings.TrimSpace(outcome)) {
+			case "pass", "passed", "ok":
+				result.Passed = true
+			case "fail", "failed", "error":
+				result.Failed = true
+			case "skip", "skipped":
+				result.Skipped = true
+			default:
+				return nil, fmt.Errorf("invalid results file %s: unknown outcome %q for %s (use pass, fail or skip)", path, outcome, name)
+			}
+			results.Tests = append(results.Tests, result)
+		}
+	default:
+		return nil, fmt.Errorf("invalid results file %s: expected a JSON array or object", path)
+	}
+	return results, nil
+}
+
+// splitTestName splits a test name into the file or package it was
+// reported under and the test itself, e.g. a pytest node ID
+// "tests/test_auth.py::test_login" or a Go test "TestLogin/case".
+func splitTestName(name string) (pkg, test string) {
+	if idx := strings.LastIndex(name, "::"); idx >= 0 {
+		return name[:idx], name[idx+2:]
+	}
+	return "", name
+}
+
+// resultsFunction returns the function a test or test_function names,
+// without its class, subtest or pytest parameters.
+func resultsFunction(name string) string {
+	_, name = splitTestName(name)
+	if idx := strings.Index(name, "["); idx >= 0 {
+		name = name[:idx]
+	}
+	return testFunctionName(name)
+}
+
+// lookup returns the evidence for each requirement. Counts are taken as
+// given; named tests belong to a requirement when they are its
+// test_function or carry its marker in a test file, per markedBy.
+func (f *verifyResultsFile) lookup(markedBy map[string][]string) func(*database.Requirement) (TestEvidence, []*TestResult, bool) {
+	if f.ByRequirement != nil {
+		return func(req *database.Requirement) (TestEvidence, []*TestResult, bool) {
+			e, ok := f.ByRequirement[req.ReqID]
+			return e, nil, ok && e.Total > 0
+		}
+	}
+
+	byFunction := make(map[string][]*TestResult)
+	for _, r := range f.Tests {
+		fn := resultsFunction(r.Test)
+		byFunction[fn] = append(byFunction[fn], r)
+	}
+	return func(req *database.Requirement) (TestEvidence, []*TestResult, bool) {
+		var matched []*TestResult
+		seen := make(map[string]bool)
+		functions := markedBy[req.ReqID]
+		if req.TestFunction != "" {
+			functions = append([]string{resultsFunction(req.TestFunction)}, functions...)
+		}
+		for _, fn := range functions {
+			if seen[fn] {
+				continue
+			}
+			seen[fn] = true
+			matched = append(matched, byFunction[fn]...)
+		}
+		return collectEvidence(matched), matched, len(matched) > 0
+	}
+}
+
+// markedTestFunctions scans the test files under root for requirement
+// markers, mapping each requirement to the test functions marked with it.
+// It is nil when the results file has no named tests.
+func (f *verifyResultsFile) markedTestFunctions(root string, extractor *markers.Extractor) (map[string][]string, error) {
+	if f.ByRequirement != nil {
+		return nil, nil
+	}
+	found, err := scanTestDirectoryWith(root, extractor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan test markers: %w", err)
+	}
+	markedBy := make(map[string][]string)
+	for _, m := range found {
+		if m.TestFunction != "" {
+			markedBy[m.ReqID] = append(markedBy[m.ReqID], resultsFunction(m.TestFunction))
+		}
+	}
+	return markedBy, nil
+}
+
+// unknownRequirements lists the requirement IDs in a results file
+// that are not in db.
+func (f *verifyResultsFile) unknownRequirements(db *database.Database) []string {
+	var unknown []string
+	for id := range f.ByRequirement {
+		if !db.Exists(id) {
+			unknown = append(unknown, id)
+		}
+	}
+	database.SortReqIDs(unknown)
+	return unknown
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// TestEvidence summarizes the test outcomes linked to a single requirement.
+type TestEvidence struct {
+	Total   int
+	Passed  int
+	Failed  int
+	Skipped int
+
+	// Coverage is the statement coverage percentage of the package(s)
+	// exercising the requirement, or a negative value when unknown.
+	Coverage float64
+}
+
+// PassRate returns the percentage of executed (non-skipped) tests that passed.
+func (e TestEvidence) PassRate() float64 {
+	executed := e.Passed + e.Failed
+	if executed == 0 {
+		return 0
+	}
+	return float64(e.Passed) / float64(executed) * 100
+}
+
+// StatusDeriver decides the new status of a requirement from its test evidence.
+// Implementations encode an organisation's closed-loop rule.
+type StatusDeriver interface {
+	// Name returns the strategy name used by --strategy and config.
+	Name() string
+
+	// Derive returns the status the requirement should move to.
+	Derive(evidence TestEvidence, current database.Status) database.Status
+}
+
+// AllPassDeriver marks a requirement COMPLETE when every linked test passes
+// and downgrades COMPLETE to PARTIAL when any test fails.
+type AllPassDeriver struct{}
+
+// Name returns the strategy name.
+func (AllPassDeriver) Name() string { return "all-pass" }
+
+// Derive implements StatusDeriver.
+func (AllPassDeriver) Derive(e TestEvidence, current database.Status) database.Status {
+	if e.Failed > 0 {
+		return downgradeOnFailure(current)
+	}
+	if e.Passed > 0 {
+		return database.StatusComplete
+	}
+	// Skipped only - keep current status
+	return current
+}
+
+// PassRateDeriver marks a requirement COMPLETE when the pass rate reaches
+// Threshold percent, and PARTIAL when some but not enough tests pass.
+type PassRateDeriver struct {
+	Threshold float64
+}
+
+// Name returns the strategy name.
+func (PassRateDeriver) Name() string { return "pass-rate" }
+
+// Derive implements StatusDeriver.
+func (d PassRateDeriver) Derive(e TestEvidence, current database.Status) database.Status {
+	if e.Passed+e.Failed == 0 {
+		return current
+	}
+	if e.PassRate() >= d.Threshold {
+		return database.StatusComplete
+	}
+	if e.Passed > 0 {
+		return database.StatusPartial
+	}
+	return downgradeOnFailure(current)
+}
+
+// CoverageDeriver requires every linked test to pass and the package
+// coverage to reach MinCoverage percent before a requirement is COMPLETE.
+// Passing tests with insufficient or unknown coverage yield PARTIAL.
+type CoverageDeriver struct {
+	MinCoverage float64
+}
+
+// Name returns the strategy name.
+func (CoverageDeriver) Name() string { return "coverage" }
+
+// Derive implements StatusDeriver.
+func (d CoverageDeriver) Derive(e TestEvidence, current database.Status) database.Status {
+	if e.Failed > 0 {
+		return downgradeOnFailure(current)
+	}
+	if e.Passed == 0 {
+		return current
+	}
+	if e.Coverage >= 0 && e.Coverage >= d.MinCoverage {
+		return database.StatusComplete
+	}
+	return database.StatusPartial
+}
+
+// downgradeOnFailure downgrades COMPLETE to PARTIAL and keeps other statuses.
+func downgradeOnFailure(current database.Status) database.Status {
+	if current == database.StatusComplete {
+		return database.StatusPartial
+	}
+	return current
+}
+
+// newStatusDeriver returns the deriver for a strategy name.
+func newStatusDeriver(name string, passRate, minCoverage float64) (StatusDeriver, error) {
+	switch strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), "_", "-") {
+	case "", "all-pass", "allpass":
+		return AllPassDeriver{}, nil
+	case "pass-rate", "passrate":
+		return PassRateDeriver{Threshold: passRate}, nil
+	case "coverage":
+		return CoverageDeriver{MinCoverage: minCoverage}, nil
+	default:
+		return nil, fmt.Errorf("unknown verify strategy %q (valid: all-pass, pass-rate, coverage)", name)
+	}
+}
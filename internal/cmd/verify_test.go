@@ -2,8 +2,14 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rtmx-ai/rtmx-go/internal/database"
 )
@@ -85,3 +91,960 @@ func TestBoolToInt(t *testing.T) {
 		t.Error("boolToInt(false) should be 0")
 	}
 }
+
+func TestStatusDeriverStrategies(t *testing.T) {
+	// 9 of 10 linked tests pass in a package with 70% coverage
+	evidence := TestEvidence{Total: 10, Passed: 9, Failed: 1, Coverage: 70}
+	allGreen := TestEvidence{Total: 10, Passed: 10, Coverage: 70}
+
+	tests := []struct {
+		name     string
+		deriver  StatusDeriver
+		evidence TestEvidence
+		current  database.Status
+		expected database.Status
+	}{
+		{"all-pass with failure stays missing", AllPassDeriver{}, evidence, database.StatusMissing, database.StatusMissing},
+		{"all-pass with failure downgrades complete", AllPassDeriver{}, evidence, database.StatusComplete, database.StatusPartial},
+		{"all-pass all green completes", AllPassDeriver{}, allGreen, database.StatusMissing, database.StatusComplete},
+		{"pass-rate above threshold completes", PassRateDeriver{Threshold: 80}, evidence, database.StatusMissing, database.StatusComplete},
+		{"pass-rate below threshold is partial", PassRateDeriver{Threshold: 95}, evidence, database.StatusMissing, database.StatusPartial},
+		{"coverage with failure stays missing", CoverageDeriver{MinCoverage: 60}, evidence, database.StatusMissing, database.StatusMissing},
+		{"coverage met completes", CoverageDeriver{MinCoverage: 60}, allGreen, database.StatusMissing, database.StatusComplete},
+		{"coverage not met is partial", CoverageDeriver{MinCoverage: 80}, allGreen, database.StatusMissing, database.StatusPartial},
+		{"coverage unknown is partial", CoverageDeriver{MinCoverage: 0}, TestEvidence{Total: 1, Passed: 1, Coverage: -1}, database.StatusMissing, database.StatusPartial},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.deriver.Derive(tt.evidence, tt.current)
+			if got != tt.expected {
+				t.Errorf("%s.Derive() = %v, want %v", tt.deriver.Name(), got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestVerifyStreamWithStrategy(t *testing.T) {
+	db := database.NewDatabase()
+	req := database.NewRequirement("REQ-TEST-001")
+	req.TestModule = "pkg/feature_test.go"
+	req.TestFunction = "TestFeature"
+	_ = db.Add(req)
+
+	events := []TestEvent{
+		{Action: "fail", Package: "pkg", Test: "TestFeature/c"},
+		{Action: "pass", Package: "pkg", Test: "TestFeature/a"},
+		{Action: "pass", Package: "pkg", Test: "TestFeature/b"},
+		{Action: "fail", Package: "pkg", Test: "TestFeature"},
+		{Action: "pass", Package: "pkg", Test: "TestOther"},
+		{Action: "output", Package: "pkg", Output: "coverage: 90.0% of statements\n"},
+		{Action: "fail", Package: "pkg"},
+	}
+
+	strategies := []struct {
+		name     string
+		expected database.Status
+	}{
+		{"all-pass", database.StatusMissing},
+		{"pass-rate", database.StatusPartial},
+		{"coverage", database.StatusMissing},
+	}
+
+	for _, tt := range strategies {
+		t.Run(tt.name, func(t *testing.T) {
+			deriver, err := newStatusDeriver(tt.name, 80, 80)
+			if err != nil {
+				t.Fatalf("newStatusDeriver(%q) failed: %v", tt.name, err)
+			}
+			stream := newVerifyStream(db, deriver, false)
+			for _, event := range events {
+				stream.handle(event)
+			}
+			stream.finish()
+
+			got := stream.Resolved
+			if len(got) != 1 {
+				t.Fatalf("Expected 1 verification result, got %d", len(got))
+			}
+			if got[0].TestsTotal != 4 {
+				t.Errorf("TestsTotal = %d, want 4", got[0].TestsTotal)
+			}
+			if got[0].NewStatus != tt.expected {
+				t.Errorf("NewStatus = %v, want %v", got[0].NewStatus, tt.expected)
+			}
+		})
+	}
+
+	if _, err := newStatusDeriver("manual-signoff", 0, 0); err == nil {
+		t.Error("Expected error for unknown strategy")
+	}
+}
+
+func TestParseCoverage(t *testing.T) {
+	pct, ok := parseCoverage("ok  \tpkg\t0.01s\tcoverage: 85.5% of statements\n")
+	if !ok || pct != 85.5 {
+		t.Errorf("parseCoverage() = %v, %v; want 85.5, true", pct, ok)
+	}
+	if _, ok := parseCoverage("PASS\n"); ok {
+		t.Error("Expected no coverage in plain output")
+	}
+}
+
+// syntheticTestEvents is go test -json output for three packages, where
+// the second package fails.
+const syntheticTestEvents = `{"Action":"run","Package":"example/a","Test":"TestAlpha"}
+{"Action":"pass","Package":"example/a","Test":"TestAlpha"}
+{"Action":"output","Package":"example/a","Output":"coverage: 90.0% of statements\n"}
+{"Action":"pass","Package":"example/a"}
+{"Action":"run","Package":"example/b","Test":"TestBeta"}
+{"Action":"run","Package":"example/b","Test":"TestBeta/case"}
+{"Action":"fail","Package":"example/b","Test":"TestBeta/case"}
+{"Action":"fail","Package":"example/b","Test":"TestBeta"}
+{"Action":"fail","Package":"example/b"}
+{"Action":"run","Package":"example/c","Test":"TestGamma"}
+{"Action":"pass","Package":"example/c","Test":"TestGamma"}
+{"Action":"pass","Package":"example/c"}
+`
+
+func verifyStreamTestDB() *database.Database {
+	db := database.NewDatabase()
+	for _, r := range []struct {
+		id, module, test string
+		status           database.Status
+	}{
+		{"REQ-VER-001", "example/a/a_test.go", "TestAlpha", database.StatusMissing},
+		{"REQ-VER-002", "example/b/b_test.go", "TestBeta", database.StatusComplete},
+		{"REQ-VER-003", "example/c/c_test.go", "TestGamma", database.StatusMissing},
+	} {
+		req := database.NewRequirement(r.id)
+		req.TestModule = r.module
+		req.TestFunction = r.test
+		req.Status = r.status
+		_ = db.Add(req)
+	}
+	return db
+}
+
+func TestVerifyStreamFailFast(t *testing.T) {
+	tests := []struct {
+		name        string
+		failFast    bool
+		wantAborted bool
+		wantOrder   []string
+	}{
+		{"fail fast", true, true, []string{"REQ-VER-001", "REQ-VER-002"}},
+		{"full run", false, false, []string{"REQ-VER-001", "REQ-VER-002", "REQ-VER-003"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := verifyStreamTestDB()
+			deriver, _ := newStatusDeriver("all-pass", 0, 0)
+
+			var live []string
+			stream := newVerifyStream(db, deriver, tt.failFast)
+			stream.onResolve = func(r VerificationResult) { live = append(live, r.ReqID) }
+
+			cmd := newTestRootCmd()
+			cmd.SetOut(new(bytes.Buffer))
+			completed := consumeTestOutput(cmd, strings.NewReader(syntheticTestEvents), stream)
+			if completed {
+				stream.finish()
+			}
+
+			if stream.Aborted != tt.wantAborted || completed == tt.wantAborted {
+				t.Errorf("Aborted = %v, completed = %v; want aborted %v", stream.Aborted, completed, tt.wantAborted)
+			}
+			if strings.Join(live, ",") != strings.Join(tt.wantOrder, ",") {
+				t.Errorf("resolved live = %v, want %v", live, tt.wantOrder)
+			}
+			if len(stream.Resolved) != len(tt.wantOrder) {
+				t.Fatalf("Resolved = %d results, want %d", len(stream.Resolved), len(tt.wantOrder))
+			}
+
+			// Statuses determined before the abort are still applied
+			applyVerifyResults(db, stream.Resolved)
+			if got := db.Get("REQ-VER-001").Status; got != database.StatusComplete {
+				t.Errorf("REQ-VER-001 status = %v, want COMPLETE", got)
+			}
+			if got := db.Get("REQ-VER-002").Status; got != database.StatusPartial {
+				t.Errorf("REQ-VER-002 status = %v, want PARTIAL", got)
+			}
+			wantGamma := database.StatusComplete
+			if tt.failFast {
+				wantGamma = database.StatusMissing
+			}
+			if got := db.Get("REQ-VER-003").Status; got != wantGamma {
+				t.Errorf("REQ-VER-003 status = %v, want %v", got, wantGamma)
+			}
+		})
+	}
+}
+
+func TestVerifyStreamWaitsForSameNamedTests(t *testing.T) {
+	// TestAlpha passes in example/a and fails in example/z, which finishes later
+	events := `{"Action":"pass","Package":"example/a","Test":"TestAlpha"}
+{"Action":"pass","Package":"example/a"}
+{"Action":"fail","Package":"example/z","Test":"TestAlpha"}
+{"Action":"fail","Package":"example/z"}
+`
+	db := database.NewDatabase()
+	unpinned := database.NewRequirement("REQ-VER-010")
+	unpinned.TestFunction = "TestAlpha"
+	pinned := database.NewRequirement("REQ-VER-011")
+	pinned.TestModule = "example/a/a_test.go"
+	pinned.TestFunction = "TestAlpha"
+	_ = db.Add(unpinned)
+	_ = db.Add(pinned)
+
+	deriver, _ := newStatusDeriver("all-pass", 0, 0)
+	stream := newVerifyStream(db, deriver, false)
+	var live []string
+	stream.onResolve = func(r VerificationResult) { live = append(live, r.ReqID) }
+
+	cmd := newTestRootCmd()
+	cmd.SetOut(new(bytes.Buffer))
+	consumeTestOutput(cmd, strings.NewReader(events), stream)
+	if strings.Join(live, ",") != "REQ-VER-011" {
+		t.Errorf("resolved before the end = %v, want only the requirement pinned to example/a", live)
+	}
+	stream.finish()
+
+	byID := make(map[string]VerificationResult)
+	for _, r := range stream.Resolved {
+		byID[r.ReqID] = r
+	}
+	if r := byID["REQ-VER-010"]; r.TestsTotal != 2 || r.TestsFailed != 1 || r.NewStatus == database.StatusComplete {
+		t.Errorf("REQ-VER-010 = %d tests, %d failed, %s; want the later failure counted", r.TestsTotal, r.TestsFailed, r.NewStatus)
+	}
+	if r := byID["REQ-VER-011"]; r.TestsTotal != 1 || r.NewStatus != database.StatusComplete {
+		t.Errorf("REQ-VER-011 = %d tests, %s; want only its own package's passing test", r.TestsTotal, r.NewStatus)
+	}
+}
+
+func TestVerifyStreamCoverageAtPackageEnd(t *testing.T) {
+	db := verifyStreamTestDB()
+	deriver, _ := newStatusDeriver("coverage", 0, 80)
+	stream := newVerifyStream(db, deriver, false)
+
+	cmd := newTestRootCmd()
+	cmd.SetOut(new(bytes.Buffer))
+	consumeTestOutput(cmd, strings.NewReader(syntheticTestEvents), stream)
+	stream.finish()
+
+	if len(stream.Resolved) == 0 || stream.Resolved[0].ReqID != "REQ-VER-001" {
+		t.Fatalf("expected REQ-VER-001 resolved first, got %+v", stream.Resolved)
+	}
+	if stream.Resolved[0].NewStatus != database.StatusComplete {
+		t.Errorf("REQ-VER-001 = %v, want COMPLETE with 90%% coverage", stream.Resolved[0].NewStatus)
+	}
+}
+
+func TestVerifyStreamDefinitionOfDone(t *testing.T) {
+	db := verifyStreamTestDB()
+	deriver, _ := newStatusDeriver("all-pass", 0, 0)
+	dod, err := newDefinitionOfDone([]string{"spec_file"}, t.TempDir())
+	if err != nil {
+		t.Fatalf("newDefinitionOfDone failed: %v", err)
+	}
+
+	stream := newVerifyStream(db, deriver, false)
+	stream.dod = dod
+	cmd := newTestRootCmd()
+	cmd.SetOut(new(bytes.Buffer))
+	consumeTestOutput(cmd, strings.NewReader(syntheticTestEvents), stream)
+	stream.finish()
+
+	// REQ-VER-001 passes its tests but has no spec file
+	r := stream.Resolved[0]
+	if r.ReqID != "REQ-VER-001" || r.NewStatus != database.StatusPartial {
+		t.Errorf("REQ-VER-001 = %s, want PARTIAL", r.NewStatus)
+	}
+	if strings.Join(r.UnmetDoD, ",") != "spec_file" {
+		t.Errorf("UnmetDoD = %v, want [spec_file]", r.UnmetDoD)
+	}
+	if !r.Updated {
+		t.Error("MISSING → PARTIAL should be an update")
+	}
+}
+
+func TestVerifyOnlyFailing(t *testing.T) {
+	root := t.TempDir()
+	db := verifyStreamTestDB()
+	db.Get("REQ-VER-001").TestModule = "example/a/a_test.go"
+	db.Get("REQ-VER-002").TestModule = "example/b/b_test.go"
+	db.Get("REQ-VER-003").TestModule = "example/c/c_test.go"
+
+	// Without a prior run there is nothing to scope to
+	history, err := loadVerifyHistory(root)
+	if err != nil || history != nil {
+		t.Fatalf("loadVerifyHistory on a fresh project = %v, %v; want nil, nil", history, err)
+	}
+
+	// A prior run marked two requirements failing
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	prior := recordVerifyRun(nil, []VerificationResult{
+		{ReqID: "REQ-VER-001", TestsPassed: 1},
+		{ReqID: "REQ-VER-002", TestsFailed: 1},
+		{ReqID: "REQ-VER-003", TestsFailed: 2},
+	}, true, now)
+	if err := saveVerifyHistory(root, prior); err != nil {
+		t.Fatalf("saveVerifyHistory failed: %v", err)
+	}
+	history, err = loadVerifyHistory(root)
+	if err != nil {
+		t.Fatalf("loadVerifyHistory failed: %v", err)
+	}
+	if strings.Join(history.Failing, ",") != "REQ-VER-002,REQ-VER-003" {
+		t.Fatalf("Failing = %v, want REQ-VER-002 and REQ-VER-003", history.Failing)
+	}
+
+	// The test command is scoped to their functions and packages
+	args := verifyScope(db, history.Failing, "./...")
+	want := []string{"-run", "^(TestBeta|TestGamma)$", "./example/b", "./example/c"}
+	if strings.Join(args, " ") != strings.Join(want, " ") {
+		t.Errorf("verifyScope = %v, want %v", args, want)
+	}
+
+	// Only those requirements are resolved, even if other tests report
+	deriver, _ := newStatusDeriver("all-pass", 0, 0)
+	stream := newVerifyStream(db, deriver, false)
+	stream.only = map[string]bool{"REQ-VER-002": true, "REQ-VER-003": true}
+	cmd := newTestRootCmd()
+	cmd.SetOut(new(bytes.Buffer))
+	consumeTestOutput(cmd, strings.NewReader(syntheticTestEvents), stream)
+	stream.finish()
+
+	var resolved []string
+	for _, r := range stream.Resolved {
+		resolved = append(resolved, r.ReqID)
+	}
+	if strings.Join(resolved, ",") != "REQ-VER-002,REQ-VER-003" {
+		t.Errorf("resolved = %v, want only the previously failing requirements", resolved)
+	}
+
+	// A scoped run keeps failures it did not re-check and drops fixed ones
+	next := recordVerifyRun(history, stream.Resolved[1:], false, now)
+	if strings.Join(next.Failing, ",") != "REQ-VER-002" {
+		t.Errorf("Failing after scoped run = %v, want [REQ-VER-002]", next.Failing)
+	}
+}
+
+func TestTestPackage(t *testing.T) {
+	tests := []struct {
+		module string
+		want   string
+	}{
+		{"internal/cmd/verify_test.go", "./internal/cmd"},
+		{"./pkg/rtmx/rtmx_test.go", "./pkg/rtmx"},
+		{"main_test.go", "."},
+		{"Makefile", ""},
+		{"tests/test_auth.py", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := testPackage(tt.module); got != tt.want {
+			t.Errorf("testPackage(%q) = %q, want %q", tt.module, got, tt.want)
+		}
+	}
+}
+
+func TestVerifyStreamAutocheck(t *testing.T) {
+	root := t.TempDir()
+	db := verifyStreamTestDB()
+	spec := "# Spec\n\n## Acceptance Criteria\n- [x] Parses input\n- [ ] Handles errors\n\n## Notes\n- [ ] Follow-up\n"
+	for _, id := range []string{"REQ-VER-001", "REQ-VER-002"} {
+		db.Get(id).RequirementFile = id + ".md"
+		if err := os.WriteFile(filepath.Join(root, id+".md"), []byte(spec), 0644); err != nil {
+			t.Fatalf("Failed to write spec: %v", err)
+		}
+	}
+
+	deriver, _ := newStatusDeriver("all-pass", 0, 0)
+	dod, err := newDefinitionOfDone([]string{"acceptance_criteria"}, root)
+	if err != nil {
+		t.Fatalf("newDefinitionOfDone failed: %v", err)
+	}
+	stream := newVerifyStream(db, deriver, false)
+	stream.dod = dod
+	stream.autocheck = func(req *database.Requirement) int {
+		ticked, err := autocheckSpec(root, req, true)
+		if err != nil {
+			t.Errorf("autocheckSpec(%s) failed: %v", req.ReqID, err)
+		}
+		return ticked
+	}
+	cmd := newTestRootCmd()
+	cmd.SetOut(new(bytes.Buffer))
+	consumeTestOutput(cmd, strings.NewReader(syntheticTestEvents), stream)
+	stream.finish()
+
+	// REQ-VER-001 passes, so its criteria are ticked before the definition
+	// of done is checked
+	r := stream.Resolved[0]
+	if r.ReqID != "REQ-VER-001" || r.CriteriaTicked != 1 || r.NewStatus != database.StatusComplete {
+		t.Errorf("REQ-VER-001 = %+v, want 1 criterion ticked and COMPLETE", r)
+	}
+	content, _ := os.ReadFile(filepath.Join(root, "REQ-VER-001.md"))
+	want := "# Spec\n\n## Acceptance Criteria\n- [x] Parses input\n- [x] Handles errors\n\n## Notes\n- [ ] Follow-up\n"
+	if string(content) != want {
+		t.Errorf("spec after autocheck =\n%s\nwant\n%s", content, want)
+	}
+
+	// REQ-VER-002 fails, so its spec is left alone
+	content, _ = os.ReadFile(filepath.Join(root, "REQ-VER-002.md"))
+	if string(content) != spec {
+		t.Errorf("failing requirement's spec changed:\n%s", content)
+	}
+	if stream.Resolved[1].CriteriaTicked != 0 {
+		t.Errorf("REQ-VER-002 CriteriaTicked = %d, want 0", stream.Resolved[1].CriteriaTicked)
+	}
+}
+
+func TestVerifyTimeoutKillsTestCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the test command")
+	}
+	origCommand, origTimeout, origUpdate := verifyCommand, verifyTimeout, verifyUpdate
+	defer func() {
+		verifyCommand, verifyTimeout, verifyUpdate = origCommand, origTimeout, origUpdate
+	}()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	if err := verifyStreamTestDB().Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	// example/a finishes; TestGamma passes but its package hangs
+	script := "#!/bin/sh\n" +
+		"echo '{\"Action\":\"pass\",\"Package\":\"example/a\",\"Test\":\"TestAlpha\"}'\n" +
+		"echo '{\"Action\":\"pass\",\"Package\":\"example/a\"}'\n" +
+		"echo '{\"Action\":\"pass\",\"Package\":\"example/c\",\"Test\":\"TestGamma\"}'\n" +
+		"sleep 30\n"
+	scriptPath := filepath.Join(tmpDir, "hang.sh")
+	if err := os.WriteFile(scriptPath, []byte(script), 0755); err != nil {
+		t.Fatalf("Failed to write script: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	verifyCommand = scriptPath
+	verifyTimeout = 300 * time.Millisecond
+	verifyUpdate = true
+
+	var buf bytes.Buffer
+	verifyCmd.SetOut(&buf)
+	defer verifyCmd.SetOut(nil)
+
+	start := time.Now()
+	err := runVerify(verifyCmd, nil)
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("verify took %s; the test command was not killed at the timeout", elapsed)
+	}
+	var exitErr *ExitError
+	if !errors.As(err, &exitErr) || exitErr.Code != 1 {
+		t.Errorf("Expected exit code 1 after a timeout, got %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "timed out after 300ms") || !strings.Contains(out, "? REQ-VER-003") {
+		t.Errorf("Expected the timeout and undetermined requirements to be reported:\n%s", out)
+	}
+
+	db, err := database.Load(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	if got := db.Get("REQ-VER-003").Status; got != database.StatusMissing {
+		t.Errorf("REQ-VER-003 status = %s; a requirement with unfinished tests must not be promoted", got)
+	}
+	if got := db.Get("REQ-VER-001").Status; got != database.StatusComplete {
+		t.Errorf("REQ-VER-001 status = %s; its package finished before the timeout", got)
+	}
+}
+
+func TestVerifyDryRunReportsChanges(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the test command")
+	}
+	origCommand, origDryRun, origUpdate, origFormat := verifyCommand, verifyDryRun, verifyUpdate, verifyFormat
+	defer func() {
+		verifyCommand, verifyDryRun, verifyUpdate, verifyFormat = origCommand, origDryRun, origUpdate, origFormat
+	}()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	if err := verifyStreamTestDB().Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+	before, _ := os.ReadFile(dbPath)
+
+	eventsPath := filepath.Join(tmpDir, "events.json")
+	if err := os.WriteFile(eventsPath, []byte(syntheticTestEvents), 0644); err != nil {
+		t.Fatalf("Failed to write events: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	verifyCommand = "cat " + eventsPath
+	verifyDryRun, verifyUpdate = true, true
+
+	for _, format := range []string{"terminal", "json"} {
+		t.Run(format, func(t *testing.T) {
+			verifyFormat = format
+			var buf bytes.Buffer
+			verifyCmd.SetOut(&buf)
+			defer verifyCmd.SetOut(nil)
+
+			var exitErr *ExitError
+			if err := runVerify(verifyCmd, nil); !errors.As(err, &exitErr) {
+				t.Fatalf("Expected an exit error for the failing test, got %v", err)
+			}
+			out := buf.String()
+
+			if format == "json" {
+				var report verifyReport
+				if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+					t.Fatalf("Expected only JSON output, got %v:\n%s", err, out)
+				}
+				if !report.DryRun || report.Applied || report.Verified != 3 {
+					t.Errorf("report = %+v, want an unapplied dry run over 3 requirements", report)
+				}
+				want := []verifyChange{
+					{ReqID: "REQ-VER-001", From: "MISSING", To: "COMPLETE", Direction: "promotion", TestsTotal: 1, TestsPassed: 1},
+					{ReqID: "REQ-VER-002", From: "COMPLETE", To: "PARTIAL", Direction: "demotion", TestsTotal: 2, TestsFailed: 2},
+					{ReqID: "REQ-VER-003", From: "MISSING", To: "COMPLETE", Direction: "promotion", TestsTotal: 1, TestsPassed: 1},
+				}
+				if len(report.Changes) != len(want) {
+					t.Fatalf("Changes = %+v, want %+v", report.Changes, want)
+				}
+				for i, c := range report.Changes {
+					if c.ReqID != want[i].ReqID || c.From != want[i].From || c.To != want[i].To ||
+						c.Direction != want[i].Direction || c.TestsTotal != want[i].TestsTotal ||
+						c.TestsPassed != want[i].TestsPassed || c.TestsFailed != want[i].TestsFailed {
+						t.Errorf("Changes[%d] = %+v, want %+v", i, c, want[i])
+					}
+				}
+			} else {
+				for _, want := range []string{
+					"Status Changes (dry run)",
+					"| REQ-VER-001 | MISSING  | COMPLETE | promotion | 1/1 passed |",
+					"| REQ-VER-002 | COMPLETE | PARTIAL  | demotion  | 0/2 passed |",
+					"Dry run - no changes made",
+				} {
+					if !strings.Contains(out, want) {
+						t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+					}
+				}
+				if strings.Contains(out, "Updated") {
+					t.Errorf("Expected no update in a dry run, got:\n%s", out)
+				}
+			}
+
+			after, _ := os.ReadFile(dbPath)
+			if !bytes.Equal(before, after) {
+				t.Error("Expected a dry run to leave the database untouched")
+			}
+			if _, err := os.Stat(filepath.Join(tmpDir, verifyHistoryFile)); !os.IsNotExist(err) {
+				t.Errorf("Expected a dry run not to record the run history, got %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyCompareBaseline(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the test command")
+	}
+	origCommand, origUpdate, origFormat := verifyCommand, verifyUpdate, verifyFormat
+	origBaseline, origFailRegr := verifyBaseline, verifyFailRegr
+	defer func() {
+		verifyCommand, verifyUpdate, verifyFormat = origCommand, origUpdate, origFormat
+		verifyBaseline, verifyFailRegr = origBaseline, origFailRegr
+	}()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	eventsPath := filepath.Join(tmpDir, "events.json")
+	if err := os.WriteFile(eventsPath, []byte(syntheticTestEvents), 0644); err != nil {
+		t.Fatalf("Failed to write events: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	verifyCommand = "cat " + eventsPath
+	verifyFormat, verifyFailRegr = "terminal", true
+
+	// saveBaseline writes the test database as both the baseline and the
+	// current RTM, with REQ-VER-002 (whose test fails) at status.
+	saveBaseline := func(t *testing.T, status database.Status) string {
+		db := verifyStreamTestDB()
+		db.Get("REQ-VER-002").Status = status
+		path := filepath.Join(tmpDir, "baseline.csv")
+		if err := db.Save(path); err != nil {
+			t.Fatalf("Failed to save baseline: %v", err)
+		}
+		if err := db.Save(dbPath); err != nil {
+			t.Fatalf("Failed to save database: %v", err)
+		}
+		return path
+	}
+
+	t.Run("regression fails", func(t *testing.T) {
+		verifyBaseline, verifyUpdate = saveBaseline(t, database.StatusComplete), false
+		before, _ := os.ReadFile(dbPath)
+
+		var buf bytes.Buffer
+		verifyCmd.SetOut(&buf)
+		defer verifyCmd.SetOut(nil)
+
+		var exitErr *ExitError
+		if err := runVerify(verifyCmd, nil); !errors.As(err, &exitErr) || exitErr.Code != 1 {
+			t.Fatalf("Expected exit code 1 for the regression, got %v", err)
+		}
+		out := buf.String()
+		for _, want := range []string{"Baseline Comparison", "REQ-VER-002: COMPLETE → PARTIAL", "REGRESSED"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+			}
+		}
+		after, _ := os.ReadFile(dbPath)
+		if !bytes.Equal(before, after) {
+			t.Error("Expected the database untouched without --update")
+		}
+	})
+
+	t.Run("failure without regression passes", func(t *testing.T) {
+		verifyBaseline, verifyUpdate = saveBaseline(t, database.StatusPartial), false
+
+		verifyCmd.SetOut(new(bytes.Buffer))
+		defer verifyCmd.SetOut(nil)
+		if err := runVerify(verifyCmd, nil); err != nil {
+			t.Fatalf("Expected no error when nothing regressed, got %v", err)
+		}
+	})
+
+	t.Run("update persists", func(t *testing.T) {
+		verifyBaseline, verifyUpdate = saveBaseline(t, database.StatusComplete), true
+
+		verifyCmd.SetOut(new(bytes.Buffer))
+		defer verifyCmd.SetOut(nil)
+		var exitErr *ExitError
+		if err := runVerify(verifyCmd, nil); !errors.As(err, &exitErr) {
+			t.Fatalf("Expected an exit error for the regression, got %v", err)
+		}
+		db, err := database.Load(dbPath)
+		if err != nil {
+			t.Fatalf("Failed to load database: %v", err)
+		}
+		if got := db.Get("REQ-VER-002").Status; got != database.StatusPartial {
+			t.Errorf("REQ-VER-002 status = %s, want PARTIAL saved with --update", got)
+		}
+	})
+}
+
+func TestVerifyPackagesFromRTM(t *testing.T) {
+	db := database.NewDatabase()
+	for _, r := range []struct{ id, category, module string }{
+		{"REQ-AUTH-001", "AUTH", "internal/auth/login_test.go"},
+		{"REQ-AUTH-002", "AUTH", "internal/auth/session_test.go"},
+		{"REQ-AUTH-003", "AUTH", "tests/test_auth.py"},
+		{"REQ-API-001", "API", "./internal/api/api_test.go"},
+		{"REQ-API-002", "API", "tests/test_api.py"},
+		{"REQ-DOC-001", "DOC", ""},
+	} {
+		req := database.NewRequirement(r.id)
+		req.Category = r.category
+		req.TestModule = r.module
+		req.TestFunction = "TestSomething"
+		_ = db.Add(req)
+	}
+
+	tests := []struct {
+		name     string
+		category string
+		goTest   bool
+		want     []string
+	}{
+		{"go packages", "", true, []string{"./internal/api", "./internal/auth"}},
+		{"custom command modules", "", false, []string{"internal/api/api_test.go", "internal/auth/login_test.go",
+			"internal/auth/session_test.go", "tests/test_api.py", "tests/test_auth.py"}},
+		{"go packages by category", "auth", true, []string{"./internal/auth"}},
+		{"custom command modules by category", "API", false, []string{"internal/api/api_test.go", "tests/test_api.py"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var only map[string]bool
+			if tt.category != "" {
+				only = categoryScope(db, tt.category, nil)
+			}
+			got := verifyTargets(db, only, tt.goTest)
+			if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("verifyTargets = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the test command")
+	}
+	t.Run("targets are appended to a custom command", func(t *testing.T) {
+		origCommand, origFromRTM, origCategory := verifyCommand, verifyFromRTM, verifyCategory
+		defer func() { verifyCommand, verifyFromRTM, verifyCategory = origCommand, origFromRTM, origCategory }()
+
+		tmpDir := t.TempDir()
+		if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+			t.Fatalf("Failed to create .rtmx dir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+			t.Fatalf("Failed to write config: %v", err)
+		}
+		if err := db.Save(filepath.Join(tmpDir, ".rtmx", "database.csv")); err != nil {
+			t.Fatalf("Failed to save database: %v", err)
+		}
+		scriptPath := filepath.Join(tmpDir, "record.sh")
+		if err := os.WriteFile(scriptPath, []byte("#!/bin/sh\necho \"$@\" > args.txt\n"), 0755); err != nil {
+			t.Fatalf("Failed to write script: %v", err)
+		}
+
+		oldWd, _ := os.Getwd()
+		_ = os.Chdir(tmpDir)
+		defer func() { _ = os.Chdir(oldWd) }()
+
+		verifyCommand = scriptPath + " -v"
+		verifyFromRTM, verifyCategory = true, "AUTH"
+		verifyCmd.SetOut(new(bytes.Buffer))
+		defer verifyCmd.SetOut(nil)
+		if err := runVerify(verifyCmd, nil); err != nil {
+			t.Fatalf("verify failed: %v", err)
+		}
+
+		args, err := os.ReadFile(filepath.Join(tmpDir, "args.txt"))
+		if err != nil {
+			t.Fatalf("Test command did not run: %v", err)
+		}
+		want := "-v internal/auth/login_test.go internal/auth/session_test.go tests/test_auth.py"
+		if got := strings.TrimSpace(string(args)); got != want {
+			t.Errorf("test command arguments = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestVerifyJUnitAndUpdateIsAllOrNothing(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as the test command")
+	}
+	origCommand, origFormat, origJUnitUpd := verifyCommand, verifyFormat, verifyJUnitUpd
+	defer func() { verifyCommand, verifyFormat, verifyJUnitUpd = origCommand, origFormat, origJUnitUpd }()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	// REQ-VER-002 is COMPLETE but its test fails, so an update demotes it
+	if err := verifyStreamTestDB().Save(dbPath); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+	eventsPath := filepath.Join(tmpDir, "events.json")
+	if err := os.WriteFile(eventsPath, []byte(syntheticTestEvents), 0644); err != nil {
+		t.Fatalf("Failed to write events: %v", err)
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	verifyCommand, verifyFormat = "cat "+eventsPath, "terminal"
+	verifyCmd.SetOut(new(bytes.Buffer))
+	defer verifyCmd.SetOut(nil)
+
+	t.Run("failed JUnit write leaves the RTM untouched", func(t *testing.T) {
+		before, _ := os.ReadFile(dbPath)
+		verifyJUnitUpd = filepath.Join(tmpDir, "missing", "report.xml")
+
+		var exitErr *ExitError
+		if err := runVerify(verifyCmd, nil); !errors.As(err, &exitErr) || exitErr.Type != ErrorTypeIO {
+			t.Fatalf("Expected an I/O error for the JUnit report, got %v", err)
+		}
+		if after, _ := os.ReadFile(dbPath); !bytes.Equal(before, after) {
+			t.Errorf("Database changed although the JUnit report could not be written:\n%s", after)
+		}
+		entries, _ := os.ReadDir(filepath.Join(tmpDir, ".rtmx"))
+		for _, e := range entries {
+			if strings.Contains(e.Name(), ".tmp-") {
+				t.Errorf("Temporary file %s left behind", e.Name())
+			}
+		}
+	})
+
+	t.Run("both are written", func(t *testing.T) {
+		verifyJUnitUpd = filepath.Join(tmpDir, "report.xml")
+
+		var exitErr *ExitError
+		if err := runVerify(verifyCmd, nil); !errors.As(err, &exitErr) || exitErr.Code != 1 {
+			t.Fatalf("Expected exit code 1 for the failing test, got %v", err)
+		}
+		db, err := database.Load(dbPath)
+		if err != nil {
+			t.Fatalf("Failed to load database: %v", err)
+		}
+		if got := db.Get("REQ-VER-002").Status; got != database.StatusPartial {
+			t.Errorf("REQ-VER-002 status = %s, want PARTIAL", got)
+		}
+		report, err := os.ReadFile(verifyJUnitUpd)
+		if err != nil {
+			t.Fatalf("JUnit report not written: %v", err)
+		}
+		for _, want := range []string{`<testsuite name="REQ-VER-002"`, `<failure message="test failed">`, `<property name="status" value="PARTIAL">`} {
+			if !strings.Contains(string(report), want) {
+				t.Errorf("Expected the report to contain %q, got:\n%s", want, report)
+			}
+		}
+	})
+}
+
+func TestVerifyResultsFile(t *testing.T) {
+	origUpdate, origFormat, origFromFile := verifyUpdate, verifyFormat, verifyFromFile
+	defer func() { verifyUpdate, verifyFormat, verifyFromFile = origUpdate, origFormat, origFromFile }()
+
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".rtmx"), 0755); err != nil {
+		t.Fatalf("Failed to create .rtmx dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".rtmx", "config.yaml"), []byte("rtmx:\n  database: .rtmx/database.csv\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	dbPath := filepath.Join(tmpDir, ".rtmx", "database.csv")
+
+	// REQ-AUTH-003 has no test_function; a marker in its test file links it
+	testFile := "import pytest\n\n@pytest.mark.req(\"REQ-AUTH-003\")\ndef test_session():\n    pass\n"
+	if err := os.MkdirAll(filepath.Join(tmpDir, "tests"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "tests", "test_session.py"), []byte(testFile), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	saveDB := func(t *testing.T) {
+		db := database.NewDatabase()
+		for _, r := range []struct {
+			id, function string
+			status       database.Status
+		}{
+			{"REQ-AUTH-001", "TestLogin", database.StatusMissing},
+			{"REQ-AUTH-002", "TestLogout", database.StatusComplete},
+			{"REQ-AUTH-003", "", database.StatusMissing},
+			{"REQ-AUTH-004", "TestUntouched", database.StatusPartial},
+		} {
+			req := database.NewRequirement(r.id)
+			req.Category = "AUTH"
+			req.TestFunction = r.function
+			req.Status = r.status
+			_ = db.Add(req)
+		}
+		if err := db.Save(dbPath); err != nil {
+			t.Fatalf("Failed to save database: %v", err)
+		}
+	}
+
+	oldWd, _ := os.Getwd()
+	_ = os.Chdir(tmpDir)
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	verifyUpdate, verifyFormat = true, "terminal"
+	tests := []struct {
+		name    string
+		results string
+	}{
+		{"counts per requirement", `[
+			{"req_id": "REQ-AUTH-001", "passed": 2, "failed": 0, "skipped": 1},
+			{"req_id": "REQ-AUTH-002", "passed": 3, "failed": 1},
+			{"req_id": "REQ-AUTH-003", "passed": 1},
+			{"req_id": "REQ-GONE-001", "passed": 1}
+		]`},
+		{"test outcomes", `{
+			"TestLogin": "pass",
+			"TestLogin/remember_me": "skip",
+			"TestLogout": "fail",
+			"TestLogout/expired": "pass",
+			"tests/test_session.py::test_session": "passed"
+		}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saveDB(t)
+			verifyFromFile = filepath.Join(tmpDir, "results.json")
+			if err := os.WriteFile(verifyFromFile, []byte(tt.results), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			verifyCmd.SetOut(&buf)
+			defer verifyCmd.SetOut(nil)
+
+			// The failing test still fails the command
+			var exitErr *ExitError
+			if err := runVerify(verifyCmd, nil); !errors.As(err, &exitErr) || exitErr.Code != 1 {
+				t.Fatalf("Expected exit code 1 for the failing test, got %v\n%s", err, buf.String())
+			}
+			if strings.Contains(buf.String(), "Running tests") {
+				t.Errorf("Expected no test run with --results:\n%s", buf.String())
+			}
+
+			db, err := database.Load(dbPath)
+			if err != nil {
+				t.Fatalf("Failed to load database: %v", err)
+			}
+			want := map[string]database.Status{
+				"REQ-AUTH-001": database.StatusComplete, // promoted
+				"REQ-AUTH-002": database.StatusPartial,  // demoted
+				"REQ-AUTH-003": database.StatusComplete, // promoted through its marker
+				"REQ-AUTH-004": database.StatusPartial,  // not in the results
+			}
+			for id, status := range want {
+				if got := db.Get(id).Status; got != status {
+					t.Errorf("%s status = %s, want %s", id, got, status)
+				}
+			}
+		})
+	}
+
+	t.Run("invalid outcome", func(t *testing.T) {
+		verifyFromFile = filepath.Join(tmpDir, "results.json")
+		if err := os.WriteFile(verifyFromFile, []byte(`{"TestLogin": "flaky"}`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		var exitErr *ExitError
+		if err := runVerify(verifyCmd, nil); !errors.As(err, &exitErr) || exitErr.Type != ErrorTypeValidation {
+			t.Fatalf("Expected a validation error, got %v", err)
+		}
+	})
+}
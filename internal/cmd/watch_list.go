@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+	"github.com/rtmx-ai/rtmx-go/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// watchListFile holds the watched requirement IDs, one per line, relative
+// to the project root.
+const watchListFile = ".rtmx/watch-list"
+
+// watchWebhookTimeout bounds a webhook notification so an unreachable
+// endpoint cannot stall verify or sync.
+const watchWebhookTimeout = 10 * time.Second
+
+var watchListCmd = &cobra.Command{
+	Use:   "watch-list",
+	Short: "Manage the requirements whose changes are highlighted",
+	Long: `Keep a list of requirements to watch in .rtmx/watch-list.
+
+Status changes to watched requirements are highlighted by diff, verify
+and sync. When watch.webhook is set in the config, verify --update and
+sync also POST the watched changes they make to that URL as JSON.
+
+Examples:
+    rtmx watch-list add REQ-AUTH-001 REQ-AUTH-002
+    rtmx watch-list remove REQ-AUTH-002
+    rtmx watch-list show`,
+}
+
+var watchListAddCmd = &cobra.Command{
+	Use:   "add REQ-ID...",
+	Short: "Watch requirements",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runWatchListAdd,
+}
+
+var watchListRemoveCmd = &cobra.Command{
+	Use:   "remove REQ-ID...",
+	Short: "Stop watching requirements",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runWatchListRemove,
+}
+
+var watchListShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "List watched requirements",
+	Args:  cobra.NoArgs,
+	RunE:  runWatchListShow,
+}
+
+func init() {
+	watchListCmd.AddCommand(watchListAddCmd)
+	watchListCmd.AddCommand(watchListRemoveCmd)
+	watchListCmd.AddCommand(watchListShowCmd)
+	rootCmd.AddCommand(watchListCmd)
+}
+
+// watchList is the set of watched requirement IDs.
+type watchList map[string]bool
+
+// watchedChange is a status change to a watched requirement.
+type watchedChange struct {
+	ReqID string `json:"req_id"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// loadWatchList reads the watch list under root. A missing file is an
+// empty list.
+func loadWatchList(root string) (watchList, error) {
+	w := make(watchList)
+	data, err := os.ReadFile(filepath.Join(root, watchListFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return w, nil
+		}
+		return nil, fmt.Errorf("failed to read watch list: %w", err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		id := strings.TrimSpace(scanner.Text())
+		if id != "" && !strings.HasPrefix(id, "#") {
+			w[id] = true
+		}
+	}
+	return w, nil
+}
+
+// saveWatchList writes the watch list under root in ID order.
+func saveWatchList(root string, w watchList) error {
+	path := filepath.Join(root, watchListFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to write watch list: %w", err)
+	}
+	var sb strings.Builder
+	for _, id := range w.IDs() {
+		sb.WriteString(id + "\n")
+	}
+	if err := os.WriteFile(path, []byte(sb.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write watch list: %w", err)
+	}
+	return nil
+}
+
+// IDs returns the watched IDs in order.
+func (w watchList) IDs() []string {
+	ids := make([]string, 0, len(w))
+	for id := range w {
+		ids = append(ids, id)
+	}
+	database.SortReqIDs(ids)
+	return ids
+}
+
+// watchedLabel marks a watched requirement ID for terminal output.
+func watchedLabel(id string) string {
+	return output.Color("★ "+id+" (watched)", output.Magenta)
+}
+
+// notifyWatchedChanges POSTs changes to the configured webhook. It does
+// nothing without a webhook or changes.
+func notifyWatchedChanges(cfg *config.Config, source string, changes []watchedChange) error {
+	url := strings.TrimSpace(cfg.RTMX.Watch.Webhook)
+	if url == "" || len(changes) == 0 {
+		return nil
+	}
+	payload, err := json.Marshal(struct {
+		Source  string          `json:"source"`
+		Changes []watchedChange `json:"changes"`
+	}{source, changes})
+	if err != nil {
+		return fmt.Errorf("failed to encode watch notification: %w", err)
+	}
+	client := &http.Client{Timeout: watchWebhookTimeout}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to notify watch webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("watch webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+func runWatchListAdd(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	db, err := database.Load(cfg.DatabasePath(cwd))
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	for _, id := range args {
+		if db.Get(id) == nil {
+			return fmt.Errorf("requirement %q not found", id)
+		}
+	}
+
+	w, err := loadWatchList(cwd)
+	if err != nil {
+		return err
+	}
+	for _, id := range args {
+		if w[id] {
+			cmd.Printf("%s %s is already watched\n", output.Color("-", output.Dim), id)
+			continue
+		}
+		w[id] = true
+		cmd.Printf("%s Watching %s\n", output.Color("✓", output.Green), id)
+	}
+	return saveWatchList(cwd, w)
+}
+
+func runWatchListRemove(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	w, err := loadWatchList(cwd)
+	if err != nil {
+		return err
+	}
+	for _, id := range args {
+		if !w[id] {
+			cmd.Printf("%s %s is not watched\n", output.Color("-", output.Dim), id)
+			continue
+		}
+		delete(w, id)
+		cmd.Printf("%s Stopped watching %s\n", output.Color("✓", output.Green), id)
+	}
+	return saveWatchList(cwd, w)
+}
+
+func runWatchListShow(cmd *cobra.Command, args []string) error {
+	if noColor {
+		output.DisableColor()
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDir(cwd)
+	if err != nil {
+		return configLoadError(err)
+	}
+
+	db, err := database.Load(cfg.DatabasePath(cwd))
+	if err != nil {
+		return databaseLoadError(err)
+	}
+
+	w, err := loadWatchList(cwd)
+	if err != nil {
+		return err
+	}
+	if len(w) == 0 {
+		cmd.Println("No requirements are watched")
+		return nil
+	}
+
+	cmd.Println(output.Header(fmt.Sprintf("Watch List (%d)", len(w)), 60))
+	cmd.Println()
+	for _, id := range w.IDs() {
+		req := db.Get(id)
+		if req == nil {
+			cmd.Printf("  %s %s %s\n", output.Color("?", output.Yellow), id, output.Color("(not in database)", output.Dim))
+			continue
+		}
+		cmd.Printf("  %s %-20s %s\n", output.StatusIcon(req.Status.String()), id, output.Truncate(req.RequirementText, 50))
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+func TestWatchListAddRemoveShow(t *testing.T) {
+	tmpDir := setupTestProject(t, "REQ-WATCH-001", "REQ-WATCH-002")
+
+	run := func(args ...string) (string, error) {
+		var buf bytes.Buffer
+		rootCmd.SetOut(&buf)
+		rootCmd.SetArgs(append([]string{"watch-list"}, args...))
+		defer rootCmd.SetOut(nil)
+		err := rootCmd.Execute()
+		return buf.String(), err
+	}
+
+	if _, err := run("add", "REQ-WATCH-002", "REQ-WATCH-001"); err != nil {
+		t.Fatalf("watch-list add failed: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(tmpDir, watchListFile))
+	if err != nil {
+		t.Fatalf("Expected the watch list to be written: %v", err)
+	}
+	if string(data) != "REQ-WATCH-001\nREQ-WATCH-002\n" {
+		t.Errorf("watch list file = %q, want both IDs in order", data)
+	}
+
+	if _, err := run("add", "REQ-WATCH-999"); err == nil {
+		t.Error("Expected watching an unknown requirement to fail")
+	}
+
+	if _, err := run("remove", "REQ-WATCH-002"); err != nil {
+		t.Fatalf("watch-list remove failed: %v", err)
+	}
+	out, err := run("show")
+	if err != nil {
+		t.Fatalf("watch-list show failed: %v", err)
+	}
+	if !strings.Contains(out, "REQ-WATCH-001") || strings.Contains(out, "REQ-WATCH-002") {
+		t.Errorf("Expected only REQ-WATCH-001 to be shown, got:\n%s", out)
+	}
+}
+
+func TestDiffHighlightsWatchedChanges(t *testing.T) {
+	tmpDir := setupTestProject(t, "REQ-WATCH-001", "REQ-WATCH-002")
+	if err := saveWatchList(tmpDir, watchList{"REQ-WATCH-001": true}); err != nil {
+		t.Fatalf("saveWatchList failed: %v", err)
+	}
+
+	// Both requirements change status; only one is watched
+	baseline := filepath.Join(tmpDir, ".rtmx", "database.csv")
+	db, err := database.Load(baseline)
+	if err != nil {
+		t.Fatalf("Failed to load database: %v", err)
+	}
+	db.Get("REQ-WATCH-001").Status = database.StatusComplete
+	db.Get("REQ-WATCH-002").Status = database.StatusPartial
+	current := filepath.Join(tmpDir, "current.csv")
+	if err := db.Save(current); err != nil {
+		t.Fatalf("Failed to save database: %v", err)
+	}
+
+	root := createDiffTestCmd()
+	var buf bytes.Buffer
+	root.SetOut(&buf)
+	root.SetArgs([]string{"diff", baseline, current})
+	if err := root.Execute(); err != nil {
+		t.Fatalf("diff command failed: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "★ REQ-WATCH-001 (watched).status: MISSING") {
+		t.Errorf("Expected the watched status change to be highlighted, got:\n%s", out)
+	}
+	if strings.Contains(out, "★ REQ-WATCH-002") {
+		t.Errorf("Expected the unwatched change not to be highlighted, got:\n%s", out)
+	}
+	if !strings.Contains(out, "REQ-WATCH-002.status: MISSING") {
+		t.Errorf("Expected the unwatched change to be listed, got:\n%s", out)
+	}
+}
+
+func TestNotifyWatchedChanges(t *testing.T) {
+	var got struct {
+		Source  string          `json:"source"`
+		Changes []watchedChange `json:"changes"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("Failed to decode notification: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.DefaultConfig()
+	changes := []watchedChange{{ReqID: "REQ-WATCH-001", From: "MISSING", To: "COMPLETE"}}
+
+	// Without a webhook nothing is sent
+	if err := notifyWatchedChanges(cfg, "verify", changes); err != nil {
+		t.Fatalf("notifyWatchedChanges without webhook failed: %v", err)
+	}
+	if got.Source != "" {
+		t.Fatal("Expected no notification without a webhook")
+	}
+
+	cfg.RTMX.Watch.Webhook = server.URL
+	if err := notifyWatchedChanges(cfg, "verify", changes); err != nil {
+		t.Fatalf("notifyWatchedChanges failed: %v", err)
+	}
+	if got.Source != "verify" || len(got.Changes) != 1 || got.Changes[0] != changes[0] {
+		t.Errorf("notification = %+v, want %+v from verify", got, changes)
+	}
+}
@@ -4,7 +4,10 @@ package config
 import (
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
+	"text/template"
 
 	"gopkg.in/yaml.v3"
 )
@@ -22,15 +25,49 @@ type RTMXConfig struct {
 	// RequirementsDir is the directory containing requirement spec files.
 	RequirementsDir string `yaml:"requirements_dir"`
 
+	// RequirementFileTemplate lays out requirement spec files under
+	// RequirementsDir, e.g. "phase-{{.Phase}}/{{.ID}}.md". Fields are
+	// .Category, .ID and .Phase.
+	RequirementFileTemplate string `yaml:"requirement_file_template"`
+
 	// Schema is the schema name (core or custom).
 	Schema string `yaml:"schema"`
 
+	// IDPadWidth is the number of digits generated IDs are zero-padded to,
+	// 3 for REQ-AUTH-001.
+	IDPadWidth int `yaml:"id_pad_width"`
+
+	// Categories, when set, is the list of categories requirements may
+	// use. validate reports others, add rejects them and bootstrap warns.
+	Categories []string `yaml:"categories"`
+
+	// ArchivedCategories are delivered categories that status and
+	// backlog hide unless run with --include-archived.
+	ArchivedCategories []string `yaml:"archived_categories"`
+
 	// Pytest configuration
 	Pytest PytestConfig `yaml:"pytest"`
 
+	// Markers configures requirement marker extraction from test files.
+	Markers MarkersConfig `yaml:"markers"`
+
 	// Phases maps phase numbers to descriptions.
 	Phases map[int]string `yaml:"phases"`
 
+	// Defaults maps a command name to default flag values, e.g.
+	// backlog: {view: critical, category: AUTH}. Flags given on the
+	// command line take precedence.
+	Defaults map[string]map[string]string `yaml:"defaults"`
+
+	// Risk configures the backlog risk score.
+	Risk RiskConfig `yaml:"risk"`
+
+	// Forecast configures the remaining-work forecast shown by status.
+	Forecast ForecastConfig `yaml:"forecast"`
+
+	// Locale configures how dates and numbers are written and displayed.
+	Locale LocaleConfig `yaml:"locale"`
+
 	// Agents configuration for AI assistants.
 	Agents AgentsConfig `yaml:"agents"`
 
@@ -40,6 +77,22 @@ type RTMXConfig struct {
 	// MCP configuration for Model Context Protocol.
 	MCP MCPConfig `yaml:"mcp"`
 
+	// Verify configuration for closed-loop verification.
+	Verify VerifyConfig `yaml:"verify"`
+
+	// DefinitionOfDone lists the checks a COMPLETE requirement must pass.
+	DefinitionOfDone DefinitionOfDoneConfig `yaml:"definition_of_done"`
+
+	// CompletionThresholds sets the completion health and gate require
+	// per category and phase.
+	CompletionThresholds CompletionThresholdsConfig `yaml:"completion_thresholds"`
+
+	// Statuses declares custom statuses and which statuses are incomplete.
+	Statuses StatusesConfig `yaml:"statuses"`
+
+	// Watch configures notification of changes to watched requirements.
+	Watch WatchConfig `yaml:"watch"`
+
 	// Sync configuration for collaboration.
 	Sync SyncConfig `yaml:"sync"`
 
@@ -52,10 +105,103 @@ type RTMXConfig struct {
 
 // PytestConfig contains pytest-related settings.
 type PytestConfig struct {
+	// MarkerPrefix names the pytest marker, "req" for pytest.mark.req.
+	// Upper-cased it starts every requirement ID, "REQ" for REQ-AUTH-001,
+	// so generated IDs and the markers found in tests and issues use it.
 	MarkerPrefix    string `yaml:"marker_prefix"`
 	RegisterMarkers bool   `yaml:"register_markers"`
 }
 
+// MarkersConfig contains requirement marker extraction rules.
+type MarkersConfig struct {
+	// Rules are extraction rules used in addition to the built-in
+	// Python, Go and JS/TS defaults.
+	Rules []MarkerRule `yaml:"rules"`
+
+	// NoDefaults disables the built-in rules.
+	NoDefaults bool `yaml:"no_defaults"`
+
+	// Implementation configures the markers that tie non-test source to
+	// the requirements it implements.
+	Implementation ImplementationMarkersConfig `yaml:"implementation"`
+}
+
+// ImplementationMarkersConfig configures implementation markers such as
+// "// implements REQ-AUTH-001" in non-test source files.
+type ImplementationMarkersConfig struct {
+	// Pattern is a regex whose first capture group is the requirement ID.
+	// The default matches "implements" followed by an ID.
+	Pattern string `yaml:"pattern"`
+
+	// Extensions are the file extensions scanned (e.g. ".go"). The default
+	// covers the languages of the built-in test marker rules.
+	Extensions []string `yaml:"extensions"`
+
+	// Dirs are the directories scanned, relative to the project root
+	// (e.g. "src"). The default is the whole project.
+	Dirs []string `yaml:"dirs"`
+}
+
+// MarkerRule extracts requirement markers from files with given extensions.
+type MarkerRule struct {
+	// Extensions are the file extensions the rule applies to (e.g. ".rb").
+	Extensions []string `yaml:"extensions"`
+
+	// TestFiles are base name globs identifying test files (e.g. "*_spec.rb").
+	// When empty, every file with a matching extension is scanned.
+	TestFiles []string `yaml:"test_files"`
+
+	// Pattern is a regex whose first capture group is the requirement ID.
+	Pattern string `yaml:"pattern"`
+
+	// Function is a regex whose first capture group is a test name.
+	// Markers are linked to the test they precede or appear in.
+	Function string `yaml:"function"`
+}
+
+// RiskConfig weights the risk score used by backlog --view risk:
+//
+//	risk = priority weight × (1 + blocked weight × downstream blocked) × uncertainty
+//
+// where uncertainty = 1 + uncertainty per week × effort weeks, or the
+// unestimated uncertainty when a requirement has no effort estimate.
+type RiskConfig struct {
+	// PriorityWeights maps a priority (P0, HIGH, MEDIUM, LOW) to its weight.
+	PriorityWeights map[string]float64 `yaml:"priority_weights"`
+
+	// BlockedWeight scales each incomplete requirement transitively blocked.
+	BlockedWeight float64 `yaml:"blocked_weight"`
+
+	// UncertaintyPerWeek is the uncertainty added per estimated week of effort.
+	UncertaintyPerWeek float64 `yaml:"uncertainty_per_week"`
+
+	// UnestimatedUncertainty is the uncertainty of a requirement without an estimate.
+	UnestimatedUncertainty float64 `yaml:"unestimated_uncertainty"`
+}
+
+// ForecastConfig configures the remaining effort and completion forecast
+// shown by status --velocity.
+type ForecastConfig struct {
+	// PartialFraction is the fraction of a PARTIAL requirement's effort
+	// still remaining.
+	PartialFraction float64 `yaml:"partial_fraction"`
+
+	// SprintWeeks is the length of a sprint in calendar weeks.
+	SprintWeeks float64 `yaml:"sprint_weeks"`
+}
+
+// LocaleConfig configures date and number formatting.
+type LocaleConfig struct {
+	// DateFormat is the Go time layout started_date and completed_date are
+	// written in and dates are displayed in, e.g. "02.01.2006". Empty
+	// means YYYY-MM-DD. Dates in YYYY-MM-DD are always read.
+	DateFormat string `yaml:"date_format"`
+
+	// DecimalSeparator separates the fractional part of displayed
+	// percentages and decimals, e.g. ",". Empty means ".".
+	DecimalSeparator string `yaml:"decimal_separator"`
+}
+
 // AgentsConfig contains AI agent settings.
 type AgentsConfig struct {
 	Claude      AgentConfig `yaml:"claude"`
@@ -72,8 +218,22 @@ type AgentConfig struct {
 
 // AdaptersConfig contains external integration settings.
 type AdaptersConfig struct {
-	GitHub GitHubConfig `yaml:"github"`
-	Jira   JiraConfig   `yaml:"jira"`
+	GitHub    GitHubConfig    `yaml:"github"`
+	Jira      JiraConfig      `yaml:"jira"`
+	Bitbucket BitbucketConfig `yaml:"bitbucket"`
+
+	// Redact lists extra secrets scrubbed from adapter logs and errors.
+	Redact RedactConfig `yaml:"redact"`
+}
+
+// RedactConfig configures secret redaction in adapter output. Adapter
+// tokens and the Authorization header are always redacted.
+type RedactConfig struct {
+	// Headers are request headers whose values are also redacted.
+	Headers []string `yaml:"headers"`
+
+	// Env names environment variables whose values are also redacted.
+	Env []string `yaml:"env"`
 }
 
 // GitHubConfig contains GitHub integration settings.
@@ -83,11 +243,71 @@ type GitHubConfig struct {
 	TokenEnv      string            `yaml:"token_env"`
 	Labels        GitHubLabels      `yaml:"labels"`
 	StatusMapping map[string]string `yaml:"status_mapping"`
+
+	// IssueTemplate shapes issues created from requirements to match a
+	// repository issue form or template.
+	IssueTemplate GitHubIssueTemplate `yaml:"issue_template"`
+
+	// AssigneeMapping maps RTM assignees to GitHub logins.
+	AssigneeMapping map[string]string `yaml:"assignee_mapping"`
+
+	// Repos lists more owner/repo repositories whose issues are fetched
+	// and merged with Repo's. Their issues are identified as
+	// owner/repo#number; new issues are created in Repo, or the first of
+	// Repos when Repo is empty.
+	Repos []string `yaml:"repos"`
+
+	// Title shapes the titles of exported issues.
+	Title IssueTitleConfig `yaml:"title"`
+}
+
+// IssueTitleConfig shapes the titles of items exported from requirements.
+type IssueTitleConfig struct {
+	// Template is a Go template for the title with fields .ID, .Text and
+	// .Category (default "[{{.ID}}] {{.Text}}").
+	Template string `yaml:"template"`
+
+	// MaxLength is the most characters of requirement text kept in .Text,
+	// ending in "..." when cut (default 80; -1 keeps the whole text). The
+	// rest of the title, such as the ID, is never cut.
+	MaxLength int `yaml:"max_length"`
 }
 
 // GitHubLabels contains GitHub label configuration.
 type GitHubLabels struct {
 	Requirement string `yaml:"requirement"`
+
+	// Blocked is the label marking an issue as blocked externally
+	// (default "blocked").
+	Blocked string `yaml:"blocked"`
+
+	// Status maps a label to the RTM status an issue carrying it imports
+	// as, e.g. wontfix: DEPRECATED, instead of the one its state maps to.
+	Status map[string]string `yaml:"status"`
+
+	// Priority maps a label to the RTM priority an issue carrying it
+	// imports at, e.g. p0: P0, ahead of the built-in priority labels.
+	Priority map[string]string `yaml:"priority"`
+}
+
+// GitHubIssueTemplate selects the issue form or template used when
+// creating issues.
+type GitHubIssueTemplate struct {
+	// Name is the template file name (without extension) or the form's
+	// name field, e.g. "requirement".
+	Name string `yaml:"name"`
+
+	// Dir is the directory holding issue templates
+	// (default .github/ISSUE_TEMPLATE).
+	Dir string `yaml:"dir"`
+
+	// RequiredLabels are applied to every created issue, in addition to
+	// the template's default labels.
+	RequiredLabels []string `yaml:"required_labels"`
+
+	// Fields maps a template section label to the requirement field
+	// (CSV column name) that fills it, overriding the built-in matching.
+	Fields map[string]string `yaml:"fields"`
 }
 
 // GitHubAdapterConfig is an alias for GitHubConfig used by the adapter.
@@ -104,11 +324,60 @@ type JiraConfig struct {
 	JQLFilter     string            `yaml:"jql_filter"`
 	Labels        []string          `yaml:"labels"`
 	StatusMapping map[string]string `yaml:"status_mapping"`
+
+	// SearchAPI selects the issue search API: "jql" for Jira Cloud's
+	// /rest/api/3/search/jql, "legacy" for /rest/api/3/search on Server
+	// and Data Center, or "auto" (the default) to try the first and fall
+	// back to the second.
+	SearchAPI string `yaml:"search_api"`
+
+	// FlaggedField is the custom field holding an issue's flag
+	// (default customfield_10021, Jira Cloud's "Flagged").
+	FlaggedField string `yaml:"flagged_field"`
+
+	// AssigneeMapping maps RTM assignees to Jira accountIds.
+	AssigneeMapping map[string]string `yaml:"assignee_mapping"`
+
+	// Title shapes the summaries of exported issues.
+	Title IssueTitleConfig `yaml:"title"`
 }
 
 // JiraAdapterConfig is an alias for JiraConfig used by the adapter.
 type JiraAdapterConfig = JiraConfig
 
+// BitbucketConfig contains Bitbucket integration settings.
+type BitbucketConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Server is the REST API base URL
+	// (default https://api.bitbucket.org/2.0, Bitbucket Cloud).
+	Server string `yaml:"server"`
+
+	// Workspace and Repo identify the repository whose issue tracker is
+	// synced; Repo is the repository slug.
+	Workspace string `yaml:"workspace"`
+	Repo      string `yaml:"repo"`
+
+	// TokenEnv names the variable holding an app password or access
+	// token. With the username in UsernameEnv set, it is sent as Basic
+	// auth; otherwise as a bearer token.
+	TokenEnv    string `yaml:"token_env"`
+	UsernameEnv string `yaml:"username_env"`
+
+	// Kind is the kind of issues created: bug, enhancement, proposal or
+	// task (default task).
+	Kind string `yaml:"kind"`
+
+	// StatusMapping maps an issue state, e.g. "on hold", to an RTM status.
+	StatusMapping map[string]string `yaml:"status_mapping"`
+
+	// Title shapes the titles of exported issues.
+	Title IssueTitleConfig `yaml:"title"`
+}
+
+// BitbucketAdapterConfig is an alias for BitbucketConfig used by the adapter.
+type BitbucketAdapterConfig = BitbucketConfig
+
 // MCPConfig contains Model Context Protocol settings.
 type MCPConfig struct {
 	Enabled bool   `yaml:"enabled"`
@@ -116,6 +385,69 @@ type MCPConfig struct {
 	Host    string `yaml:"host"`
 }
 
+// VerifyConfig contains closed-loop verification settings.
+type VerifyConfig struct {
+	// Strategy selects how test results map to status
+	// (all-pass, pass-rate, or coverage).
+	Strategy string `yaml:"strategy"`
+
+	// PassRateThreshold is the percentage of passing tests required
+	// by the pass-rate strategy for a requirement to be COMPLETE.
+	PassRateThreshold float64 `yaml:"pass_rate_threshold"`
+
+	// MinCoverage is the statement coverage percentage required
+	// by the coverage strategy for a requirement to be COMPLETE.
+	MinCoverage float64 `yaml:"min_coverage"`
+}
+
+// CompletionThresholdsConfig sets minimum completion percentages for parts
+// of the RTM, e.g. categories: {AUTH: 100, DOCS: 50}. Completion counts
+// PARTIAL requirements as half done.
+type CompletionThresholdsConfig struct {
+	// Categories maps a category to its minimum completion percentage.
+	Categories map[string]float64 `yaml:"categories"`
+
+	// Phases maps a phase number to its minimum completion percentage.
+	Phases map[int]float64 `yaml:"phases"`
+}
+
+// IsEmpty reports whether no threshold is set.
+func (c CompletionThresholdsConfig) IsEmpty() bool {
+	return len(c.Categories) == 0 && len(c.Phases) == 0
+}
+
+// StatusesConfig extends the built-in statuses (COMPLETE, PARTIAL,
+// MISSING, NOT_STARTED) and decides which of them are still backlog.
+type StatusesConfig struct {
+	// Custom lists additional statuses accepted in the database, e.g.
+	// IN_REVIEW or BLOCKED.
+	Custom []string `yaml:"custom"`
+
+	// Incomplete lists the statuses that count as incomplete and appear in
+	// the backlog. Empty means every status except COMPLETE.
+	Incomplete []string `yaml:"incomplete"`
+}
+
+// WatchConfig configures how changes to requirements on the watch list
+// are reported.
+type WatchConfig struct {
+	// Webhook is a URL that verify and sync POST watched status changes
+	// to as JSON. Empty disables notification.
+	Webhook string `yaml:"webhook"`
+}
+
+// DefinitionOfDoneConfig is the checklist a requirement must satisfy to be
+// COMPLETE. Checks are spec_file, acceptance_criteria, test_linked, and
+// field:<column> for a non-empty column (e.g. field:reviewer).
+type DefinitionOfDoneConfig struct {
+	// Checks are the definition-of-done items, checked in order.
+	Checks []string `yaml:"checks"`
+
+	// EnforceInVerify keeps requirements that fail a check PARTIAL in
+	// verify even when their tests pass.
+	EnforceInVerify bool `yaml:"enforce_in_verify"`
+}
+
 // SyncConfig contains collaboration settings.
 type SyncConfig struct {
 	ConflictResolution string                `yaml:"conflict_resolution"`
@@ -145,15 +477,32 @@ type ZitiConfig struct {
 	Services    map[string]string `yaml:"services"`
 }
 
+// DefaultRequirementFileTemplate groups requirement spec files by category.
+const DefaultRequirementFileTemplate = "{{.Category}}/{{.ID}}.md"
+
+// DefaultMarkerPrefix is the pytest marker when pytest.marker_prefix is
+// not set.
+const DefaultMarkerPrefix = "req"
+
+// DefaultIDPrefix starts requirement IDs when pytest.marker_prefix is not
+// set.
+const DefaultIDPrefix = "REQ"
+
+// DefaultIDPadWidth is the digit count of generated IDs when id_pad_width
+// is not set.
+const DefaultIDPadWidth = 3
+
 // DefaultConfig returns a configuration with sensible defaults.
 func DefaultConfig() *Config {
 	return &Config{
 		RTMX: RTMXConfig{
-			Database:        ".rtmx/database.csv",
-			RequirementsDir: ".rtmx/requirements",
-			Schema:          "core",
+			Database:                ".rtmx/database.csv",
+			RequirementsDir:         ".rtmx/requirements",
+			RequirementFileTemplate: DefaultRequirementFileTemplate,
+			Schema:                  "core",
+			IDPadWidth:              DefaultIDPadWidth,
 			Pytest: PytestConfig{
-				MarkerPrefix:    "req",
+				MarkerPrefix:    DefaultMarkerPrefix,
 				RegisterMarkers: true,
 			},
 			Phases: map[int]string{
@@ -161,6 +510,21 @@ func DefaultConfig() *Config {
 				2: "Core Features",
 				3: "Integration",
 			},
+			Risk: RiskConfig{
+				PriorityWeights: map[string]float64{
+					"P0":     4,
+					"HIGH":   3,
+					"MEDIUM": 2,
+					"LOW":    1,
+				},
+				BlockedWeight:          1,
+				UncertaintyPerWeek:     0.25,
+				UnestimatedUncertainty: 2,
+			},
+			Forecast: ForecastConfig{
+				PartialFraction: 0.5,
+				SprintWeeks:     2,
+			},
 			Agents: AgentsConfig{
 				Claude: AgentConfig{
 					Enabled:    true,
@@ -192,12 +556,23 @@ func DefaultConfig() *Config {
 					EmailEnv:  "JIRA_EMAIL",
 					IssueType: "Requirement",
 				},
+				Bitbucket: BitbucketConfig{
+					Enabled:     false,
+					TokenEnv:    "BITBUCKET_TOKEN",
+					UsernameEnv: "BITBUCKET_USERNAME",
+					Kind:        "task",
+				},
 			},
 			MCP: MCPConfig{
 				Enabled: false,
 				Port:    3000,
 				Host:    "localhost",
 			},
+			Verify: VerifyConfig{
+				Strategy:          "all-pass",
+				PassRateThreshold: 80,
+				MinCoverage:       80,
+			},
 			Sync: SyncConfig{
 				ConflictResolution: "manual",
 				Remotes:            make(map[string]SyncRemote),
@@ -278,7 +653,60 @@ func FindConfig(startPath string) (string, error) {
 	return "", fmt.Errorf("no RTMX configuration found")
 }
 
-// LoadFromDir loads configuration from the given directory.
+// EnvVar names the environment variable selecting a config overlay when
+// none is set with SetEnvironment.
+const EnvVar = "RTMX_ENV"
+
+// environment is the overlay selected with SetEnvironment.
+var environment string
+
+// SetEnvironment selects the config overlay LoadFromDir merges over the
+// base config. An empty name defers to RTMX_ENV.
+func SetEnvironment(name string) {
+	environment = strings.TrimSpace(name)
+}
+
+// Environment returns the selected config overlay, "" for none.
+func Environment() string {
+	if environment != "" {
+		return environment
+	}
+	return strings.TrimSpace(os.Getenv(EnvVar))
+}
+
+// OverlayPath returns the overlay for env next to the base config at path,
+// e.g. rtmx.ci.yaml for rtmx.yaml.
+func OverlayPath(path, env string) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "." + env + ext
+}
+
+// LoadWithOverlay loads the config at path and merges the overlay for env
+// over it. Mappings are merged key by key and the overlay wins; its lists
+// and scalars replace the base's. An empty env loads path alone.
+func LoadWithOverlay(path, env string) (*Config, error) {
+	config, err := Load(path)
+	if err != nil || env == "" {
+		return config, err
+	}
+	if strings.ContainsAny(env, `/\`) || strings.Contains(env, "..") {
+		return nil, fmt.Errorf("invalid config environment %q", env)
+	}
+
+	overlay := OverlayPath(path, env)
+	data, err := os.ReadFile(overlay)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config overlay for environment %q: %w", env, err)
+	}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse config overlay %s: %w", overlay, err)
+	}
+
+	return config, nil
+}
+
+// LoadFromDir loads configuration from the given directory, merging the
+// overlay for the selected environment over it.
 func LoadFromDir(dir string) (*Config, error) {
 	path, err := FindConfig(dir)
 	if err != nil {
@@ -286,7 +714,7 @@ func LoadFromDir(dir string) (*Config, error) {
 		return DefaultConfig(), nil
 	}
 
-	return Load(path)
+	return LoadWithOverlay(path, Environment())
 }
 
 // DatabasePath returns the resolved database path.
@@ -297,6 +725,29 @@ func (c *Config) DatabasePath(baseDir string) string {
 	return filepath.Join(baseDir, c.RTMX.Database)
 }
 
+// MarkerPrefix returns the pytest marker prefix lower-cased,
+// DefaultMarkerPrefix when unset.
+func (c *Config) MarkerPrefix() string {
+	if prefix := strings.TrimSpace(c.RTMX.Pytest.MarkerPrefix); prefix != "" {
+		return strings.ToLower(prefix)
+	}
+	return DefaultMarkerPrefix
+}
+
+// IDPrefix returns the requirement ID prefix: the marker prefix upper-cased.
+func (c *Config) IDPrefix() string {
+	return strings.ToUpper(c.MarkerPrefix())
+}
+
+// IDPadWidth returns the digit count for generated IDs, DefaultIDPadWidth
+// when unset.
+func (c *Config) IDPadWidth() int {
+	if c.RTMX.IDPadWidth > 0 {
+		return c.RTMX.IDPadWidth
+	}
+	return DefaultIDPadWidth
+}
+
 // RequirementsPath returns the resolved requirements directory path.
 func (c *Config) RequirementsPath(baseDir string) string {
 	if filepath.IsAbs(c.RTMX.RequirementsDir) {
@@ -305,6 +756,113 @@ func (c *Config) RequirementsPath(baseDir string) string {
 	return filepath.Join(baseDir, c.RTMX.RequirementsDir)
 }
 
+// RequirementFile returns the requirement_file path for a requirement,
+// rendered from RequirementFileTemplate under RequirementsDir. The path
+// is slash-separated and relative to the project root.
+func (c *Config) RequirementFile(category, id string, phase int) (string, error) {
+	text := c.RTMX.RequirementFileTemplate
+	if text == "" {
+		text = DefaultRequirementFileTemplate
+	}
+	tmpl, err := template.New("requirement_file").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid requirement_file_template: %w", err)
+	}
+
+	var sb strings.Builder
+	data := struct {
+		Category string
+		ID       string
+		Phase    int
+	}{category, id, phase}
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("invalid requirement_file_template: %w", err)
+	}
+
+	rel := path.Clean(filepath.ToSlash(strings.TrimSpace(sb.String())))
+	if rel == "." || path.IsAbs(rel) || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", fmt.Errorf("requirement_file_template produced invalid path %q for %s", sb.String(), id)
+	}
+
+	dir := c.RTMX.RequirementsDir
+	if dir == "" {
+		dir = DefaultConfig().RTMX.RequirementsDir
+	}
+	return path.Join(filepath.ToSlash(dir), rel), nil
+}
+
+// CategoryAllowed reports whether category is in the categories
+// allow-list. Every category is allowed when no list is configured.
+func (c *Config) CategoryAllowed(category string) bool {
+	if len(c.RTMX.Categories) == 0 {
+		return true
+	}
+	for _, allowed := range c.RTMX.Categories {
+		if category == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// CategoryArchived reports whether category is archived, ignoring case.
+func (c *Config) CategoryArchived(category string) bool {
+	for _, archived := range c.RTMX.ArchivedCategories {
+		if strings.EqualFold(category, archived) {
+			return true
+		}
+	}
+	return false
+}
+
+// SuggestCategory returns the allowed category closest to category: one
+// that differs only in case, then one that is a prefix of the other
+// (AUTH for authentication), then the one with the fewest edits. It
+// returns "" when no allow-list is configured.
+func (c *Config) SuggestCategory(category string) string {
+	upper := strings.ToUpper(strings.TrimSpace(category))
+	for _, allowed := range c.RTMX.Categories {
+		if strings.ToUpper(allowed) == upper {
+			return allowed
+		}
+	}
+	for _, allowed := range c.RTMX.Categories {
+		a := strings.ToUpper(allowed)
+		if a != "" && upper != "" && (strings.HasPrefix(upper, a) || strings.HasPrefix(a, upper)) {
+			return allowed
+		}
+	}
+
+	best, bestDist := "", -1
+	for _, allowed := range c.RTMX.Categories {
+		if d := editDistance(upper, strings.ToUpper(allowed)); bestDist < 0 || d < bestDist {
+			best, bestDist = allowed, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
 // PhaseDescription returns the description for a phase number.
 func (c *Config) PhaseDescription(phase int) string {
 	if desc, ok := c.RTMX.Phases[phase]; ok {
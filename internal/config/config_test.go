@@ -181,6 +181,73 @@ func TestPhaseDescription(t *testing.T) {
 	}
 }
 
+func TestCategoryAllowList(t *testing.T) {
+	cfg := DefaultConfig()
+	if !cfg.CategoryAllowed("ANYTHING") {
+		t.Error("Every category should be allowed without an allow-list")
+	}
+
+	cfg.RTMX.Categories = []string{"AUTH", "API", "STORAGE"}
+	tests := []struct {
+		category string
+		allowed  bool
+		suggest  string
+	}{
+		{"AUTH", true, "AUTH"},
+		{"Auth", false, "AUTH"},
+		{"authentication", false, "AUTH"},
+		{"STORGE", false, "STORAGE"},
+		{"APIS", false, "API"},
+	}
+	for _, tt := range tests {
+		if got := cfg.CategoryAllowed(tt.category); got != tt.allowed {
+			t.Errorf("CategoryAllowed(%q) = %v, want %v", tt.category, got, tt.allowed)
+		}
+		if got := cfg.SuggestCategory(tt.category); got != tt.suggest {
+			t.Errorf("SuggestCategory(%q) = %q, want %q", tt.category, got, tt.suggest)
+		}
+	}
+}
+
+func TestRequirementFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		dir      string
+		template string
+		want     string
+		wantErr  bool
+	}{
+		{"default", ".rtmx/requirements", "", ".rtmx/requirements/AUTH/REQ-AUTH-001.md", false},
+		{"by phase", ".rtmx/requirements", "phase-{{.Phase}}/{{.ID}}.md", ".rtmx/requirements/phase-2/REQ-AUTH-001.md", false},
+		{"flat", "docs/requirements", "{{.ID}}.md", "docs/requirements/REQ-AUTH-001.md", false},
+		{"unknown field", ".rtmx/requirements", "{{.Owner}}/{{.ID}}.md", "", true},
+		{"escapes dir", ".rtmx/requirements", "../{{.ID}}.md", "", true},
+		{"empty", ".rtmx/requirements", "{{/* nothing */}}", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			cfg.RTMX.RequirementsDir = tt.dir
+			cfg.RTMX.RequirementFileTemplate = tt.template
+
+			got, err := cfg.RequirementFile("AUTH", "REQ-AUTH-001", 2)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("RequirementFile() = %q, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RequirementFile() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RequirementFile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestLoadRealConfig(t *testing.T) {
 	// Try to load the real config from rtmx-go project
 	paths := []string{
@@ -209,3 +276,96 @@ func TestLoadRealConfig(t *testing.T) {
 	t.Logf("Loaded real config: database=%s, schema=%s",
 		cfg.RTMX.Database, cfg.RTMX.Schema)
 }
+
+func TestLoadFromDirEnvironmentOverlay(t *testing.T) {
+	tmpDir := t.TempDir()
+	base := `rtmx:
+  database: docs/rtm_database.csv
+  phases:
+    1: Foundation
+    2: Core
+  adapters:
+    github:
+      enabled: true
+      repo: acme/app
+      status_mapping:
+        open: MISSING
+`
+	overlay := `rtmx:
+  database: build/ci_database.csv
+  phases:
+    2: Core (CI)
+  adapters:
+    jira:
+      enabled: true
+      server: https://acme.atlassian.net
+      project: APP
+    github:
+      status_mapping:
+        closed: COMPLETE
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "rtmx.yaml"), []byte(base), 0644); err != nil {
+		t.Fatalf("Failed to write base config: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "rtmx.ci.yaml"), []byte(overlay), 0644); err != nil {
+		t.Fatalf("Failed to write overlay: %v", err)
+	}
+	defer SetEnvironment("")
+
+	tests := []struct {
+		name   string
+		flag   string
+		envVar string
+	}{
+		{name: "selected with SetEnvironment", flag: "ci"},
+		{name: "selected with RTMX_ENV", envVar: "ci"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetEnvironment(tt.flag)
+			t.Setenv(EnvVar, tt.envVar)
+
+			cfg, err := LoadFromDir(tmpDir)
+			if err != nil {
+				t.Fatalf("LoadFromDir failed: %v", err)
+			}
+			if cfg.RTMX.Database != "build/ci_database.csv" {
+				t.Errorf("Database = %q, want the overlay's", cfg.RTMX.Database)
+			}
+			jira := cfg.RTMX.Adapters.Jira
+			if !jira.Enabled || jira.Server != "https://acme.atlassian.net" || jira.Project != "APP" {
+				t.Errorf("Jira = %+v, want it enabled by the overlay", jira)
+			}
+			github := cfg.RTMX.Adapters.GitHub
+			if !github.Enabled || github.Repo != "acme/app" {
+				t.Errorf("GitHub = %+v, want the base settings kept", github)
+			}
+			if github.StatusMapping["open"] != "MISSING" || github.StatusMapping["closed"] != "COMPLETE" {
+				t.Errorf("StatusMapping = %v, want base and overlay keys merged", github.StatusMapping)
+			}
+			if cfg.RTMX.Phases[1] != "Foundation" || cfg.RTMX.Phases[2] != "Core (CI)" {
+				t.Errorf("Phases = %v, want phase 1 kept and phase 2 overridden", cfg.RTMX.Phases)
+			}
+		})
+	}
+
+	t.Run("no environment", func(t *testing.T) {
+		SetEnvironment("")
+		t.Setenv(EnvVar, "")
+		cfg, err := LoadFromDir(tmpDir)
+		if err != nil {
+			t.Fatalf("LoadFromDir failed: %v", err)
+		}
+		if cfg.RTMX.Database != "docs/rtm_database.csv" || cfg.RTMX.Adapters.Jira.Enabled {
+			t.Errorf("Expected the base config alone, got database %q, jira %v", cfg.RTMX.Database, cfg.RTMX.Adapters.Jira.Enabled)
+		}
+	})
+
+	t.Run("missing overlay", func(t *testing.T) {
+		SetEnvironment("staging")
+		if _, err := LoadFromDir(tmpDir); err == nil {
+			t.Error("Expected an error for an environment without an overlay")
+		}
+	})
+}
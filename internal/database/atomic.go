@@ -0,0 +1,158 @@
+package database
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// writeFileAtomic replaces path with the output of write. The content goes
+// to a temporary file in the same directory, which is synced and renamed
+// over path only once write succeeds, so a failed or interrupted write
+// leaves the previous file untouched.
+func writeFileAtomic(path string, write func(w io.Writer) error) error {
+	f, err := stageFile(path, write)
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(f.tmp, f.path); err != nil {
+		os.Remove(f.tmp)
+		return fmt.Errorf("failed to replace %s: %w", f.path, err)
+	}
+	syncDir(filepath.Dir(f.path))
+	return nil
+}
+
+// stagedFile is the synced temporary file holding path's new content.
+type stagedFile struct {
+	tmp, path string
+}
+
+// stageFile writes the output of write to a temporary file beside path,
+// with path's file mode. Symlinks are written through rather than
+// replaced. On error the temporary file is removed.
+func stageFile(path string, write func(w io.Writer) error) (f stagedFile, err error) {
+	// Write through symlinks rather than replacing them
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return f, fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	buf := bufio.NewWriter(tmp)
+	if err := write(buf); err != nil {
+		return f, err
+	}
+	if err := buf.Flush(); err != nil {
+		return f, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		return f, fmt.Errorf("failed to set mode of %s: %w", path, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return f, fmt.Errorf("failed to sync %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return f, fmt.Errorf("failed to close %s: %w", path, err)
+	}
+	return stagedFile{tmp: tmp.Name(), path: path}, nil
+}
+
+// Transaction replaces several files as one unit. Stage writes each new
+// content to a temporary file beside its target, and Commit renames them
+// into place in the order staged, restoring the files it already replaced
+// if a later rename fails. Until Commit, no target is touched.
+type Transaction struct {
+	staged []stagedFile
+}
+
+// Stage writes the output of write as the new content of path. If write
+// fails the transaction is left as it was, so the caller can Abort it.
+func (tx *Transaction) Stage(path string, write func(w io.Writer) error) error {
+	f, err := stageFile(path, write)
+	if err != nil {
+		return err
+	}
+	tx.staged = append(tx.staged, f)
+	return nil
+}
+
+// Abort removes the staged files without replacing anything.
+func (tx *Transaction) Abort() {
+	for _, f := range tx.staged {
+		os.Remove(f.tmp)
+	}
+	tx.staged = nil
+}
+
+// Commit replaces every staged target. On error each target keeps, or
+// gets back, its content from before Commit.
+func (tx *Transaction) Commit() error {
+	defer tx.Abort()
+
+	// Keep the previous content so replaced files can be put back
+	type previous struct {
+		path    string
+		data    []byte
+		existed bool
+	}
+	var done []previous
+	rollback := func(cause error) error {
+		for i := len(done) - 1; i >= 0; i-- {
+			p := done[i]
+			var err error
+			if p.existed {
+				err = writeFileAtomic(p.path, func(w io.Writer) error {
+					_, err := w.Write(p.data)
+					return err
+				})
+			} else {
+				err = os.Remove(p.path)
+			}
+			if err != nil {
+				cause = errors.Join(cause, fmt.Errorf("failed to restore %s: %w", p.path, err))
+			}
+		}
+		return cause
+	}
+
+	for _, f := range tx.staged {
+		data, err := os.ReadFile(f.path)
+		existed := err == nil
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return rollback(fmt.Errorf("failed to read %s: %w", f.path, err))
+		}
+		if err := os.Rename(f.tmp, f.path); err != nil {
+			return rollback(fmt.Errorf("failed to replace %s: %w", f.path, err))
+		}
+		done = append(done, previous{path: f.path, data: data, existed: existed})
+		syncDir(filepath.Dir(f.path))
+	}
+	return nil
+}
+
+// syncDir flushes a directory entry so a rename survives a crash. Not every
+// platform supports syncing directories, so failures are ignored.
+func syncDir(dir string) {
+	if d, err := os.Open(dir); err == nil {
+		_ = d.Sync()
+		d.Close()
+	}
+}
@@ -0,0 +1,82 @@
+package database
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// largeCSV builds an RTM of n requirements with dependencies and links.
+func largeCSV(b *testing.B, n int) []byte {
+	b.Helper()
+	db := NewDatabase()
+	statuses := AllStatuses()
+	for i := 0; i < n; i++ {
+		req := NewRequirement(fmt.Sprintf("REQ-BENCH-%05d", i))
+		req.Category = fmt.Sprintf("CAT%d", i%20)
+		req.RequirementText = fmt.Sprintf("Requirement %d shall do something measurable", i)
+		req.Status = statuses[i%len(statuses)]
+		req.Phase = i%5 + 1
+		req.EffortWeeks = float64(i%4) + 0.5
+		if i > 0 {
+			req.Dependencies.Add(fmt.Sprintf("REQ-BENCH-%05d", i-1))
+		}
+		if i > 1 {
+			req.Dependencies.Add(fmt.Sprintf("REQ-BENCH-%05d", i-2))
+		}
+		req.Blocks.Add(fmt.Sprintf("REQ-BENCH-%05d", i+1))
+		req.SetExternalIDFor("github", fmt.Sprint(i))
+		req.TestModule = "internal/bench/bench_test.go"
+		req.TestFunction = fmt.Sprintf("TestBench%d", i)
+		if err := db.Add(req); err != nil {
+			b.Fatal(err)
+		}
+	}
+	var buf bytes.Buffer
+	if err := db.WriteCSV(&buf); err != nil {
+		b.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func BenchmarkLoadLarge(b *testing.B) {
+	data := largeCSV(b, 10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ReadCSV(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkStatusCounts(b *testing.B) {
+	db, err := ReadCSV(bytes.NewReader(largeCSV(b, 10000)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = db.StatusCounts()
+		_ = db.CompletionPercentage()
+		_ = db.Incomplete()
+	}
+}
+
+func BenchmarkFilterFields(b *testing.B) {
+	db, err := ReadCSV(bytes.NewReader(largeCSV(b, 10000)))
+	if err != nil {
+		b.Fatal(err)
+	}
+	complete := true
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = db.Filter(FilterOptions{IsComplete: &complete})
+		for _, req := range db.All() {
+			_ = req.Field("dependencies")
+			_ = req.Field("status")
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package database
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Inconsistency is a combination of a requirement's fields that cannot be
+// right, such as a completion date before its start date.
+type Inconsistency struct {
+	Message string
+
+	// Fix corrects the requirement. It is nil when the right value needs
+	// a human decision.
+	Fix func()
+}
+
+// Inconsistencies reports the values ReadCSV could not parse and checks a
+// requirement's effort, phase, status and dates against each other.
+func (r *Requirement) Inconsistencies() []Inconsistency {
+	var found []Inconsistency
+	add := func(format string, args ...interface{}) {
+		found = append(found, Inconsistency{Message: fmt.Sprintf(format, args...)})
+	}
+
+	for _, problem := range r.parseProblems {
+		add("%s", problem)
+	}
+
+	if r.EffortWeeks < 0 {
+		add("negative effort_weeks %g", r.EffortWeeks)
+	}
+	if r.Phase < 0 {
+		add("negative phase %d", r.Phase)
+	}
+	if r.Phase == 0 && r.StartedDate != "" {
+		add("started_date %s is set but no phase is assigned", r.StartedDate)
+	}
+
+	started, startedOK := parseDateColumn("started_date", r.StartedDate, add)
+	completed, completedOK := parseDateColumn("completed_date", r.CompletedDate, add)
+	if startedOK && completedOK && completed.Before(started) {
+		add("completed_date %s is before started_date %s", r.CompletedDate, r.StartedDate)
+	}
+
+	if r.IsComplete() && r.CompletedDate == "" {
+		found = append(found, Inconsistency{
+			Message: "status is COMPLETE but completed_date is empty",
+			Fix:     r.SetCompletedDate,
+		})
+	}
+	return found
+}
+
+// parseDateColumn parses a date column value in the configured layout or
+// as YYYY-MM-DD, reporting values that do not parse. It returns false for empty or invalid values.
+func parseDateColumn(column, value string, report func(string, ...interface{})) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	t, err := ParseDate(value)
+	if err != nil {
+		report("%s %q is not a %s date", column, value, dateLayoutName())
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// ExternalIDDuplicate is an external ID that more than one requirement
+// links to. Service is "" for a legacy unprefixed external_id.
+type ExternalIDDuplicate struct {
+	Service string
+	ID      string
+	ReqIDs  []string
+}
+
+// String returns the link as written in the external_id column, e.g.
+// "github:42".
+func (d ExternalIDDuplicate) String() string {
+	if d.Service == "" {
+		return d.ID
+	}
+	return d.Service + ":" + d.ID
+}
+
+// DuplicateExternalIDs finds the external IDs linked by more than one
+// requirement, per service, ordered by service and ID.
+func (db *Database) DuplicateExternalIDs() []ExternalIDDuplicate {
+	type link struct{ service, id string }
+	linked := make(map[link][]string)
+	for _, req := range db.order {
+		if req.ExternalID != "" {
+			l := link{"", req.ExternalID}
+			linked[l] = append(linked[l], req.ReqID)
+		}
+		for service, id := range req.ExternalIDs {
+			l := link{service, id}
+			linked[l] = append(linked[l], req.ReqID)
+		}
+	}
+
+	var dupes []ExternalIDDuplicate
+	for l, reqIDs := range linked {
+		if len(reqIDs) > 1 {
+			dupes = append(dupes, ExternalIDDuplicate{Service: l.service, ID: l.id, ReqIDs: reqIDs})
+		}
+	}
+	sort.Slice(dupes, func(i, j int) bool {
+		if dupes[i].Service != dupes[j].Service {
+			return dupes[i].Service < dupes[j].Service
+		}
+		return dupes[i].ID < dupes[j].ID
+	})
+	return dupes
+}
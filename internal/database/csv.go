@@ -1,6 +1,7 @@
 package database
 
 import (
+	"bufio"
 	"encoding/csv"
 	"fmt"
 	"io"
@@ -41,6 +42,17 @@ var standardColumns = []string{
 	"external_id",
 }
 
+// optionalColumns are RTM fields written only when at least one requirement
+// sets them, so databases that don't use them keep the standard schema.
+var optionalColumns = []string{
+	"due_date",
+	"release",
+	"blocked_external",
+	"commits",
+	"pull_requests",
+	"external_url",
+}
+
 // Load loads a database from a CSV file.
 func Load(path string) (*Database, error) {
 	file, err := os.Open(path)
@@ -58,7 +70,8 @@ func Load(path string) (*Database, error) {
 	return db, nil
 }
 
-// Save saves the database to a CSV file.
+// Save saves the database to a CSV file. The file is replaced atomically,
+// so an interrupted save never leaves a truncated database behind.
 func (db *Database) Save(path string) error {
 	if path == "" {
 		path = db.path
@@ -67,13 +80,7 @@ func (db *Database) Save(path string) error {
 		return fmt.Errorf("no path specified for saving database")
 	}
 
-	file, err := os.Create(path)
-	if err != nil {
-		return fmt.Errorf("failed to create database file: %w", err)
-	}
-	defer file.Close()
-
-	if err := db.WriteCSV(file); err != nil {
+	if err := writeFileAtomic(path, db.WriteCSV); err != nil {
 		return err
 	}
 
@@ -82,10 +89,34 @@ func (db *Database) Save(path string) error {
 	return nil
 }
 
-// ReadCSV reads requirements from a CSV reader.
+// utf8BOM is the byte order mark some editors write at the start of a
+// UTF-8 file.
+const utf8BOM = "\ufeff"
+
+// SkipBOM returns a reader positioned after a leading UTF-8 byte order
+// mark, if r starts with one.
+func SkipBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if prefix, err := br.Peek(len(utf8BOM)); err == nil && string(prefix) == utf8BOM {
+		_, _ = br.Discard(len(utf8BOM))
+	}
+	return br
+}
+
+// ReadCSV reads requirements from a CSV reader. A leading UTF-8 BOM is
+// ignored, and quoted fields may span lines.
 func ReadCSV(r io.Reader) (*Database, error) {
-	reader := csv.NewReader(r)
+	return ReadCSVWithMapping(r, nil)
+}
+
+// ReadCSVWithMapping reads requirements from a CSV reader whose columns may
+// use a foreign schema. Source columns are renamed to RTM fields and values
+// translated according to the mapping; unmapped columns are kept as extras.
+// A nil mapping behaves like ReadCSV.
+func ReadCSVWithMapping(r io.Reader, mapping *ColumnMapping) (*Database, error) {
+	reader := csv.NewReader(SkipBOM(r))
 	reader.FieldsPerRecord = -1 // Allow variable fields
+	reader.ReuseRecord = true   // parseRow copies out every value
 
 	// Read header
 	header, err := reader.Read()
@@ -93,32 +124,9 @@ func ReadCSV(r io.Reader) (*Database, error) {
 		return nil, fmt.Errorf("failed to read CSV header: %w", err)
 	}
 
-	// Build column index
-	colIndex := make(map[string]int)
-	extraCols := make([]string, 0)
-	for i, col := range header {
-		normalized := normalizeColumnName(col)
-		colIndex[normalized] = i
-
-		// Track extra columns not in standard set
-		isStandard := false
-		for _, std := range standardColumns {
-			if normalized == std {
-				isStandard = true
-				break
-			}
-		}
-		if !isStandard {
-			extraCols = append(extraCols, col)
-		}
-	}
-
-	// Verify required columns
-	requiredCols := []string{"req_id", "category", "requirement_text"}
-	for _, col := range requiredCols {
-		if _, ok := colIndex[col]; !ok {
-			return nil, fmt.Errorf("missing required column: %s", col)
-		}
+	colIndex, extraCols, err := indexColumns(header, mapping)
+	if err != nil {
+		return nil, err
 	}
 
 	db := NewDatabase()
@@ -134,11 +142,21 @@ func ReadCSV(r io.Reader) (*Database, error) {
 			return nil, fmt.Errorf("failed to read CSV row %d: %w", lineNum+1, err)
 		}
 		lineNum++
+		line, _ := reader.FieldPos(0)
+
+		if mapping != nil {
+			for field, idx := range colIndex {
+				if idx < len(record) {
+					record[idx] = mapping.translateValue(field, strings.TrimSpace(record[idx]))
+				}
+			}
+		}
 
 		req, err := parseRow(record, colIndex, extraCols)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse row %d: %w", lineNum, err)
 		}
+		req.line = line
 
 		if err := db.Add(req); err != nil {
 			return nil, fmt.Errorf("row %d: %w", lineNum, err)
@@ -148,11 +166,56 @@ func ReadCSV(r io.Reader) (*Database, error) {
 	return db, nil
 }
 
-// WriteCSV writes the database to a CSV writer.
+// indexColumns maps each RTM field in header to its index and lists the
+// extra columns, checking that the required columns are present.
+func indexColumns(header []string, mapping *ColumnMapping) (map[string]int, []string, error) {
+	colIndex := make(map[string]int)
+	extraCols := make([]string, 0)
+	for i, col := range header {
+		normalized := mapping.targetColumn(col)
+		colIndex[normalized] = i
+
+		// Track extra columns not in standard set
+		if !isStandardColumn(normalized) {
+			extraCols = append(extraCols, col)
+		}
+	}
+
+	// Verify required columns
+	requiredCols := []string{"req_id", "category", "requirement_text"}
+	for _, col := range requiredCols {
+		if _, ok := colIndex[col]; !ok {
+			return nil, nil, fmt.Errorf("missing required column: %s", col)
+		}
+	}
+	return colIndex, extraCols, nil
+}
+
+// WriteCSV writes the database to a CSV writer. Fields containing
+// newlines, quotes or commas are quoted so they read back unchanged.
 func (db *Database) WriteCSV(w io.Writer) error {
 	writer := csv.NewWriter(w)
 	defer writer.Flush()
 
+	header := db.columns()
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write rows
+	for _, req := range db.All() {
+		row := formatRow(req, header)
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row for %s: %w", req.ReqID, err)
+		}
+	}
+
+	return nil
+}
+
+// columns returns the columns the database is written with: the standard
+// columns, the optional ones in use and the extra columns in order.
+func (db *Database) columns() []string {
 	// Collect all extra columns used
 	extraCols := make(map[string]bool)
 	for _, req := range db.All() {
@@ -164,6 +227,11 @@ func (db *Database) WriteCSV(w io.Writer) error {
 	// Build header
 	header := make([]string, len(standardColumns))
 	copy(header, standardColumns)
+	for _, col := range optionalColumns {
+		if db.usesColumn(col) {
+			header = append(header, col)
+		}
+	}
 	extraColsList := make([]string, 0, len(extraCols))
 	for col := range extraCols {
 		extraColsList = append(extraColsList, col)
@@ -177,20 +245,40 @@ func (db *Database) WriteCSV(w io.Writer) error {
 		}
 	}
 	header = append(header, extraColsList...)
+	return header
+}
 
-	if err := writer.Write(header); err != nil {
-		return fmt.Errorf("failed to write CSV header: %w", err)
-	}
-
-	// Write rows
+// usesColumn reports whether any requirement has a value for an optional column.
+func (db *Database) usesColumn(col string) bool {
 	for _, req := range db.All() {
-		row := formatRow(req, header)
-		if err := writer.Write(row); err != nil {
-			return fmt.Errorf("failed to write CSV row for %s: %w", req.ReqID, err)
+		switch col {
+		case "due_date":
+			if req.DueDate != "" {
+				return true
+			}
+		case "release":
+			if req.Release != "" {
+				return true
+			}
+		case "blocked_external":
+			if req.BlockedExternal != "" {
+				return true
+			}
+		case "commits":
+			if req.Commits.Len() > 0 {
+				return true
+			}
+		case "pull_requests":
+			if req.PullRequests.Len() > 0 {
+				return true
+			}
+		case "external_url":
+			if len(req.ExternalURLs) > 0 {
+				return true
+			}
 		}
 	}
-
-	return nil
+	return false
 }
 
 // normalizeColumnName converts column names to snake_case.
@@ -228,7 +316,9 @@ func parseRow(record []string, colIndex map[string]int, extraCols []string) (*Re
 		return ""
 	}
 
-	req := NewRequirement(getValue("req_id"))
+	// Every field is set below, so skip the empty sets NewRequirement
+	// would allocate only to have them replaced
+	req := &Requirement{ReqID: getValue("req_id"), Extra: make(map[string]string)}
 	if req.ReqID == "" {
 		return nil, fmt.Errorf("req_id is required")
 	}
@@ -245,15 +335,20 @@ func parseRow(record []string, colIndex map[string]int, extraCols []string) (*Re
 	req.Sprint = getValue("sprint")
 	req.StartedDate = getValue("started_date")
 	req.CompletedDate = getValue("completed_date")
+	req.DueDate = getValue("due_date")
+	req.Release = getValue("release")
+	req.BlockedExternal = getValue("blocked_external")
 	req.RequirementFile = getValue("requirement_file")
-	req.ExternalID = getValue("external_id")
+	req.ExternalID, req.ExternalIDs = ParseExternalIDs(getValue("external_id"))
+	req.ExternalURLs = ParseExternalURLs(getValue("external_url"))
 
 	// Parse status
 	statusStr := getValue("status")
 	status, err := ParseStatus(statusStr)
 	if err != nil {
-		// Log warning but don't fail
+		// Keep loading, but remember the bad value for validate
 		status = StatusMissing
+		req.parseProblems = append(req.parseProblems, fmt.Sprintf("invalid status %q (read as %s)", statusStr, status))
 	}
 	req.Status = status
 
@@ -262,6 +357,7 @@ func parseRow(record []string, colIndex map[string]int, extraCols []string) (*Re
 	priority, err := ParsePriority(priorityStr)
 	if err != nil {
 		priority = PriorityMedium
+		req.parseProblems = append(req.parseProblems, fmt.Sprintf("invalid priority %q (read as %s)", priorityStr, priority))
 	}
 	req.Priority = priority
 
@@ -270,6 +366,8 @@ func parseRow(record []string, colIndex map[string]int, extraCols []string) (*Re
 	if phaseStr != "" {
 		if phase, err := strconv.Atoi(phaseStr); err == nil {
 			req.Phase = phase
+		} else {
+			req.parseProblems = append(req.parseProblems, fmt.Sprintf("invalid phase %q", phaseStr))
 		}
 	}
 
@@ -278,6 +376,8 @@ func parseRow(record []string, colIndex map[string]int, extraCols []string) (*Re
 	if effortStr != "" {
 		if effort, err := strconv.ParseFloat(effortStr, 64); err == nil {
 			req.EffortWeeks = effort
+		} else {
+			req.parseProblems = append(req.parseProblems, fmt.Sprintf("invalid effort_weeks %q", effortStr))
 		}
 	}
 
@@ -287,6 +387,10 @@ func parseRow(record []string, colIndex map[string]int, extraCols []string) (*Re
 	// Parse blocks
 	req.Blocks = ParseStringSet(getValue("blocks"))
 
+	// Parse implementation links
+	req.Commits = ParseStringSet(getValue("commits"))
+	req.PullRequests = ParseStringSet(getValue("pull_requests"))
+
 	// Parse extra columns
 	for _, col := range extraCols {
 		normalized := normalizeColumnName(col)
@@ -304,66 +408,81 @@ func parseRow(record []string, colIndex map[string]int, extraCols []string) (*Re
 // formatRow formats a Requirement as a CSV row.
 func formatRow(req *Requirement, header []string) []string {
 	row := make([]string, len(header))
-
 	for i, col := range header {
-		switch col {
-		case "req_id":
-			row[i] = req.ReqID
-		case "category":
-			row[i] = req.Category
-		case "subcategory":
-			row[i] = req.Subcategory
-		case "requirement_text":
-			row[i] = req.RequirementText
-		case "target_value":
-			row[i] = req.TargetValue
-		case "test_module":
-			row[i] = req.TestModule
-		case "test_function":
-			row[i] = req.TestFunction
-		case "validation_method":
-			row[i] = req.ValidationMethod
-		case "status":
-			row[i] = req.Status.String()
-		case "priority":
-			row[i] = req.Priority.String()
-		case "phase":
-			if req.Phase > 0 {
-				row[i] = strconv.Itoa(req.Phase)
-			}
-		case "notes":
-			row[i] = req.Notes
-		case "effort_weeks":
-			if req.EffortWeeks > 0 {
-				row[i] = strconv.FormatFloat(req.EffortWeeks, 'f', -1, 64)
-			}
-		case "dependencies":
-			row[i] = req.Dependencies.String()
-		case "blocks":
-			row[i] = req.Blocks.String()
-		case "assignee":
-			row[i] = req.Assignee
-		case "sprint":
-			row[i] = req.Sprint
-		case "started_date":
-			row[i] = req.StartedDate
-		case "completed_date":
-			row[i] = req.CompletedDate
-		case "requirement_file":
-			row[i] = req.RequirementFile
-		case "external_id":
-			row[i] = req.ExternalID
-		default:
-			// Extra column
-			if val, ok := req.Extra[col]; ok {
-				row[i] = val
-			}
-		}
+		row[i] = formatField(req, col)
 	}
-
 	return row
 }
 
+// formatField formats one column of a Requirement's CSV row.
+func formatField(req *Requirement, col string) string {
+	switch col {
+	case "req_id":
+		return req.ReqID
+	case "category":
+		return req.Category
+	case "subcategory":
+		return req.Subcategory
+	case "requirement_text":
+		return req.RequirementText
+	case "target_value":
+		return req.TargetValue
+	case "test_module":
+		return req.TestModule
+	case "test_function":
+		return req.TestFunction
+	case "validation_method":
+		return req.ValidationMethod
+	case "status":
+		return req.Status.String()
+	case "priority":
+		return req.Priority.String()
+	case "phase":
+		if req.Phase > 0 {
+			return strconv.Itoa(req.Phase)
+		}
+		return ""
+	case "notes":
+		return req.Notes
+	case "effort_weeks":
+		if req.EffortWeeks != 0 {
+			return strconv.FormatFloat(req.EffortWeeks, 'f', -1, 64)
+		}
+		return ""
+	case "dependencies":
+		return req.Dependencies.String()
+	case "blocks":
+		return req.Blocks.String()
+	case "assignee":
+		return req.Assignee
+	case "sprint":
+		return req.Sprint
+	case "started_date":
+		return req.StartedDate
+	case "completed_date":
+		return req.CompletedDate
+	case "due_date":
+		return req.DueDate
+	case "release":
+		return req.Release
+	case "blocked_external":
+		return req.BlockedExternal
+	case "commits":
+		return req.Commits.String()
+	case "pull_requests":
+		return req.PullRequests.String()
+	case "requirement_file":
+		return req.RequirementFile
+	case "external_id":
+		return FormatExternalIDs(req.ExternalID, req.ExternalIDs)
+	case "external_url":
+		return FormatExternalURLs(req.ExternalURLs)
+	default:
+		// Extra column
+		return req.Extra[col]
+	}
+}
+
 // FindDatabase searches for a database file starting from the given path.
 func FindDatabase(startPath string) (string, error) {
 	// Check common locations
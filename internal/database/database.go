@@ -3,6 +3,7 @@ package database
 import (
 	"fmt"
 	"sort"
+	"strings"
 )
 
 // Database is the in-memory RTM database.
@@ -10,21 +11,26 @@ type Database struct {
 	// requirements stores all requirements by ID.
 	requirements map[string]*Requirement
 
-	// order preserves insertion order for consistent output.
-	order []string
+	// order preserves insertion order for consistent output. It holds the
+	// requirements themselves so walking the database needs no lookups.
+	order []*Requirement
 
 	// path is the file path this database was loaded from.
 	path string
 
 	// dirty tracks if the database has been modified.
 	dirty bool
+
+	// statusCounts caches StatusCounts until the database is next
+	// modified. Nil when it has to be counted again.
+	statusCounts map[Status]int
 }
 
 // NewDatabase creates a new empty database.
 func NewDatabase() *Database {
 	return &Database{
 		requirements: make(map[string]*Requirement),
-		order:        make([]string, 0),
+		order:        make([]*Requirement, 0),
 	}
 }
 
@@ -43,6 +49,14 @@ func (db *Database) IsDirty() bool {
 	return db.dirty
 }
 
+// MarkDirty marks the database as modified. Callers that change a
+// requirement's status in place, rather than through Update, must call it
+// so cached status counts are recounted.
+func (db *Database) MarkDirty() {
+	db.dirty = true
+	db.statusCounts = nil
+}
+
 // MarkClean marks the database as saved.
 func (db *Database) MarkClean() {
 	db.dirty = false
@@ -73,8 +87,8 @@ func (db *Database) Add(req *Requirement) error {
 		return fmt.Errorf("requirement %q already exists", req.ReqID)
 	}
 	db.requirements[req.ReqID] = req
-	db.order = append(db.order, req.ReqID)
-	db.dirty = true
+	db.order = append(db.order, req)
+	db.MarkDirty()
 	return nil
 }
 
@@ -135,6 +149,25 @@ func (db *Database) Update(reqID string, updates map[string]interface{}) error {
 			if s, ok := value.(string); ok {
 				req.CompletedDate = s
 			}
+		case "due_date":
+			if s, ok := value.(string); ok {
+				if err := ValidateDueDate(s); err != nil {
+					return err
+				}
+				req.DueDate = s
+			}
+		case "release":
+			if s, ok := value.(string); ok {
+				req.Release = s
+			}
+		case "blocked_external":
+			if s, ok := value.(string); ok {
+				req.BlockedExternal = s
+			}
+		case "external_url":
+			if s, ok := value.(string); ok {
+				req.ExternalURLs = ParseExternalURLs(s)
+			}
 		// Add more fields as needed
 		default:
 			// Store unknown fields in Extra
@@ -147,7 +180,7 @@ func (db *Database) Update(reqID string, updates map[string]interface{}) error {
 		}
 	}
 
-	db.dirty = true
+	db.MarkDirty()
 	return nil
 }
 
@@ -157,41 +190,85 @@ func (db *Database) Remove(reqID string) error {
 		return fmt.Errorf("requirement %q not found", reqID)
 	}
 
+	removed := db.requirements[reqID]
 	delete(db.requirements, reqID)
 
 	// Remove from order
-	newOrder := make([]string, 0, len(db.order)-1)
-	for _, id := range db.order {
-		if id != reqID {
-			newOrder = append(newOrder, id)
+	newOrder := make([]*Requirement, 0, len(db.order)-1)
+	for _, req := range db.order {
+		if req != removed {
+			newOrder = append(newOrder, req)
 		}
 	}
 	db.order = newOrder
+	db.MarkDirty()
+	return nil
+}
+
+// Rename changes a requirement's ID, keeping its position and updating
+// dependency and blocks references on every other requirement.
+func (db *Database) Rename(oldID, newID string) error {
+	req := db.Get(oldID)
+	if req == nil {
+		return fmt.Errorf("requirement %q not found", oldID)
+	}
+	if newID == "" {
+		return fmt.Errorf("requirement ID cannot be empty")
+	}
+	if oldID == newID {
+		return nil
+	}
+	if db.Exists(newID) {
+		return fmt.Errorf("requirement %q already exists", newID)
+	}
+
+	delete(db.requirements, oldID)
+	req.ReqID = newID
+	db.requirements[newID] = req
+
+	for _, other := range db.requirements {
+		if other.Dependencies.Contains(oldID) {
+			other.Dependencies.Remove(oldID)
+			other.Dependencies.Add(newID)
+		}
+		if other.Blocks.Contains(oldID) {
+			other.Blocks.Remove(oldID)
+			other.Blocks.Add(newID)
+		}
+	}
+
 	db.dirty = true
 	return nil
 }
 
+// SortByID orders the requirements by ID per CompareReqIDs, so the
+// database is written in a stable order however it was built.
+func (db *Database) SortByID() {
+	sort.SliceStable(db.order, func(i, j int) bool {
+		return CompareReqIDs(db.order[i].ReqID, db.order[j].ReqID) < 0
+	})
+	db.dirty = true
+}
+
 // All returns all requirements in insertion order.
 func (db *Database) All() []*Requirement {
-	reqs := make([]*Requirement, 0, len(db.order))
-	for _, id := range db.order {
-		if req := db.requirements[id]; req != nil {
-			reqs = append(reqs, req)
-		}
-	}
-	return reqs
+	return append(make([]*Requirement, 0, len(db.order)), db.order...)
 }
 
 // IDs returns all requirement IDs in insertion order.
 func (db *Database) IDs() []string {
-	return append([]string{}, db.order...)
+	ids := make([]string, len(db.order))
+	for i, req := range db.order {
+		ids[i] = req.ReqID
+	}
+	return ids
 }
 
 // Filter returns requirements matching the given criteria.
 func (db *Database) Filter(opts FilterOptions) []*Requirement {
 	var results []*Requirement
 
-	for _, req := range db.All() {
+	for _, req := range db.order {
 		if opts.Status != nil && req.Status != *opts.Status {
 			continue
 		}
@@ -251,19 +328,33 @@ type FilterOptions struct {
 	Assignee   string
 }
 
-// StatusCounts returns a map of status to count.
+// StatusCounts returns a map of status to count. The counts are cached
+// until the database is next modified.
 func (db *Database) StatusCounts() map[Status]int {
-	counts := make(map[Status]int)
-	for _, req := range db.All() {
-		counts[req.Status]++
+	cached := db.cachedStatusCounts()
+	counts := make(map[Status]int, len(cached))
+	for status, n := range cached {
+		counts[status] = n
 	}
 	return counts
 }
 
+// cachedStatusCounts returns the cached status counts, counting them
+// first if the database changed since. Callers must not modify the map.
+func (db *Database) cachedStatusCounts() map[Status]int {
+	if db.statusCounts == nil {
+		db.statusCounts = make(map[Status]int)
+		for _, req := range db.order {
+			db.statusCounts[req.Status]++
+		}
+	}
+	return db.statusCounts
+}
+
 // PriorityCounts returns a map of priority to count.
 func (db *Database) PriorityCounts() map[Priority]int {
 	counts := make(map[Priority]int)
-	for _, req := range db.All() {
+	for _, req := range db.order {
 		counts[req.Priority]++
 	}
 	return counts
@@ -305,8 +396,8 @@ func (db *Database) CompletionPercentage() float64 {
 	}
 
 	var total float64
-	for _, req := range db.All() {
-		total += req.Status.CompletionPercent()
+	for status, n := range db.cachedStatusCounts() {
+		total += status.CompletionPercent() * float64(n)
 	}
 
 	return total / float64(db.Len())
@@ -321,6 +412,53 @@ func (db *Database) ByCategory() map[string][]*Requirement {
 	return result
 }
 
+// Releases returns all unique releases, skipping requirements without one.
+func (db *Database) Releases() []string {
+	seen := make(map[string]bool)
+	var releases []string
+	for _, req := range db.All() {
+		if req.Release != "" && !seen[req.Release] {
+			seen[req.Release] = true
+			releases = append(releases, req.Release)
+		}
+	}
+	sort.Strings(releases)
+	return releases
+}
+
+// ByRelease returns requirements grouped by release. Requirements without
+// a release are grouped under "".
+func (db *Database) ByRelease() map[string][]*Requirement {
+	result := make(map[string][]*Requirement)
+	for _, req := range db.All() {
+		result[req.Release] = append(result[req.Release], req)
+	}
+	return result
+}
+
+// ParentID returns the parent of a hierarchical requirement ID, the ID
+// without its last dotted segment: "REQ-AUTH-001.2" has the parent
+// "REQ-AUTH-001". It returns "" for top-level IDs.
+func ParentID(reqID string) string {
+	i := strings.LastIndex(reqID, ".")
+	if i <= 0 || i == len(reqID)-1 {
+		return ""
+	}
+	return reqID[:i]
+}
+
+// Children returns the direct children of a requirement, in insertion
+// order.
+func (db *Database) Children(reqID string) []*Requirement {
+	var children []*Requirement
+	for _, req := range db.All() {
+		if ParentID(req.ReqID) == reqID {
+			children = append(children, req)
+		}
+	}
+	return children
+}
+
 // ByPhase returns requirements grouped by phase.
 func (db *Database) ByPhase() map[int][]*Requirement {
 	result := make(map[int][]*Requirement)
@@ -330,10 +468,16 @@ func (db *Database) ByPhase() map[int][]*Requirement {
 	return result
 }
 
-// Incomplete returns all incomplete requirements.
+// Incomplete returns all incomplete requirements, as defined by
+// SetIncompleteStatuses.
 func (db *Database) Incomplete() []*Requirement {
-	complete := false
-	return db.Filter(FilterOptions{IsComplete: &complete})
+	var result []*Requirement
+	for _, req := range db.order {
+		if req.IsIncomplete() {
+			result = append(result, req)
+		}
+	}
+	return result
 }
 
 // Complete returns all complete requirements.
@@ -357,7 +501,7 @@ func (db *Database) Backlog() []*Requirement {
 			return incomplete[i].Phase < incomplete[j].Phase
 		}
 		// Then by ID
-		return incomplete[i].ReqID < incomplete[j].ReqID
+		return LessReqID(incomplete[i].ReqID, incomplete[j].ReqID)
 	})
 	return incomplete
 }
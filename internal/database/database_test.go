@@ -2,8 +2,14 @@ package database
 
 import (
 	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestStatusParsing(t *testing.T) {
@@ -250,6 +256,49 @@ func TestDatabaseFilter(t *testing.T) {
 	}
 }
 
+func TestStatusCountsCache(t *testing.T) {
+	db := NewDatabase()
+	for _, id := range []string{"REQ-A-001", "REQ-A-002"} {
+		if err := db.Add(NewRequirement(id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	counts := db.StatusCounts()
+	counts[StatusComplete] = 10
+	if got := db.StatusCounts()[StatusComplete]; got != 0 {
+		t.Errorf("StatusCounts shares its cache with callers: COMPLETE = %d, want 0", got)
+	}
+
+	if err := db.Update("REQ-A-001", map[string]interface{}{"status": StatusComplete}); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.CompletionPercentage(); got != 50 {
+		t.Errorf("CompletionPercentage after Update = %f, want 50", got)
+	}
+
+	db.Get("REQ-A-002").Status = StatusPartial
+	db.MarkDirty()
+	if got := db.CompletionPercentage(); got != 75 {
+		t.Errorf("CompletionPercentage after MarkDirty = %f, want 75", got)
+	}
+
+	if err := db.Remove("REQ-A-001"); err != nil {
+		t.Fatal(err)
+	}
+	counts = db.StatusCounts()
+	if counts[StatusComplete] != 0 || counts[StatusPartial] != 1 {
+		t.Errorf("StatusCounts after Remove = %v, want one PARTIAL", counts)
+	}
+
+	if err := db.Add(NewRequirement("REQ-A-003")); err != nil {
+		t.Fatal(err)
+	}
+	if got := db.StatusCounts()[StatusMissing]; got != 1 {
+		t.Errorf("StatusCounts[MISSING] after Add = %d, want 1", got)
+	}
+}
+
 func TestCSVRoundTrip(t *testing.T) {
 	// Create a database
 	db := NewDatabase()
@@ -433,3 +482,713 @@ REQ-003,TEST,Third requirement,PARTIAL,LOW,3,REQ-001|REQ-002
 		t.Error("REQ-002 should not be blocked (REQ-001 is complete)")
 	}
 }
+
+func TestReadCSVWithMapping(t *testing.T) {
+	csvData := `Key,Area,Summary,State,Severity,Owner,Team
+JIRA-1,CLI,First imported requirement,Done,Critical,alice,core
+JIRA-2,DATA,Second imported requirement,In Progress,Minor,bob,data
+JIRA-3,DATA,Third imported requirement,Backlog,,,
+`
+
+	mapping := NewColumnMapping()
+	for _, pair := range []string{
+		"Key=req_id",
+		"Area=category",
+		"Summary=requirement_text",
+		"State=status",
+		"Severity=priority",
+		"Owner=assignee",
+	} {
+		if err := mapping.ParseColumnPair(pair); err != nil {
+			t.Fatalf("ParseColumnPair(%q) failed: %v", pair, err)
+		}
+	}
+	mapping.Values["status"] = map[string]string{
+		"Done":        "COMPLETE",
+		"In Progress": "PARTIAL",
+		"backlog":     "NOT_STARTED",
+	}
+	mapping.Values["priority"] = map[string]string{
+		"Critical": "P0",
+		"Minor":    "LOW",
+	}
+
+	db, err := ReadCSVWithMapping(strings.NewReader(csvData), mapping)
+	if err != nil {
+		t.Fatalf("ReadCSVWithMapping failed: %v", err)
+	}
+
+	if db.Len() != 3 {
+		t.Fatalf("Expected 3 requirements, got %d", db.Len())
+	}
+
+	tests := []struct {
+		id       string
+		category string
+		text     string
+		status   Status
+		priority Priority
+		assignee string
+		team     string
+	}{
+		{"JIRA-1", "CLI", "First imported requirement", StatusComplete, PriorityP0, "alice", "core"},
+		{"JIRA-2", "DATA", "Second imported requirement", StatusPartial, PriorityLow, "bob", "data"},
+		{"JIRA-3", "DATA", "Third imported requirement", StatusNotStarted, PriorityMedium, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			req := db.Get(tt.id)
+			if req == nil {
+				t.Fatalf("%s not found", tt.id)
+			}
+			if req.Category != tt.category {
+				t.Errorf("Category = %q, want %q", req.Category, tt.category)
+			}
+			if req.RequirementText != tt.text {
+				t.Errorf("RequirementText = %q, want %q", req.RequirementText, tt.text)
+			}
+			if req.Status != tt.status {
+				t.Errorf("Status = %v, want %v", req.Status, tt.status)
+			}
+			if req.Priority != tt.priority {
+				t.Errorf("Priority = %v, want %v", req.Priority, tt.priority)
+			}
+			if req.Assignee != tt.assignee {
+				t.Errorf("Assignee = %q, want %q", req.Assignee, tt.assignee)
+			}
+			if req.Extra["Team"] != tt.team {
+				t.Errorf("Extra[Team] = %q, want %q", req.Extra["Team"], tt.team)
+			}
+		})
+	}
+}
+
+func TestColumnMappingValidate(t *testing.T) {
+	mapping := NewColumnMapping()
+	if err := mapping.ParseColumnPair("Summary"); err == nil {
+		t.Error("Expected error for pair without '='")
+	}
+
+	mapping.Columns["Summary"] = "not_a_field"
+	if err := mapping.Validate(); err == nil {
+		t.Error("Expected error for mapping to unknown field")
+	}
+}
+
+func TestExternalIDsRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		column  string
+		legacy  string
+		perSvc  map[string]string
+		written string
+	}{
+		{"legacy only", "42", "42", map[string]string{}, "42"},
+		{"per-service", "jira:PROJ-7|github:42", "", map[string]string{"github": "42", "jira": "PROJ-7"}, "github:42|jira:PROJ-7"},
+		{"mixed", "99|github:42", "99", map[string]string{"github": "42"}, "99|github:42"},
+		{"empty", "", "", map[string]string{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			csvData := "req_id,category,requirement_text,external_id\nREQ-001,CLI,Text," + tt.column + "\n"
+			db, err := ReadCSV(strings.NewReader(csvData))
+			if err != nil {
+				t.Fatalf("ReadCSV failed: %v", err)
+			}
+
+			req := db.Get("REQ-001")
+			if req.ExternalID != tt.legacy {
+				t.Errorf("ExternalID = %q, want %q", req.ExternalID, tt.legacy)
+			}
+			if len(req.ExternalIDs) != len(tt.perSvc) {
+				t.Errorf("ExternalIDs = %v, want %v", req.ExternalIDs, tt.perSvc)
+			}
+			for service, id := range tt.perSvc {
+				if got := req.ExternalIDFor(service); got != id {
+					t.Errorf("ExternalIDFor(%q) = %q, want %q", service, got, id)
+				}
+			}
+
+			var buf bytes.Buffer
+			if err := db.WriteCSV(&buf); err != nil {
+				t.Fatalf("WriteCSV failed: %v", err)
+			}
+			db2, err := ReadCSV(&buf)
+			if err != nil {
+				t.Fatalf("ReadCSV round trip failed: %v", err)
+			}
+			got := db2.Get("REQ-001")
+			if written := FormatExternalIDs(got.ExternalID, got.ExternalIDs); written != tt.written {
+				t.Errorf("external_id after round trip = %q, want %q", written, tt.written)
+			}
+		})
+	}
+}
+
+func TestSetExternalIDFor(t *testing.T) {
+	req := NewRequirement("REQ-001")
+	req.SetExternalIDFor("github", "42")
+	req.SetExternalIDFor("jira", "PROJ-7")
+	if req.ExternalIDFor("github") != "42" || req.ExternalIDFor("jira") != "PROJ-7" {
+		t.Errorf("ExternalIDs = %v", req.ExternalIDs)
+	}
+
+	clone := req.Clone()
+	clone.SetExternalIDFor("github", "")
+	if clone.ExternalIDFor("github") != "" {
+		t.Error("Expected empty ID to remove the link")
+	}
+	if req.ExternalIDFor("github") != "42" {
+		t.Error("Clone should not share ExternalIDs with the original")
+	}
+}
+
+func TestExternalURLsRoundTrip(t *testing.T) {
+	csvData := "req_id,category,requirement_text,external_url\n" +
+		"REQ-001,CLI,Text,jira:https://acme.atlassian.net/browse/PROJ-7|github:https://github.com/o/r/issues/42\n"
+	db, err := ReadCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("ReadCSV failed: %v", err)
+	}
+	req := db.Get("REQ-001")
+	if req.ExternalURLFor("github") != "https://github.com/o/r/issues/42" || req.ExternalURLFor("jira") != "https://acme.atlassian.net/browse/PROJ-7" {
+		t.Fatalf("ExternalURLs = %v", req.ExternalURLs)
+	}
+
+	req.SetExternalURLFor("gitlab", "https://gitlab.com/o/r/-/issues/1?a|b")
+	var buf bytes.Buffer
+	if err := db.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	db2, err := ReadCSV(&buf)
+	if err != nil {
+		t.Fatalf("ReadCSV round trip failed: %v", err)
+	}
+	got := db2.Get("REQ-001")
+	if len(got.ExternalURLs) != 3 || got.ExternalURLFor("gitlab") != "https://gitlab.com/o/r/-/issues/1?a|b" {
+		t.Errorf("ExternalURLs after round trip = %v", got.ExternalURLs)
+	}
+}
+
+func TestDueDateRoundTrip(t *testing.T) {
+	csvData := "req_id,category,requirement_text,due_date\nREQ-001,CLI,Text,2025-06-30\nREQ-002,CLI,Text,\n"
+	db, err := ReadCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("ReadCSV failed: %v", err)
+	}
+	if got := db.Get("REQ-001").DueDate; got != "2025-06-30" {
+		t.Errorf("DueDate = %q, want 2025-06-30", got)
+	}
+
+	var buf bytes.Buffer
+	if err := db.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	db2, err := ReadCSV(&buf)
+	if err != nil {
+		t.Fatalf("ReadCSV round trip failed: %v", err)
+	}
+	if got := db2.Get("REQ-001").DueDate; got != "2025-06-30" {
+		t.Errorf("DueDate after round trip = %q, want 2025-06-30", got)
+	}
+
+	// Databases without due dates keep the standard header
+	plain := NewDatabase()
+	_ = plain.Add(NewRequirement("REQ-003"))
+	buf.Reset()
+	if err := plain.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	if strings.Contains(buf.String(), "due_date") {
+		t.Errorf("Expected no due_date column when unused, got header %q", strings.SplitN(buf.String(), "\n", 2)[0])
+	}
+}
+
+func TestRequirementOverdue(t *testing.T) {
+	now := time.Date(2025, 6, 15, 14, 30, 0, 0, time.Local)
+
+	tests := []struct {
+		name      string
+		due       string
+		status    Status
+		overdue   bool
+		dueWithin bool // within 7 days
+	}{
+		{"past due", "2025-06-10", StatusMissing, true, false},
+		{"due today", "2025-06-15", StatusPartial, false, true},
+		{"due in a week", "2025-06-22", StatusMissing, false, true},
+		{"due later", "2025-07-01", StatusMissing, false, false},
+		{"completed past due", "2025-06-10", StatusComplete, false, false},
+		{"no due date", "", StatusMissing, false, false},
+		{"invalid due date", "soon", StatusMissing, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := NewRequirement("REQ-001")
+			req.DueDate = tt.due
+			req.Status = tt.status
+			if got := req.IsOverdue(now); got != tt.overdue {
+				t.Errorf("IsOverdue = %v, want %v", got, tt.overdue)
+			}
+			if got := req.IsDueWithin(now, 7*24*time.Hour); got != tt.dueWithin {
+				t.Errorf("IsDueWithin = %v, want %v", got, tt.dueWithin)
+			}
+		})
+	}
+}
+
+func TestDueDateLayout(t *testing.T) {
+	SetDateLayout("02.01.2006")
+	defer SetDateLayout("")
+
+	for _, value := range []string{"30.06.2025", "2025-06-30"} {
+		if err := ValidateDueDate(value); err != nil {
+			t.Errorf("ValidateDueDate(%q) = %v", value, err)
+		}
+		req := NewRequirement("REQ-001")
+		req.DueDate = value
+		if due, ok := req.Due(); !ok || due.Month() != time.June || due.Day() != 30 {
+			t.Errorf("Due() for %q = %v, %v", value, due, ok)
+		}
+	}
+	if err := ValidateDueDate("06/30/2025"); err == nil || !strings.Contains(err.Error(), "02.01.2006 or YYYY-MM-DD") {
+		t.Errorf("ValidateDueDate(06/30/2025) = %v, want a layout error", err)
+	}
+}
+
+func TestDatabaseRename(t *testing.T) {
+	db := NewDatabase()
+	for _, id := range []string{"REQ-A-001", "REQ-A-002", "REQ-B-001"} {
+		if err := db.Add(NewRequirement(id)); err != nil {
+			t.Fatalf("Add failed: %v", err)
+		}
+	}
+	db.Get("REQ-A-002").Dependencies.Add("REQ-A-001")
+	db.Get("REQ-B-001").Blocks.Add("REQ-A-001")
+
+	if err := db.Rename("REQ-A-001", "REQ-C-001"); err != nil {
+		t.Fatalf("Rename failed: %v", err)
+	}
+
+	if db.Exists("REQ-A-001") || db.Get("REQ-C-001") == nil {
+		t.Fatal("Expected requirement to be stored under its new ID")
+	}
+	if db.Get("REQ-C-001").ReqID != "REQ-C-001" {
+		t.Errorf("ReqID = %q", db.Get("REQ-C-001").ReqID)
+	}
+	if got := db.IDs(); got[0] != "REQ-C-001" {
+		t.Errorf("Expected renamed requirement to keep its position, got %v", got)
+	}
+	if !db.Get("REQ-A-002").Dependencies.Contains("REQ-C-001") || db.Get("REQ-A-002").Dependencies.Contains("REQ-A-001") {
+		t.Errorf("Dependencies not updated: %v", db.Get("REQ-A-002").Dependencies.Slice())
+	}
+	if !db.Get("REQ-B-001").Blocks.Contains("REQ-C-001") {
+		t.Errorf("Blocks not updated: %v", db.Get("REQ-B-001").Blocks.Slice())
+	}
+
+	if err := db.Rename("REQ-A-002", "REQ-B-001"); err == nil {
+		t.Error("Expected error renaming onto an existing ID")
+	}
+	if err := db.Rename("REQ-MISSING", "REQ-X-001"); err == nil {
+		t.Error("Expected error renaming a missing requirement")
+	}
+}
+
+func TestDatabaseSortByID(t *testing.T) {
+	db := NewDatabase()
+	for _, id := range []string{"REQ-B-002", "REQ-A-010", "REQ-A-002"} {
+		if err := db.Add(NewRequirement(id)); err != nil {
+			t.Fatalf("Add failed: %v", err)
+		}
+	}
+	db.MarkClean()
+
+	db.SortByID()
+	if got := strings.Join(db.IDs(), ","); got != "REQ-A-002,REQ-A-010,REQ-B-002" {
+		t.Errorf("IDs = %s, want them in ID order", got)
+	}
+	if !db.IsDirty() {
+		t.Error("Expected sorting to mark the database dirty")
+	}
+}
+
+func TestRequirementField(t *testing.T) {
+	req := NewRequirement("REQ-FLD-001")
+	req.Category = "FIELD"
+	req.Phase = 2
+	req.Status = StatusPartial
+	req.Extra["reviewer"] = "alice"
+
+	tests := map[string]string{
+		"req_id":   "REQ-FLD-001",
+		"category": "FIELD",
+		"phase":    "2",
+		"status":   "PARTIAL",
+		"reviewer": "alice",
+		"assignee": "",
+		"unknown":  "",
+	}
+	for column, want := range tests {
+		if got := req.Field(column); got != want {
+			t.Errorf("Field(%q) = %q, want %q", column, got, want)
+		}
+	}
+}
+
+func TestReadCSVWithBOM(t *testing.T) {
+	csvData := "\ufeffreq_id,category,requirement_text,status\nREQ-001,CLI,Text,COMPLETE\n"
+	db, err := ReadCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("ReadCSV with BOM failed: %v", err)
+	}
+	req := db.Get("REQ-001")
+	if req == nil {
+		t.Fatal("Expected REQ-001 to load despite the BOM")
+	}
+	if req.Category != "CLI" || req.Status != StatusComplete {
+		t.Errorf("Columns shifted: category=%q status=%q", req.Category, req.Status)
+	}
+}
+
+func TestMultilineTextRoundTrip(t *testing.T) {
+	text := "Users can reset passwords\n- link expires in 1h\n- old sessions end"
+	csvData := "req_id,category,requirement_text,status\r\n" +
+		"REQ-001,AUTH,\"Users can reset passwords\r\n- link expires in 1h\r\n- old sessions end\",MISSING\r\n" +
+		"REQ-002,AUTH,Next row,COMPLETE\r\n"
+	db, err := ReadCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("ReadCSV failed: %v", err)
+	}
+	if got := db.Get("REQ-001").RequirementText; got != text {
+		t.Errorf("RequirementText = %q, want %q", got, text)
+	}
+	if got := db.Get("REQ-002").Status; got != StatusComplete {
+		t.Errorf("Row after multiline field shifted, REQ-002 status = %q", got)
+	}
+
+	var buf bytes.Buffer
+	if err := db.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "\""+text+"\"") {
+		t.Errorf("Expected multiline text to be quoted, got:\n%s", buf.String())
+	}
+	db2, err := ReadCSV(&buf)
+	if err != nil {
+		t.Fatalf("ReadCSV round trip failed: %v", err)
+	}
+	if got := db2.Get("REQ-001").RequirementText; got != text {
+		t.Errorf("RequirementText after round trip = %q, want %q", got, text)
+	}
+	if db2.Len() != 2 {
+		t.Errorf("Len after round trip = %d, want 2", db2.Len())
+	}
+}
+
+func TestConfiguredStatuses(t *testing.T) {
+	defer SetCustomStatuses(nil)
+	defer SetIncompleteStatuses(nil)
+
+	if _, err := ParseStatus("IN_REVIEW"); err == nil {
+		t.Fatal("Expected IN_REVIEW to be invalid before it is configured")
+	}
+	SetCustomStatuses([]string{"in_review"})
+	status, err := ParseStatus("IN_REVIEW")
+	if err != nil || status != Status("IN_REVIEW") {
+		t.Fatalf("ParseStatus(IN_REVIEW) = %q, %v", status, err)
+	}
+
+	db := NewDatabase()
+	for id, s := range map[string]Status{"REQ-001": StatusMissing, "REQ-002": status, "REQ-003": StatusComplete} {
+		req := NewRequirement(id)
+		req.Status = s
+		_ = db.Add(req)
+	}
+	ids := func(reqs []*Requirement) string {
+		var out []string
+		for _, r := range reqs {
+			out = append(out, r.ReqID)
+		}
+		sort.Strings(out)
+		return strings.Join(out, ",")
+	}
+
+	// By default anything not COMPLETE is incomplete
+	if got := ids(db.Incomplete()); got != "REQ-001,REQ-002" {
+		t.Errorf("Incomplete() = %s, want REQ-001,REQ-002", got)
+	}
+
+	SetIncompleteStatuses([]string{"MISSING", "PARTIAL", "NOT_STARTED"})
+	if got := ids(db.Incomplete()); got != "REQ-001" {
+		t.Errorf("Incomplete() with IN_REVIEW done = %s, want REQ-001", got)
+	}
+	if got := ids(db.Backlog()); got != "REQ-001" {
+		t.Errorf("Backlog() with IN_REVIEW done = %s, want REQ-001", got)
+	}
+}
+
+func TestReadCSVLineNumbers(t *testing.T) {
+	csvData := "req_id,category,requirement_text,status\n" +
+		"REQ-001,AUTH,\"Spans\ntwo lines\",MISSING\n" +
+		"REQ-002,AUTH,Text,DONEISH\n"
+	db, err := ReadCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("ReadCSV failed: %v", err)
+	}
+	if got := db.Get("REQ-001").Line(); got != 2 {
+		t.Errorf("REQ-001 line = %d, want 2", got)
+	}
+	if got := db.Get("REQ-002").Line(); got != 4 {
+		t.Errorf("REQ-002 line = %d, want 4 after the multiline field", got)
+	}
+	if got := NewRequirement("REQ-003").Line(); got != 0 {
+		t.Errorf("Line of a requirement not read from CSV = %d, want 0", got)
+	}
+
+	problems := db.Get("REQ-002").Inconsistencies()
+	if len(problems) != 1 || problems[0].Message != `invalid status "DONEISH" (read as MISSING)` {
+		t.Errorf("Inconsistencies = %+v, want the invalid status", problems)
+	}
+}
+
+func TestReleaseRoundTrip(t *testing.T) {
+	db := NewDatabase()
+	req := NewRequirement("REQ-001")
+	req.Release = "v1.1"
+	_ = db.Add(req)
+	_ = db.Add(NewRequirement("REQ-002"))
+
+	var buf bytes.Buffer
+	if err := db.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	if header := strings.SplitN(buf.String(), "\n", 2)[0]; !strings.Contains(header, ",release") {
+		t.Errorf("Expected release column in header %q", header)
+	}
+	db2, err := ReadCSV(&buf)
+	if err != nil {
+		t.Fatalf("ReadCSV failed: %v", err)
+	}
+	if got := db2.Get("REQ-001").Release; got != "v1.1" {
+		t.Errorf("Release after round trip = %q, want v1.1", got)
+	}
+	if got := db2.Releases(); len(got) != 1 || got[0] != "v1.1" {
+		t.Errorf("Releases() = %v, want [v1.1]", got)
+	}
+	if err := db2.Update("REQ-002", map[string]interface{}{"release": "v1.0"}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if got := len(db2.ByRelease()["v1.0"]); got != 1 {
+		t.Errorf("ByRelease()[v1.0] has %d requirements, want 1", got)
+	}
+
+	// Databases without releases keep the standard header
+	plain := NewDatabase()
+	_ = plain.Add(NewRequirement("REQ-003"))
+	buf.Reset()
+	if err := plain.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	if strings.Contains(buf.String(), "release") {
+		t.Errorf("Expected no release column when unused, got header %q", strings.SplitN(buf.String(), "\n", 2)[0])
+	}
+}
+
+func TestSaveIsAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "database.csv")
+
+	db := NewDatabase()
+	_ = db.Add(NewRequirement("REQ-001"))
+	if err := db.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		t.Fatal(err)
+	}
+	original, _ := os.ReadFile(path)
+
+	// A write that fails after emitting more than a buffer's worth of output
+	errDisk := errors.New("disk full")
+	err := writeFileAtomic(path, func(w io.Writer) error {
+		_, _ = w.Write(bytes.Repeat([]byte("REQ-999,PARTIAL\n"), 1000))
+		return errDisk
+	})
+	if !errors.Is(err, errDisk) {
+		t.Fatalf("writeFileAtomic error = %v, want %v", err, errDisk)
+	}
+
+	if got, _ := os.ReadFile(path); !bytes.Equal(got, original) {
+		t.Errorf("Database changed after a failed write:\n%s", got)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		t.Errorf("Expected only the database in %s, found %v", dir, names)
+	}
+
+	// A successful save replaces the content and keeps the file mode
+	_ = db.Add(NewRequirement("REQ-002"))
+	if err := db.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.Len() != 2 {
+		t.Errorf("Expected 2 requirements after save, got %d", loaded.Len())
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0600 {
+		t.Errorf("File mode = %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func TestTransactionRollsBack(t *testing.T) {
+	dir := t.TempDir()
+	report := filepath.Join(dir, "report.xml")
+	if err := os.WriteFile(report, []byte("old report"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// A non-empty directory cannot be replaced by a file
+	blocked := filepath.Join(dir, "blocked")
+	if err := os.MkdirAll(filepath.Join(blocked, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	fresh := filepath.Join(dir, "fresh.csv")
+
+	content := func(s string) func(io.Writer) error {
+		return func(w io.Writer) error {
+			_, err := io.WriteString(w, s)
+			return err
+		}
+	}
+
+	var tx Transaction
+	for _, path := range []string{report, fresh, blocked} {
+		if err := tx.Stage(path, content("new")); err != nil {
+			t.Fatalf("Stage(%s) failed: %v", path, err)
+		}
+	}
+	if err := tx.Commit(); err == nil {
+		t.Fatal("Expected Commit to fail replacing a directory")
+	}
+
+	if got, _ := os.ReadFile(report); string(got) != "old report" {
+		t.Errorf("report = %q, want the content from before the transaction", got)
+	}
+	if _, err := os.Stat(fresh); !os.IsNotExist(err) {
+		t.Errorf("Expected %s removed again, got %v", fresh, err)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 2 {
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		t.Errorf("Expected only the original files in %s, found %v", dir, names)
+	}
+
+	// Without a failure every file is replaced
+	tx = Transaction{}
+	for _, path := range []string{report, fresh} {
+		if err := tx.Stage(path, content("new")); err != nil {
+			t.Fatalf("Stage(%s) failed: %v", path, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	for _, path := range []string{report, fresh} {
+		if got, _ := os.ReadFile(path); string(got) != "new" {
+			t.Errorf("%s = %q, want the staged content", path, got)
+		}
+	}
+}
+
+func TestChildren(t *testing.T) {
+	db := NewDatabase()
+	for _, id := range []string{"REQ-AUTH-001", "REQ-AUTH-001.2", "REQ-AUTH-001.1", "REQ-AUTH-001.1.1", "REQ-AUTH-0011"} {
+		_ = db.Add(NewRequirement(id))
+	}
+
+	var ids []string
+	for _, child := range db.Children("REQ-AUTH-001") {
+		ids = append(ids, child.ReqID)
+	}
+	if strings.Join(ids, ",") != "REQ-AUTH-001.2,REQ-AUTH-001.1" {
+		t.Errorf("Children = %v, want the direct children in insertion order", ids)
+	}
+
+	tests := map[string]string{
+		"REQ-AUTH-001.1":   "REQ-AUTH-001",
+		"REQ-AUTH-001.1.1": "REQ-AUTH-001.1",
+		"REQ-AUTH-001":     "",
+		"REQ-AUTH-001.":    "",
+		".1":               "",
+	}
+	for id, want := range tests {
+		if got := ParentID(id); got != want {
+			t.Errorf("ParentID(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestTextSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"User can login with a password", "User can login with password", 1},
+		{"The system SHALL export metrics.", "system exports metrics", 0.5},
+		{"Session expires", "User can login", 0},
+		{"", "anything", 0},
+	}
+	for _, tt := range tests {
+		if got := TextSimilarity(tt.a, tt.b); got != tt.want {
+			t.Errorf("TextSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	db := NewDatabase()
+	req := NewRequirement("REQ-SIM-001")
+	req.RequirementText = "User can login with a password"
+	_ = db.Add(req)
+	if match, _ := db.MostSimilar("user can log in with password", DefaultSimilarityThreshold); match != nil {
+		t.Errorf("Expected no match below the threshold, got %s", match.ReqID)
+	}
+	if match, score := db.MostSimilar("User can login with their password", DefaultSimilarityThreshold); match == nil || score < DefaultSimilarityThreshold {
+		t.Errorf("Expected REQ-SIM-001 to match, got %v (%v)", match, score)
+	}
+}
+
+func TestCompareReqIDs(t *testing.T) {
+	ids := []string{"REQ-X-100", "REQ-X-0010", "REQ-Y-001", "REQ-X-009", "REQ-X-1000", "REQ-X-011", "REQ-X-01", "REQ-X-001", "REQ-X"}
+	SortReqIDs(ids)
+	want := []string{"REQ-X", "REQ-X-001", "REQ-X-01", "REQ-X-009", "REQ-X-0010", "REQ-X-011", "REQ-X-100", "REQ-X-1000", "REQ-Y-001"}
+	if strings.Join(ids, " ") != strings.Join(want, " ") {
+		t.Errorf("SortReqIDs = %v, want %v", ids, want)
+	}
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"REQ-X-009", "REQ-X-010", -1},
+		{"REQ-X-010", "REQ-X-0009", 1},
+		{"REQ-X-0100", "REQ-X-100", -1},
+		{"REQ-X-100", "REQ-X-100", 0},
+		{"REQ-A-999", "REQ-B-001", -1},
+	}
+	for _, tt := range tests {
+		if got := CompareReqIDs(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareReqIDs(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
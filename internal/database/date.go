@@ -0,0 +1,62 @@
+package database
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultDateLayout is the layout of the dates rtmx writes unless another
+// is configured: YYYY-MM-DD.
+const DefaultDateLayout = "2006-01-02"
+
+// dateLayout is the Go time layout started_date and completed_date are
+// written in and dates are displayed in.
+var dateLayout = DefaultDateLayout
+
+// SetDateLayout sets the Go time layout dates are written and displayed
+// in, e.g. "02.01.2006". Empty restores YYYY-MM-DD.
+func SetDateLayout(layout string) {
+	if layout == "" {
+		layout = DefaultDateLayout
+	}
+	dateLayout = layout
+}
+
+// DateLayout returns the layout dates are written and displayed in.
+func DateLayout() string {
+	return dateLayout
+}
+
+// ValidateDateLayout checks that layout is a Go time layout that keeps the
+// year, month and day of a date.
+func ValidateDateLayout(layout string) error {
+	ref := time.Date(2001, time.February, 3, 0, 0, 0, 0, time.UTC)
+	t, err := time.Parse(layout, ref.Format(layout))
+	if err != nil || !t.Equal(ref) {
+		return fmt.Errorf("invalid date format %q (use a Go time layout with year, month and day, e.g. 2006-01-02)", layout)
+	}
+	return nil
+}
+
+// FormatDate formats t in the configured date layout.
+func FormatDate(t time.Time) string {
+	return t.Format(dateLayout)
+}
+
+// ParseDate parses value in the configured date layout or as YYYY-MM-DD,
+// in the local time zone.
+func ParseDate(value string) (time.Time, error) {
+	t, err := time.ParseInLocation(dateLayout, value, time.Local)
+	if err == nil || dateLayout == DefaultDateLayout {
+		return t, err
+	}
+	return time.ParseInLocation(DefaultDateLayout, value, time.Local)
+}
+
+// dateLayoutName describes the accepted dates in messages.
+func dateLayoutName() string {
+	if dateLayout == DefaultDateLayout {
+		return "YYYY-MM-DD"
+	}
+	return dateLayout + " or YYYY-MM-DD"
+}
@@ -0,0 +1,262 @@
+package database
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is a file format the database can be read from and written to.
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// AllFormats returns the supported formats.
+func AllFormats() []Format {
+	return []Format{FormatCSV, FormatJSON, FormatYAML}
+}
+
+// ParseFormat parses a format name, accepting "yml" for YAML.
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "csv":
+		return FormatCSV, nil
+	case "json":
+		return FormatJSON, nil
+	case "yaml", "yml":
+		return FormatYAML, nil
+	}
+	return "", fmt.Errorf("unsupported format %q (use csv, json or yaml)", s)
+}
+
+// FormatFromPath infers the format of a file from its extension.
+func FormatFromPath(path string) (Format, error) {
+	ext := strings.TrimPrefix(filepath.Ext(path), ".")
+	if ext == "" {
+		return "", fmt.Errorf("cannot infer the format of %s without an extension", path)
+	}
+	f, err := ParseFormat(ext)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", path, err)
+	}
+	return f, nil
+}
+
+// LoadFormat loads a database from a file in the given format.
+func LoadFormat(path string, f Format) (*Database, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	defer file.Close()
+
+	db, err := ReadFormat(file, f)
+	if err != nil {
+		return nil, err
+	}
+
+	db.path = path
+	return db, nil
+}
+
+// SaveFormat writes the database to a file in the given format, replacing
+// it atomically. Unlike Save it leaves the database's own path alone.
+func (db *Database) SaveFormat(path string, f Format) error {
+	return writeFileAtomic(path, func(w io.Writer) error {
+		return db.WriteFormat(w, f)
+	})
+}
+
+// ReadFormat reads requirements in the given format.
+func ReadFormat(r io.Reader, f Format) (*Database, error) {
+	switch f {
+	case FormatCSV:
+		return ReadCSV(r)
+	case FormatJSON:
+		return ReadJSON(r)
+	case FormatYAML:
+		return ReadYAML(r)
+	}
+	return nil, fmt.Errorf("unsupported format %q", f)
+}
+
+// WriteFormat writes the database in the given format.
+func (db *Database) WriteFormat(w io.Writer, f Format) error {
+	switch f {
+	case FormatCSV:
+		return db.WriteCSV(w)
+	case FormatJSON:
+		return db.WriteJSON(w)
+	case FormatYAML:
+		return db.WriteYAML(w)
+	}
+	return fmt.Errorf("unsupported format %q", f)
+}
+
+// ReadJSON reads requirements from a JSON array of objects keyed by CSV
+// column, as written by WriteJSON.
+func ReadJSON(r io.Reader) (*Database, error) {
+	var records []map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	return readRecords(records)
+}
+
+// ReadYAML reads requirements from a YAML list of mappings keyed by CSV
+// column, as written by WriteYAML.
+func ReadYAML(r io.Reader) (*Database, error) {
+	var records []map[string]interface{}
+	if err := yaml.NewDecoder(r).Decode(&records); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	return readRecords(records)
+}
+
+// readRecords builds a database from records keyed by column, parsing
+// each one as a CSV row with the same columns.
+func readRecords(records []map[string]interface{}) (*Database, error) {
+	if len(records) == 0 {
+		return NewDatabase(), nil
+	}
+
+	seen := make(map[string]bool)
+	var header []string
+	for _, record := range records {
+		keys := make([]string, 0, len(record))
+		for k := range record {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		header = append(header, keys...)
+	}
+
+	colIndex, extraCols, err := indexColumns(header, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	db := NewDatabase()
+	row := make([]string, len(header))
+	for i, record := range records {
+		for j, col := range header {
+			row[j] = recordValue(record[col])
+		}
+		req, err := parseRow(row, colIndex, extraCols)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse requirement %d: %w", i+1, err)
+		}
+		if err := db.Add(req); err != nil {
+			return nil, fmt.Errorf("requirement %d: %w", i+1, err)
+		}
+	}
+	return db, nil
+}
+
+// recordValue converts a decoded JSON or YAML value to its CSV cell. Lists
+// become pipe-separated, as dependencies are in the CSV.
+func recordValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = recordValue(item)
+		}
+		return strings.Join(parts, "|")
+	}
+	return fmt.Sprint(v)
+}
+
+// WriteJSON writes the database as a JSON array with one object per
+// requirement, keyed by CSV column in column order. Empty cells are left
+// out.
+func (db *Database) WriteJSON(w io.Writer) error {
+	header := db.columns()
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, req := range db.All() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('{')
+		first := true
+		for j, value := range formatRow(req, header) {
+			if value == "" {
+				continue
+			}
+			if !first {
+				buf.WriteByte(',')
+			}
+			first = false
+			key, _ := json.Marshal(header[j])
+			val, _ := json.Marshal(value)
+			buf.Write(key)
+			buf.WriteByte(':')
+			buf.Write(val)
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte(']')
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, buf.Bytes(), "", "  "); err != nil {
+		return fmt.Errorf("failed to write JSON: %w", err)
+	}
+	out.WriteByte('\n')
+	if _, err := w.Write(out.Bytes()); err != nil {
+		return fmt.Errorf("failed to write JSON: %w", err)
+	}
+	return nil
+}
+
+// WriteYAML writes the database as a YAML list with one mapping per
+// requirement, keyed by CSV column in column order. Empty cells are left
+// out.
+func (db *Database) WriteYAML(w io.Writer) error {
+	header := db.columns()
+	list := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, req := range db.All() {
+		m := &yaml.Node{Kind: yaml.MappingNode}
+		for j, value := range formatRow(req, header) {
+			if value == "" {
+				continue
+			}
+			m.Content = append(m.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: header[j]},
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value},
+			)
+		}
+		list.Content = append(list.Content, m)
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(list); err != nil {
+		return fmt.Errorf("failed to write YAML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("failed to write YAML: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package database
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ColumnMapping describes how a foreign CSV schema maps onto RTM fields.
+type ColumnMapping struct {
+	// Columns maps source column names to RTM field names
+	// (e.g. "Summary" -> "requirement_text").
+	Columns map[string]string `yaml:"columns"`
+
+	// Values maps an RTM field name to a table of source value -> RTM value
+	// translations (e.g. "status": {"Done": "COMPLETE"}).
+	Values map[string]map[string]string `yaml:"values"`
+}
+
+// NewColumnMapping creates an empty column mapping.
+func NewColumnMapping() *ColumnMapping {
+	return &ColumnMapping{
+		Columns: make(map[string]string),
+		Values:  make(map[string]map[string]string),
+	}
+}
+
+// LoadColumnMapping loads a column mapping from a YAML file.
+func LoadColumnMapping(path string) (*ColumnMapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mapping file: %w", err)
+	}
+
+	m := NewColumnMapping()
+	if err := yaml.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("failed to parse mapping file: %w", err)
+	}
+	if m.Columns == nil {
+		m.Columns = make(map[string]string)
+	}
+	if m.Values == nil {
+		m.Values = make(map[string]map[string]string)
+	}
+
+	return m, m.Validate()
+}
+
+// ParseColumnPair parses a "Source=field" pair and adds it to the mapping.
+func (m *ColumnMapping) ParseColumnPair(pair string) error {
+	parts := strings.SplitN(pair, "=", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return fmt.Errorf("invalid column mapping %q (expected Source=field)", pair)
+	}
+	m.Columns[strings.TrimSpace(parts[0])] = normalizeColumnName(parts[1])
+	return nil
+}
+
+// Validate checks that every mapped column targets a known RTM field.
+func (m *ColumnMapping) Validate() error {
+	for src, dst := range m.Columns {
+		if !isStandardColumn(normalizeColumnName(dst)) {
+			return fmt.Errorf("column %q maps to unknown field %q", src, dst)
+		}
+	}
+	for field := range m.Values {
+		if !isStandardColumn(normalizeColumnName(field)) {
+			return fmt.Errorf("value mapping for unknown field %q", field)
+		}
+	}
+	return nil
+}
+
+// targetColumn returns the RTM field a source column maps to, or the
+// normalized source name when no mapping applies.
+func (m *ColumnMapping) targetColumn(src string) string {
+	if m != nil {
+		if dst, ok := m.Columns[src]; ok {
+			return normalizeColumnName(dst)
+		}
+		// Fall back to a case-insensitive match on the source name
+		for k, dst := range m.Columns {
+			if strings.EqualFold(strings.TrimSpace(k), strings.TrimSpace(src)) {
+				return normalizeColumnName(dst)
+			}
+		}
+	}
+	return normalizeColumnName(src)
+}
+
+// translateValue applies the value mapping for an RTM field.
+func (m *ColumnMapping) translateValue(field, value string) string {
+	if m == nil {
+		return value
+	}
+
+	var values map[string]string
+	for k, v := range m.Values {
+		if normalizeColumnName(k) == field {
+			values = v
+			break
+		}
+	}
+	if values == nil {
+		return value
+	}
+
+	if mapped, ok := values[value]; ok {
+		return mapped
+	}
+	for k, mapped := range values {
+		if strings.EqualFold(k, value) {
+			return mapped
+		}
+	}
+	return value
+}
+
+// isStandardColumn reports whether name is one of the standard or optional RTM columns.
+func isStandardColumn(name string) bool {
+	for _, std := range standardColumns {
+		if name == std {
+			return true
+		}
+	}
+	for _, opt := range optionalColumns {
+		if name == opt {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package database
+
+import (
+	"sort"
+	"strings"
+)
+
+// CompareReqIDs orders requirement IDs with runs of digits compared by
+// value, so REQ-X-009 < REQ-X-010 < REQ-X-100 whatever their padding. IDs
+// that differ only in padding, like REQ-X-01 and REQ-X-001, fall back to
+// plain string order. It returns -1, 0 or +1.
+func CompareReqIDs(a, b string) int {
+	x, y := a, b
+	for x != "" && y != "" {
+		if isDigit(x[0]) && isDigit(y[0]) {
+			var nx, ny string
+			nx, x = splitDigits(x)
+			ny, y = splitDigits(y)
+			nx, ny = strings.TrimLeft(nx, "0"), strings.TrimLeft(ny, "0")
+			if len(nx) != len(ny) {
+				if len(nx) < len(ny) {
+					return -1
+				}
+				return 1
+			}
+			if c := strings.Compare(nx, ny); c != 0 {
+				return c
+			}
+			continue
+		}
+		if x[0] != y[0] {
+			if x[0] < y[0] {
+				return -1
+			}
+			return 1
+		}
+		x, y = x[1:], y[1:]
+	}
+	if x != y {
+		if x == "" {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// LessReqID reports whether requirement ID a sorts before b, per
+// CompareReqIDs.
+func LessReqID(a, b string) bool {
+	return CompareReqIDs(a, b) < 0
+}
+
+// SortReqIDs sorts requirement IDs in place per CompareReqIDs.
+func SortReqIDs(ids []string) {
+	sort.Slice(ids, func(i, j int) bool { return LessReqID(ids[i], ids[j]) })
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// splitDigits splits s after its leading run of digits.
+func splitDigits(s string) (digits, rest string) {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i], s[i:]
+}
@@ -1,6 +1,8 @@
 package database
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 	"time"
 )
@@ -31,6 +33,11 @@ type Requirement struct {
 	EffortWeeks float64 `csv:"effort_weeks" json:"effort_weeks"`
 	Assignee    string  `csv:"assignee" json:"assignee"`
 	Sprint      string  `csv:"sprint" json:"sprint"`
+	Release     string  `csv:"release" json:"release,omitempty"`
+
+	// BlockedExternal says what blocks the requirement outside the RTM,
+	// such as a flagged issue in a linked tracker. Empty when nothing does.
+	BlockedExternal string `csv:"blocked_external" json:"blocked_external,omitempty"`
 
 	// Dependencies (stored as pipe-separated strings in CSV)
 	Dependencies StringSet `csv:"dependencies" json:"dependencies"`
@@ -39,13 +46,35 @@ type Requirement struct {
 	// Dates
 	StartedDate   string `csv:"started_date" json:"started_date"`
 	CompletedDate string `csv:"completed_date" json:"completed_date"`
+	DueDate       string `csv:"due_date" json:"due_date,omitempty"`
 
 	// External references
 	RequirementFile string `csv:"requirement_file" json:"requirement_file"`
 	ExternalID      string `csv:"external_id" json:"external_id"`
 
+	// ExternalURLs maps a service name to the web address of the linked
+	// item, set by sync when it imports or exports the requirement. Stored
+	// in the external_url column as "github:https://...|jira:https://...".
+	ExternalURLs map[string]string `csv:"-" json:"external_urls,omitempty"`
+
+	// Implementation links (stored as pipe-separated strings in CSV)
+	Commits      StringSet `csv:"commits" json:"commits,omitempty"`
+	PullRequests StringSet `csv:"pull_requests" json:"pull_requests,omitempty"`
+
+	// ExternalIDs maps a service name to its external ID (e.g. "github" -> "42").
+	// Stored alongside ExternalID in the external_id column as "github:42|jira:PROJ-7".
+	ExternalIDs map[string]string `csv:"-" json:"external_ids,omitempty"`
+
 	// Extensible fields
 	Extra map[string]string `csv:"-" json:"extra,omitempty"`
+
+	// line is the CSV line the requirement was read from, 0 if it was not
+	// read from a file.
+	line int
+
+	// parseProblems are the values ReadCSV could not parse and replaced
+	// with a default.
+	parseProblems []string
 }
 
 // StringSet is a set of strings, stored as pipe-separated in CSV.
@@ -64,8 +93,13 @@ func NewStringSet(items ...string) StringSet {
 
 // ParseStringSet parses a pipe-separated string into a StringSet.
 func ParseStringSet(s string) StringSet {
-	set := make(StringSet)
-	for _, item := range strings.Split(s, "|") {
+	if strings.TrimSpace(s) == "" {
+		return make(StringSet)
+	}
+	set := make(StringSet, strings.Count(s, "|")+1)
+	for s != "" {
+		var item string
+		item, s, _ = strings.Cut(s, "|")
 		if item = strings.TrimSpace(item); item != "" {
 			set[item] = struct{}{}
 		}
@@ -98,13 +132,7 @@ func (s StringSet) Slice() []string {
 		items = append(items, item)
 	}
 	// Sort for deterministic output
-	for i := 0; i < len(items)-1; i++ {
-		for j := i + 1; j < len(items); j++ {
-			if items[i] > items[j] {
-				items[i], items[j] = items[j], items[i]
-			}
-		}
-	}
+	sort.Strings(items)
 	return items
 }
 
@@ -126,15 +154,31 @@ func NewRequirement(reqID string) *Requirement {
 		Priority:     PriorityMedium,
 		Dependencies: make(StringSet),
 		Blocks:       make(StringSet),
+		Commits:      make(StringSet),
+		PullRequests: make(StringSet),
+		ExternalIDs:  make(map[string]string),
+		ExternalURLs: make(map[string]string),
 		Extra:        make(map[string]string),
 	}
 }
 
+// Line returns the line of the CSV file the requirement's row starts on,
+// or 0 if it was not read from a file.
+func (r *Requirement) Line() int {
+	return r.line
+}
+
 // HasTest returns true if the requirement has a test assigned.
 func (r *Requirement) HasTest() bool {
 	return r.TestModule != "" && r.TestFunction != ""
 }
 
+// Field returns the requirement's value for a CSV column, as it would be
+// written to the database. Unknown columns are looked up in Extra.
+func (r *Requirement) Field(column string) string {
+	return formatField(r, column)
+}
+
 // IsComplete returns true if the requirement is complete.
 func (r *Requirement) IsComplete() bool {
 	return r.Status.IsComplete()
@@ -178,16 +222,68 @@ func (r *Requirement) BlockingDeps(db *Database) []string {
 	return blocking
 }
 
-// SetStartedDate sets the started date to today if not already set.
+// SetStartedDate sets the started date to today, in the configured date
+// layout, if not already set.
 func (r *Requirement) SetStartedDate() {
 	if r.StartedDate == "" {
-		r.StartedDate = time.Now().Format("2006-01-02")
+		r.StartedDate = FormatDate(time.Now())
 	}
 }
 
-// SetCompletedDate sets the completed date to today.
+// SetCompletedDate sets the completed date to today, in the configured
+// date layout.
 func (r *Requirement) SetCompletedDate() {
-	r.CompletedDate = time.Now().Format("2006-01-02")
+	r.CompletedDate = FormatDate(time.Now())
+}
+
+// Due returns the parsed due date, or false if none is set or it is invalid.
+func (r *Requirement) Due() (time.Time, bool) {
+	if r.DueDate == "" {
+		return time.Time{}, false
+	}
+	due, err := ParseDate(r.DueDate)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return due, true
+}
+
+// IsOverdue returns true if the requirement is incomplete and its due date
+// is before the day of now.
+func (r *Requirement) IsOverdue(now time.Time) bool {
+	due, ok := r.Due()
+	if !ok || r.IsComplete() {
+		return false
+	}
+	return due.Before(startOfDay(now))
+}
+
+// IsDueWithin returns true if the requirement is incomplete and due between
+// today and today plus window (inclusive). Overdue requirements are excluded.
+func (r *Requirement) IsDueWithin(now time.Time, window time.Duration) bool {
+	due, ok := r.Due()
+	if !ok || r.IsComplete() {
+		return false
+	}
+	today := startOfDay(now)
+	return !due.Before(today) && !due.After(today.Add(window))
+}
+
+// ValidateDueDate checks that a due date is empty or in the configured date
+// layout or YYYY-MM-DD.
+func ValidateDueDate(value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := ParseDate(value); err != nil {
+		return fmt.Errorf("invalid due date %q (expected %s)", value, dateLayoutName())
+	}
+	return nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.In(time.Local).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
 }
 
 // Clone creates a deep copy of the requirement.
@@ -201,9 +297,151 @@ func (r *Requirement) Clone() *Requirement {
 	for k := range r.Blocks {
 		clone.Blocks[k] = struct{}{}
 	}
+	clone.Commits = make(StringSet)
+	for k := range r.Commits {
+		clone.Commits[k] = struct{}{}
+	}
+	clone.PullRequests = make(StringSet)
+	for k := range r.PullRequests {
+		clone.PullRequests[k] = struct{}{}
+	}
+	clone.ExternalIDs = make(map[string]string)
+	for k, v := range r.ExternalIDs {
+		clone.ExternalIDs[k] = v
+	}
+	clone.ExternalURLs = make(map[string]string)
+	for k, v := range r.ExternalURLs {
+		clone.ExternalURLs[k] = v
+	}
 	clone.Extra = make(map[string]string)
 	for k, v := range r.Extra {
 		clone.Extra[k] = v
 	}
 	return &clone
 }
+
+// ExternalIDFor returns the external ID linked for a service, or "".
+func (r *Requirement) ExternalIDFor(service string) string {
+	return r.ExternalIDs[service]
+}
+
+// SetExternalIDFor links the requirement to an external ID in a service.
+// An empty id removes the link.
+func (r *Requirement) SetExternalIDFor(service, id string) {
+	if id == "" {
+		delete(r.ExternalIDs, service)
+		return
+	}
+	if r.ExternalIDs == nil {
+		r.ExternalIDs = make(map[string]string)
+	}
+	r.ExternalIDs[service] = id
+}
+
+// ExternalURLFor returns the web address of the item linked in a service,
+// or "".
+func (r *Requirement) ExternalURLFor(service string) string {
+	return r.ExternalURLs[service]
+}
+
+// SetExternalURLFor records the web address of the item linked in a
+// service. An empty url removes it.
+func (r *Requirement) SetExternalURLFor(service, url string) {
+	if url == "" {
+		delete(r.ExternalURLs, service)
+		return
+	}
+	if r.ExternalURLs == nil {
+		r.ExternalURLs = make(map[string]string)
+	}
+	r.ExternalURLs[service] = url
+}
+
+// ParseExternalIDs splits an external_id column value into an unprefixed
+// legacy ID and per-service IDs. "github:42|jira:PROJ-7" yields
+// {"github": "42", "jira": "PROJ-7"}; a bare "42" is returned as legacy.
+func ParseExternalIDs(value string) (string, map[string]string) {
+	ids := make(map[string]string)
+	var legacy []string
+	for value != "" {
+		var part string
+		part, value, _ = strings.Cut(value, "|")
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if idx := strings.Index(part, ":"); idx > 0 && isServiceName(part[:idx]) && idx < len(part)-1 {
+			ids[part[:idx]] = part[idx+1:]
+			continue
+		}
+		legacy = append(legacy, part)
+	}
+	return strings.Join(legacy, "|"), ids
+}
+
+// FormatExternalIDs joins a legacy ID and per-service IDs into the
+// external_id column format, with services in sorted order.
+func FormatExternalIDs(legacy string, ids map[string]string) string {
+	parts := make([]string, 0, len(ids)+1)
+	if legacy != "" {
+		parts = append(parts, legacy)
+	}
+	services := make([]string, 0, len(ids))
+	for service := range ids {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		if ids[service] != "" {
+			parts = append(parts, service+":"+ids[service])
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+// ParseExternalURLs splits an external_url column value into per-service
+// web addresses. "github:https://github.com/o/r/issues/42" yields
+// {"github": "https://github.com/o/r/issues/42"}; entries without a
+// service prefix are dropped.
+func ParseExternalURLs(value string) map[string]string {
+	urls := make(map[string]string)
+	for _, part := range strings.Split(value, "|") {
+		service, url, ok := strings.Cut(strings.TrimSpace(part), ":")
+		// A bare "https://..." has no service in front of it
+		if !ok || !isServiceName(service) || url == "" || strings.HasPrefix(url, "//") {
+			continue
+		}
+		urls[service] = strings.ReplaceAll(url, "%7C", "|")
+	}
+	return urls
+}
+
+// FormatExternalURLs joins per-service web addresses into the
+// external_url column format, with services in sorted order.
+func FormatExternalURLs(urls map[string]string) string {
+	services := make([]string, 0, len(urls))
+	for service := range urls {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	parts := make([]string, 0, len(services))
+	for _, service := range services {
+		if urls[service] != "" {
+			parts = append(parts, service+":"+strings.ReplaceAll(urls[service], "|", "%7C"))
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+// isServiceName reports whether s looks like a service prefix (lowercase word).
+func isServiceName(s string) bool {
+	for i, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case i > 0 && (c >= '0' && c <= '9' || c == '-' || c == '_'):
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
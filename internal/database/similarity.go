@@ -0,0 +1,63 @@
+package database
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DefaultSimilarityThreshold is the TextSimilarity at or above which two
+// requirement texts are treated as near-duplicates.
+const DefaultSimilarityThreshold = 0.8
+
+// similarityStopWords are ignored when comparing requirement texts.
+var similarityStopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "and": true, "or": true, "of": true,
+	"to": true, "in": true, "on": true, "for": true, "be": true, "is": true,
+	"shall": true, "should": true, "must": true,
+}
+
+// NormalizeText lowercases text and reduces it to its words, dropping
+// punctuation and stop words, so texts differing only in wording noise
+// compare equal.
+func NormalizeText(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	kept := words[:0]
+	for _, w := range words {
+		if !similarityStopWords[w] {
+			kept = append(kept, w)
+		}
+	}
+	return strings.Join(kept, " ")
+}
+
+// TextSimilarity returns the Jaccard similarity of the normalized words of
+// a and b, from 0 (nothing shared) to 1 (the same words).
+func TextSimilarity(a, b string) float64 {
+	wordsA := NewStringSet(strings.Fields(NormalizeText(a))...)
+	wordsB := NewStringSet(strings.Fields(NormalizeText(b))...)
+	if wordsA.Len() == 0 || wordsB.Len() == 0 {
+		return 0
+	}
+	shared := 0
+	for w := range wordsA {
+		if wordsB.Contains(w) {
+			shared++
+		}
+	}
+	return float64(shared) / float64(wordsA.Len()+wordsB.Len()-shared)
+}
+
+// MostSimilar returns the requirement whose text is most similar to text
+// and its similarity, or nil when none reaches threshold.
+func (db *Database) MostSimilar(text string, threshold float64) (*Requirement, float64) {
+	var best *Requirement
+	bestScore := 0.0
+	for _, req := range db.order {
+		if score := TextSimilarity(text, req.RequirementText); score >= threshold && score > bestScore {
+			best, bestScore = req, score
+		}
+	}
+	return best, bestScore
+}
@@ -16,6 +16,40 @@ const (
 	StatusNotStarted Status = "NOT_STARTED"
 )
 
+var (
+	// customStatuses are the statuses ParseStatus accepts besides the
+	// built-in ones.
+	customStatuses = map[Status]bool{}
+
+	// incompleteStatuses are the statuses IsIncomplete reports. Empty
+	// means every status except COMPLETE.
+	incompleteStatuses = map[Status]bool{}
+)
+
+// SetCustomStatuses makes ParseStatus accept statuses besides COMPLETE,
+// PARTIAL, MISSING and NOT_STARTED, such as IN_REVIEW. Custom statuses
+// sort after the built-in ones and count as 0% complete.
+func SetCustomStatuses(statuses []string) {
+	customStatuses = statusSet(statuses)
+}
+
+// SetIncompleteStatuses sets the statuses that count as incomplete and so
+// belong in the backlog. An empty list restores the default: every status
+// except COMPLETE.
+func SetIncompleteStatuses(statuses []string) {
+	incompleteStatuses = statusSet(statuses)
+}
+
+func statusSet(statuses []string) map[Status]bool {
+	set := make(map[Status]bool, len(statuses))
+	for _, s := range statuses {
+		if s = strings.ToUpper(strings.TrimSpace(s)); s != "" {
+			set[Status(s)] = true
+		}
+	}
+	return set
+}
+
 // AllStatuses returns all valid status values.
 func AllStatuses() []Status {
 	return []Status{StatusComplete, StatusPartial, StatusMissing, StatusNotStarted}
@@ -33,6 +67,9 @@ func ParseStatus(s string) (Status, error) {
 	case "NOT_STARTED":
 		return StatusNotStarted, nil
 	default:
+		if custom := Status(strings.ToUpper(strings.TrimSpace(s))); customStatuses[custom] {
+			return custom, nil
+		}
 		return StatusMissing, fmt.Errorf("invalid status: %q", s)
 	}
 }
@@ -47,8 +84,12 @@ func (s Status) IsComplete() bool {
 	return s == StatusComplete
 }
 
-// IsIncomplete returns true if the status is not COMPLETE.
+// IsIncomplete returns true if the status is one of the configured
+// incomplete statuses, by default any status but COMPLETE.
 func (s Status) IsIncomplete() bool {
+	if len(incompleteStatuses) > 0 {
+		return incompleteStatuses[s]
+	}
 	return s != StatusComplete
 }
 
@@ -2,6 +2,8 @@ package graph
 
 import (
 	"sort"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
 )
 
 // CriticalPath returns requirements on the critical path.
@@ -125,3 +127,61 @@ func (g *Graph) UnblockedIncomplete() []string {
 func (g *Graph) NextWorkable() []string {
 	return g.UnblockedIncomplete()
 }
+
+// CriticalChain returns the longest chain of incomplete requirements in
+// which each one depends on the one before it, dependencies first. Of
+// equally long chains, the one whose first requirement blocks the most
+// incomplete requirements wins, then database order. Dependencies that
+// close a cycle are not followed.
+func (g *Graph) CriticalChain() []string {
+	length := make(map[string]int) // longest chain starting at a requirement
+	next := make(map[string]string)
+	visiting := make(map[string]bool)
+
+	var walk func(id string) int
+	walk = func(id string) int {
+		if n, ok := length[id]; ok {
+			return n
+		}
+		visiting[id] = true
+		dependents := append([]string(nil), g.dependents[id]...)
+		database.SortReqIDs(dependents)
+		best := 0
+		for _, dependent := range dependents {
+			if req := g.db.Get(dependent); req == nil || !req.IsIncomplete() || visiting[dependent] {
+				continue
+			}
+			if n := walk(dependent); n > best {
+				best = n
+				next[id] = dependent
+			}
+		}
+		visiting[id] = false
+		length[id] = best + 1
+		return best + 1
+	}
+
+	start, startLen, startBlocked := "", 0, 0
+	for _, req := range g.db.All() {
+		if !req.IsIncomplete() {
+			continue
+		}
+		n := walk(req.ReqID)
+		if n < startLen {
+			continue
+		}
+		blocked := g.countBlockedIncomplete(req.ReqID)
+		if n > startLen || blocked > startBlocked {
+			start, startLen, startBlocked = req.ReqID, n, blocked
+		}
+	}
+	if start == "" {
+		return nil
+	}
+
+	chain := []string{start}
+	for id := next[start]; id != ""; id = next[id] {
+		chain = append(chain, id)
+	}
+	return chain
+}
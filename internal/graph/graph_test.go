@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/rtmx-ai/rtmx-go/internal/database"
@@ -265,6 +266,29 @@ func TestCriticalPath(t *testing.T) {
 	}
 }
 
+func TestCriticalChain(t *testing.T) {
+	db := createTestDB()
+	g := NewGraph(db)
+
+	// C is complete, so A -> B -> D is the longest incomplete chain
+	chain := g.CriticalChain()
+	if strings.Join(chain, ",") != "A,B,D" {
+		t.Errorf("CriticalChain = %v, want [A B D]", chain)
+	}
+
+	for _, req := range db.All() {
+		req.Status = database.StatusComplete
+	}
+	if chain := g.CriticalChain(); chain != nil {
+		t.Errorf("CriticalChain = %v, want none when everything is complete", chain)
+	}
+
+	// Cycles are not followed
+	if chain := NewGraph(createCyclicDB()).CriticalChain(); len(chain) != 3 {
+		t.Errorf("CriticalChain = %v, want the 3 requirements of the cycle once", chain)
+	}
+}
+
 func TestBlockingAnalysis(t *testing.T) {
 	db := createTestDB()
 	g := NewGraph(db)
@@ -340,3 +364,64 @@ func TestLoadRealGraph(t *testing.T) {
 		t.Logf("  - %s", id)
 	}
 }
+
+func TestRiskScores(t *testing.T) {
+	db := database.NewDatabase()
+
+	// CORE (MEDIUM) blocks X, Y and Z; LEAF (HIGH) blocks nothing
+	reqs := []*database.Requirement{
+		{ReqID: "CORE", Status: database.StatusMissing, Priority: database.PriorityMedium, EffortWeeks: 1,
+			Dependencies: database.NewStringSet(), Blocks: database.NewStringSet()},
+		{ReqID: "LEAF", Status: database.StatusMissing, Priority: database.PriorityHigh, EffortWeeks: 1,
+			Dependencies: database.NewStringSet(), Blocks: database.NewStringSet()},
+		{ReqID: "X", Status: database.StatusMissing, Priority: database.PriorityLow, EffortWeeks: 1,
+			Dependencies: database.NewStringSet("CORE"), Blocks: database.NewStringSet()},
+		{ReqID: "Y", Status: database.StatusMissing, Priority: database.PriorityLow, EffortWeeks: 1,
+			Dependencies: database.NewStringSet("CORE"), Blocks: database.NewStringSet()},
+		{ReqID: "Z", Status: database.StatusMissing, Priority: database.PriorityLow,
+			Dependencies: database.NewStringSet("X"), Blocks: database.NewStringSet()},
+		{ReqID: "DONE", Status: database.StatusComplete, Priority: database.PriorityP0,
+			Dependencies: database.NewStringSet(), Blocks: database.NewStringSet()},
+	}
+	for _, req := range reqs {
+		req.Extra = make(map[string]string)
+		_ = db.Add(req)
+	}
+
+	weights := RiskWeights{
+		Priority: map[database.Priority]float64{
+			database.PriorityP0: 4, database.PriorityHigh: 3, database.PriorityMedium: 2, database.PriorityLow: 1,
+		},
+		Blocked:            1,
+		UncertaintyPerWeek: 0.25,
+		Unestimated:        2,
+	}
+	risks := NewGraph(db).RiskScores(weights)
+
+	var order []string
+	byID := make(map[string]Risk)
+	for _, r := range risks {
+		order = append(order, r.ReqID)
+		byID[r.ReqID] = r
+	}
+
+	// CORE: 2 × (1+3) × 1.25 = 10; LEAF: 3 × 1 × 1.25 = 3.75;
+	// X: 1 × 2 × 1.25 = 2.5; Z: 1 × 1 × 2 = 2; Y: 1 × 1 × 1.25 = 1.25
+	want := []string{"CORE", "LEAF", "X", "Z", "Y"}
+	if len(order) != len(want) {
+		t.Fatalf("RiskScores order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("RiskScores order = %v, want %v", order, want)
+		}
+	}
+
+	core := byID["CORE"]
+	if core.Score != 10 || core.Blocked != 3 || core.PriorityWeight != 2 || core.Uncertainty != 1.25 {
+		t.Errorf("CORE risk = %+v", core)
+	}
+	if byID["Z"].Uncertainty != 2 {
+		t.Errorf("Unestimated uncertainty = %v, want 2", byID["Z"].Uncertainty)
+	}
+}
@@ -0,0 +1,65 @@
+package graph
+
+import (
+	"sort"
+
+	"github.com/rtmx-ai/rtmx-go/internal/database"
+)
+
+// RiskWeights parameterizes the risk score:
+// priority weight × (1 + Blocked × downstream blocked) × uncertainty.
+type RiskWeights struct {
+	// Priority maps each priority to its weight.
+	Priority map[database.Priority]float64
+
+	// Blocked scales each incomplete requirement transitively blocked.
+	Blocked float64
+
+	// UncertaintyPerWeek is the uncertainty added per week of estimated effort.
+	UncertaintyPerWeek float64
+
+	// Unestimated is the uncertainty of a requirement with no effort estimate.
+	Unestimated float64
+}
+
+// Risk is a requirement's risk score and the factors it is built from.
+type Risk struct {
+	ReqID          string
+	Score          float64
+	PriorityWeight float64
+	Blocked        int
+	Uncertainty    float64
+}
+
+// RiskScores scores every incomplete requirement, highest risk first.
+func (g *Graph) RiskScores(w RiskWeights) []Risk {
+	var risks []Risk
+	for _, req := range g.db.All() {
+		if !req.IsIncomplete() {
+			continue
+		}
+
+		uncertainty := w.Unestimated
+		if req.EffortWeeks > 0 {
+			uncertainty = 1 + w.UncertaintyPerWeek*req.EffortWeeks
+		}
+		blocked := g.countBlockedIncomplete(req.ReqID)
+		weight := w.Priority[req.Priority]
+
+		risks = append(risks, Risk{
+			ReqID:          req.ReqID,
+			Score:          weight * (1 + w.Blocked*float64(blocked)) * uncertainty,
+			PriorityWeight: weight,
+			Blocked:        blocked,
+			Uncertainty:    uncertainty,
+		})
+	}
+
+	sort.SliceStable(risks, func(i, j int) bool {
+		if risks[i].Score != risks[j].Score {
+			return risks[i].Score > risks[j].Score
+		}
+		return database.LessReqID(risks[i].ReqID, risks[j].ReqID)
+	})
+	return risks
+}
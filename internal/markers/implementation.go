@@ -0,0 +1,144 @@
+package markers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+)
+
+// ImplementationPattern returns the default pattern for implementation
+// markers, like "// implements REQ-AUTH-001", for IDs starting with prefix.
+func ImplementationPattern(prefix string) string {
+	return `\b(?i:implements):?\s+` + IDPattern(prefix)
+}
+
+// DefaultImplementationExtensions are the source file extensions scanned
+// for implementation markers unless configured otherwise.
+var DefaultImplementationExtensions = []string{".py", ".go", ".js", ".jsx", ".ts", ".tsx", ".mjs", ".cjs"}
+
+// skipDirs are directories never scanned for implementation markers.
+var skipDirs = map[string]bool{"vendor": true, "node_modules": true}
+
+// ImplementationScanner finds implementation markers in non-test source.
+type ImplementationScanner struct {
+	pattern    *regexp.Regexp
+	extensions map[string]bool
+}
+
+// NewImplementationScanner creates a scanner from the implementation
+// markers configuration whose default pattern matches IDs starting with
+// prefix.
+func NewImplementationScanner(cfg config.ImplementationMarkersConfig, prefix string) (*ImplementationScanner, error) {
+	expr := cfg.Pattern
+	if expr == "" {
+		expr = ImplementationPattern(prefix)
+	}
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("implementation marker pattern: %w", err)
+	}
+	if pattern.NumSubexp() < 1 {
+		return nil, fmt.Errorf("implementation marker pattern must capture the requirement ID")
+	}
+
+	exts := cfg.Extensions
+	if len(exts) == 0 {
+		exts = DefaultImplementationExtensions
+	}
+	s := &ImplementationScanner{pattern: pattern, extensions: make(map[string]bool)}
+	for _, ext := range exts {
+		ext = strings.ToLower(ext)
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		s.extensions[ext] = true
+	}
+	return s, nil
+}
+
+// Handles reports whether path has an extension the scanner reads.
+func (s *ImplementationScanner) Handles(path string) bool {
+	return s.extensions[strings.ToLower(filepath.Ext(path))]
+}
+
+// Extract returns the implementation markers in content, one per
+// requirement per line.
+func (s *ImplementationScanner) Extract(path string, content []byte) []Marker {
+	var found []Marker
+	for i, line := range strings.Split(string(content), "\n") {
+		seen := make(map[string]bool)
+		for _, m := range s.pattern.FindAllStringSubmatch(line, -1) {
+			if id := strings.TrimSpace(m[1]); id != "" && !seen[id] {
+				seen[id] = true
+				found = append(found, Marker{ReqID: id, File: path, Line: i + 1})
+			}
+		}
+	}
+	return found
+}
+
+// ScanDir walks root for source files the scanner handles, skipping
+// hidden, vendor and node_modules directories, directories that cannot be
+// read and the files isTest reports as tests. Marker paths are relative to
+// root, with forward slashes, in walk order.
+func (s *ImplementationScanner) ScanDir(root string, isTest func(string) bool) ([]Marker, error) {
+	var found []Marker
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if path == root {
+				return err
+			}
+			// Skip what can't be read, e.g. another user's cache directory
+			if info != nil && info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			name := info.Name()
+			if path != root && (strings.HasPrefix(name, ".") || skipDirs[name]) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !s.Handles(path) || (isTest != nil && isTest(path)) {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			// Skip files that can't be read
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			rel = path
+		}
+		found = append(found, s.Extract(filepath.ToSlash(rel), content)...)
+		return nil
+	})
+	return found, err
+}
+
+// ByRequirement groups markers by requirement ID, each group in the order
+// found.
+func ByRequirement(found []Marker) map[string][]Marker {
+	grouped := make(map[string][]Marker)
+	for _, m := range found {
+		grouped[m.ReqID] = append(grouped[m.ReqID], m)
+	}
+	return grouped
+}
+
+// Locations returns the markers as "file:line" references.
+func Locations(found []Marker) []string {
+	locs := make([]string, len(found))
+	for i, m := range found {
+		locs[i] = fmt.Sprintf("%s:%d", m.File, m.Line)
+	}
+	return locs
+}
@@ -0,0 +1,310 @@
+// Package markers extracts requirement markers from test source files.
+//
+// Extraction is driven by rules: a regex per file extension that captures the
+// requirement ID, and an optional regex that captures test names so markers
+// can be linked to the test they belong to. Built-in rules cover pytest
+// markers, Go comments and rtmx.Req calls, and JS/TS JSDoc tags and
+// describe/it/test titles; projects add their own in rtmx.yaml.
+//
+// Implementation markers, like "// implements REQ-AUTH-001", tie non-test
+// source to requirements and are found by an ImplementationScanner.
+package markers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+)
+
+// IDPattern returns a pattern capturing requirement IDs that start with
+// prefix, like REQ-AUTH-001 for "REQ".
+func IDPattern(prefix string) string {
+	return `(` + regexp.QuoteMeta(prefix) + `-[A-Z0-9_]+(?:-[A-Z0-9_]+)+)`
+}
+
+// PytestMarker returns the pattern of the pytest marker for requirement IDs
+// that start with prefix: pytest.mark.req for REQ.
+func PytestMarker(prefix string) string {
+	return `pytest\.mark\.` + regexp.QuoteMeta(strings.ToLower(prefix))
+}
+
+// DefaultRules returns the built-in rules for Python, Go and JS/TS.
+func DefaultRules() []config.MarkerRule {
+	return DefaultRulesFor(config.DefaultIDPrefix)
+}
+
+// DefaultRulesFor returns the built-in rules matching requirement IDs that
+// start with prefix.
+func DefaultRulesFor(prefix string) []config.MarkerRule {
+	reqIDPattern := IDPattern(prefix)
+	return []config.MarkerRule{
+		{
+			Extensions: []string{".py"},
+			TestFiles:  []string{"test_*.py", "*_test.py"},
+			Pattern:    PytestMarker(prefix) + `\s*\(\s*["'](` + regexp.QuoteMeta(prefix) + `-[^"']+)["']\s*\)`,
+			Function:   `^(?:async\s+)?def\s+(test_\w+)\s*\(`,
+		},
+		{
+			Extensions: []string{".go"},
+			TestFiles:  []string{"*_test.go"},
+			Pattern:    `(?://\s*|rtmx\.Req\(\s*\w+\s*,\s*")` + reqIDPattern,
+			Function:   `^func\s+(Test\w+)\s*\(`,
+		},
+		{
+			Extensions: []string{".js", ".jsx", ".ts", ".tsx", ".mjs", ".cjs"},
+			TestFiles:  []string{"*.test.*", "*.spec.*"},
+			Pattern:    `(?:@req\s+|(?:describe|it|test)\s*\(\s*["'` + "`" + `])` + reqIDPattern,
+			Function:   `(?:describe|it|test)\s*\(\s*["'` + "`" + `]([^"'` + "`" + `]+)`,
+		},
+	}
+}
+
+// Marker is a requirement marker found in a file.
+type Marker struct {
+	ReqID        string
+	File         string
+	Line         int
+	Function     string
+	FunctionLine int
+}
+
+// Function is a test function (or test block) found in a file. Doc is the
+// one-line docstring opening its body, if any.
+type Function struct {
+	Name string
+	Line int
+	Doc  string
+}
+
+// FileResult holds the markers and test functions found in one file.
+type FileResult struct {
+	Markers   []Marker
+	Functions []Function
+}
+
+// Extractor applies marker rules to files.
+type Extractor struct {
+	rules    []compiledRule
+	idPrefix string
+}
+
+type compiledRule struct {
+	extensions []string
+	testFiles  []string
+	pattern    *regexp.Regexp
+	function   *regexp.Regexp
+}
+
+// New creates an extractor from the markers configuration.
+func New(cfg config.MarkersConfig) (*Extractor, error) {
+	return NewWithPrefix(cfg, config.DefaultIDPrefix)
+}
+
+// NewWithPrefix creates an extractor from the markers configuration whose
+// built-in rules match requirement IDs that start with prefix.
+func NewWithPrefix(cfg config.MarkersConfig, prefix string) (*Extractor, error) {
+	var rules []config.MarkerRule
+	rules = append(rules, cfg.Rules...)
+	if !cfg.NoDefaults {
+		rules = append(rules, DefaultRulesFor(prefix)...)
+	}
+	e, err := NewFromRules(rules)
+	if err != nil {
+		return nil, err
+	}
+	e.idPrefix = prefix
+	return e, nil
+}
+
+// NewFromRules creates an extractor from an explicit list of rules.
+func NewFromRules(rules []config.MarkerRule) (*Extractor, error) {
+	e := &Extractor{idPrefix: config.DefaultIDPrefix}
+	for i, r := range rules {
+		if len(r.Extensions) == 0 {
+			return nil, fmt.Errorf("marker rule %d: no extensions", i+1)
+		}
+		pattern, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("marker rule %d: invalid pattern: %w", i+1, err)
+		}
+		if pattern.NumSubexp() < 1 {
+			return nil, fmt.Errorf("marker rule %d: pattern must capture the requirement ID", i+1)
+		}
+
+		cr := compiledRule{pattern: pattern, testFiles: r.TestFiles}
+		for _, ext := range r.Extensions {
+			ext = strings.ToLower(ext)
+			if !strings.HasPrefix(ext, ".") {
+				ext = "." + ext
+			}
+			cr.extensions = append(cr.extensions, ext)
+		}
+		if r.Function != "" {
+			cr.function, err = regexp.Compile(r.Function)
+			if err != nil {
+				return nil, fmt.Errorf("marker rule %d: invalid function pattern: %w", i+1, err)
+			}
+			if cr.function.NumSubexp() < 1 {
+				return nil, fmt.Errorf("marker rule %d: function pattern must capture the test name", i+1)
+			}
+		}
+		e.rules = append(e.rules, cr)
+	}
+	return e, nil
+}
+
+// Default returns an extractor with only the built-in rules.
+func Default() *Extractor {
+	e, err := NewFromRules(DefaultRules())
+	if err != nil {
+		panic(err) // built-in rules are static
+	}
+	return e
+}
+
+// ForConfig returns an extractor for cfg, falling back to the defaults
+// when cfg is nil.
+func ForConfig(cfg *config.Config) (*Extractor, error) {
+	if cfg == nil {
+		return Default(), nil
+	}
+	return NewWithPrefix(cfg.RTMX.Markers, cfg.IDPrefix())
+}
+
+// IDPrefix returns the requirement ID prefix the built-in rules match.
+func (e *Extractor) IDPrefix() string {
+	return e.idPrefix
+}
+
+// IsTestFile reports whether any rule treats path as a test file.
+func (e *Extractor) IsTestFile(path string) bool {
+	base := filepath.Base(path)
+	for _, r := range e.rulesFor(path) {
+		if len(r.testFiles) == 0 {
+			return true
+		}
+		for _, glob := range r.testFiles {
+			if ok, _ := filepath.Match(glob, base); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ExtractFile reads and scans a file.
+func (e *Extractor) ExtractFile(path string) (*FileResult, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return e.Extract(path, content), nil
+}
+
+// Extract scans content using the rules for path's extension.
+//
+// A marker is linked to the test defined on the same line, or to the next
+// test when only blank lines, comments, decorators and other markers come
+// between them; otherwise it belongs to the test it appears in.
+func (e *Extractor) Extract(path string, content []byte) *FileResult {
+	result := &FileResult{}
+	rules := e.rulesFor(path)
+	if len(rules) == 0 {
+		return result
+	}
+
+	var pending []Marker
+	var current Function
+	attach := func(fn Function) {
+		for _, m := range pending {
+			m.Function = fn.Name
+			m.FunctionLine = fn.Line
+			result.Markers = append(result.Markers, m)
+		}
+		pending = nil
+	}
+
+	for i, line := range strings.Split(string(content), "\n") {
+		lineNum := i + 1
+		trimmed := strings.TrimSpace(line)
+
+		seen := make(map[string]bool)
+		var found []Marker
+		fnName := ""
+		for _, r := range rules {
+			for _, m := range r.pattern.FindAllStringSubmatch(trimmed, -1) {
+				if id := strings.TrimSpace(m[1]); id != "" && !seen[id] {
+					seen[id] = true
+					found = append(found, Marker{ReqID: id, File: path, Line: lineNum})
+				}
+			}
+			if fnName == "" && r.function != nil {
+				if m := r.function.FindStringSubmatch(trimmed); m != nil {
+					fnName = m[1]
+				}
+			}
+		}
+
+		if n := len(result.Functions); n > 0 && result.Functions[n-1].Line == lineNum-1 {
+			result.Functions[n-1].Doc = docstring(trimmed)
+		}
+
+		switch {
+		case fnName != "":
+			current = Function{Name: fnName, Line: lineNum}
+			result.Functions = append(result.Functions, current)
+			pending = append(pending, found...)
+			attach(current)
+		case len(found) > 0:
+			pending = append(pending, found...)
+		case len(pending) > 0 && !isAnnotationLine(trimmed):
+			attach(current)
+		}
+	}
+	attach(current)
+
+	return result
+}
+
+// docstring returns the text of a one-line Python docstring, or "".
+func docstring(line string) string {
+	for _, quote := range []string{`"""`, `'''`} {
+		if strings.HasPrefix(line, quote) {
+			return strings.TrimSuffix(strings.TrimPrefix(line, quote), quote)
+		}
+	}
+	return ""
+}
+
+// rulesFor returns the rules that apply to path's extension.
+func (e *Extractor) rulesFor(path string) []compiledRule {
+	ext := strings.ToLower(filepath.Ext(path))
+	var rules []compiledRule
+	for _, r := range e.rules {
+		for _, re := range r.extensions {
+			if re == ext {
+				rules = append(rules, r)
+				break
+			}
+		}
+	}
+	return rules
+}
+
+// isAnnotationLine reports whether a line can sit between a marker and the
+// test it annotates: blank lines, comments and decorators.
+func isAnnotationLine(line string) bool {
+	if line == "" {
+		return true
+	}
+	for _, prefix := range []string{"@", "#", "//", "/*", "*"} {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
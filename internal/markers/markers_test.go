@@ -0,0 +1,357 @@
+package markers
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rtmx-ai/rtmx-go/internal/config"
+)
+
+func markerIndex(result *FileResult) map[string]string {
+	idx := make(map[string]string)
+	for _, m := range result.Markers {
+		idx[m.ReqID] = m.Function
+	}
+	return idx
+}
+
+func TestExtractGoComments(t *testing.T) {
+	src := `package auth
+
+// REQ-AUTH-001: users can log in
+func TestLogin(t *testing.T) {
+	login(t)
+}
+
+func TestLogout(t *testing.T) {
+	rtmx.Req(t, "REQ-AUTH-002")
+	logout(t)
+}
+
+func TestHelperless(t *testing.T) {}
+`
+	e, err := New(config.MarkersConfig{})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	result := e.Extract("auth_test.go", []byte(src))
+	got := markerIndex(result)
+
+	want := map[string]string{
+		"REQ-AUTH-001": "TestLogin",
+		"REQ-AUTH-002": "TestLogout",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Markers = %v, want %v", got, want)
+	}
+	for id, fn := range want {
+		if got[id] != fn {
+			t.Errorf("%s linked to %q, want %q", id, got[id], fn)
+		}
+	}
+	if len(result.Functions) != 3 {
+		t.Errorf("Expected 3 test functions, got %v", result.Functions)
+	}
+}
+
+func TestExtractJSDescribe(t *testing.T) {
+	src := `import { login } from "./auth";
+
+describe("REQ-X-001", () => {
+  it("logs in", () => {
+    expect(login()).toBe(true);
+  });
+});
+
+/**
+ * @req REQ-X-002
+ */
+test("logs out", () => {});
+`
+	e, err := New(config.MarkersConfig{})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	got := markerIndex(e.Extract("auth.test.ts", []byte(src)))
+	if got["REQ-X-001"] != "REQ-X-001" {
+		t.Errorf("REQ-X-001 linked to %q, want the describe block", got["REQ-X-001"])
+	}
+	if got["REQ-X-002"] != "logs out" {
+		t.Errorf("REQ-X-002 linked to %q, want %q", got["REQ-X-002"], "logs out")
+	}
+}
+
+func TestExtractPytestMarkers(t *testing.T) {
+	src := `import pytest
+
+@pytest.mark.req("REQ-PY-001")
+@pytest.mark.scope_unit
+def test_one():
+    pass
+`
+	got := markerIndex(Default().Extract("test_one.py", []byte(src)))
+	if got["REQ-PY-001"] != "test_one" {
+		t.Errorf("REQ-PY-001 linked to %q, want test_one", got["REQ-PY-001"])
+	}
+}
+
+func TestExtractDocstrings(t *testing.T) {
+	src := `def test_login():
+    """User can log in with valid credentials."""
+    pass
+
+def test_reset():
+    '''Password reset works.'''
+
+def test_plain():
+    pass
+`
+	got := Default().Extract("test_auth.py", []byte(src)).Functions
+	want := []string{"User can log in with valid credentials.", "Password reset works.", ""}
+	if len(got) != len(want) {
+		t.Fatalf("got %d functions, want %d", len(got), len(want))
+	}
+	for i, fn := range got {
+		if fn.Doc != want[i] {
+			t.Errorf("%s doc = %q, want %q", fn.Name, fn.Doc, want[i])
+		}
+	}
+}
+
+func TestCustomRuleFromConfig(t *testing.T) {
+	dir := t.TempDir()
+	yaml := `rtmx:
+  markers:
+    rules:
+      - extensions: [".rb"]
+        test_files: ["*_spec.rb"]
+        pattern: 'req:\s*"(REQ-[A-Z0-9-]+)"'
+        function: '^it\s+"([^"]+)"'
+`
+	path := filepath.Join(dir, "rtmx.yaml")
+	if err := os.WriteFile(path, []byte(yaml), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	e, err := ForConfig(cfg)
+	if err != nil {
+		t.Fatalf("ForConfig failed: %v", err)
+	}
+
+	src := `describe Auth do
+  it "logs in", req: "REQ-RB-001" do
+    expect(login).to be true
+  end
+end
+`
+	if !e.IsTestFile("spec/auth_spec.rb") {
+		t.Error("Expected auth_spec.rb to be a test file")
+	}
+	if e.IsTestFile("lib/auth.rb") {
+		t.Error("Expected lib/auth.rb not to be a test file")
+	}
+	got := markerIndex(e.Extract("spec/auth_spec.rb", []byte(src)))
+	if got["REQ-RB-001"] != "logs in" {
+		t.Errorf("REQ-RB-001 linked to %q, want %q", got["REQ-RB-001"], "logs in")
+	}
+
+	// Defaults still apply alongside custom rules
+	if !e.IsTestFile("auth_test.go") {
+		t.Error("Expected default Go rule to remain active")
+	}
+}
+
+func TestNewInvalidRules(t *testing.T) {
+	tests := []struct {
+		name string
+		rule config.MarkerRule
+	}{
+		{"no extensions", config.MarkerRule{Pattern: `(REQ-\d+)`}},
+		{"bad regex", config.MarkerRule{Extensions: []string{".rb"}, Pattern: `(REQ-`}},
+		{"no capture group", config.MarkerRule{Extensions: []string{".rb"}, Pattern: `REQ-\d+`}},
+		{"bad function", config.MarkerRule{Extensions: []string{".rb"}, Pattern: `(REQ-\d+)`, Function: `it "`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(config.MarkersConfig{Rules: []config.MarkerRule{tt.rule}}); err == nil {
+				t.Error("Expected error for invalid rule")
+			}
+		})
+	}
+}
+
+func TestNoDefaults(t *testing.T) {
+	e, err := New(config.MarkersConfig{NoDefaults: true})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	if e.IsTestFile("test_one.py") {
+		t.Error("Expected no rules when defaults are disabled")
+	}
+}
+
+func TestForConfigIDPrefix(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.RTMX.Pytest.MarkerPrefix = "proj"
+	e, err := ForConfig(cfg)
+	if err != nil {
+		t.Fatalf("ForConfig failed: %v", err)
+	}
+	if e.IDPrefix() != "PROJ" {
+		t.Errorf("IDPrefix() = %q, want PROJ", e.IDPrefix())
+	}
+
+	src := `package auth
+
+// PROJ-AUTH-001: users can log in
+func TestLogin(t *testing.T) {}
+
+// REQ-AUTH-002: users can log out
+func TestLogout(t *testing.T) {}
+`
+	got := markerIndex(e.Extract("auth_test.go", []byte(src)))
+	if got["PROJ-AUTH-001"] != "TestLogin" {
+		t.Errorf("Expected PROJ-AUTH-001 linked to TestLogin, got %v", got)
+	}
+	if _, ok := got["REQ-AUTH-002"]; ok {
+		t.Errorf("Expected markers with another prefix to be ignored, got %v", got)
+	}
+
+	py := `@pytest.mark.proj("PROJ-USER_ACCOUNTS-001")
+def test_signup():
+    pass
+
+@pytest.mark.req("REQ-AUTH-002")
+def test_logout():
+    pass
+`
+	got = markerIndex(e.Extract("test_accounts.py", []byte(py)))
+	if got["PROJ-USER_ACCOUNTS-001"] != "test_signup" || len(got) != 1 {
+		t.Errorf("Expected only PROJ-USER_ACCOUNTS-001 linked to test_signup, got %v", got)
+	}
+}
+
+// writeScanFixtures writes n pytest files with a mix of marked and unmarked
+// tests and returns their paths.
+func writeScanFixtures(t testing.TB, n int) []string {
+	dir := t.TempDir()
+	paths := make([]string, n)
+	for i := range paths {
+		content := fmt.Sprintf(`import pytest
+
+@pytest.mark.req("REQ-SCAN-%03d")
+def test_marked_%d():
+    pass
+
+def test_unmarked_%d():
+    pass
+`, i, i, i)
+		paths[i] = filepath.Join(dir, fmt.Sprintf("test_scan_%03d.py", i))
+		if err := os.WriteFile(paths[i], []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write fixture: %v", err)
+		}
+	}
+	return paths
+}
+
+func TestScanFilesMatchesSequential(t *testing.T) {
+	paths := writeScanFixtures(t, 50)
+	// An unreadable file in the middle must not stop the scan
+	missing := filepath.Join(filepath.Dir(paths[0]), "test_missing.py")
+	paths = append(paths[:25], append([]string{missing}, paths[25:]...)...)
+
+	e := Default()
+	for _, workers := range []int{1, 4, 0} {
+		scans := e.ScanFiles(paths, workers)
+		if len(scans) != len(paths) {
+			t.Fatalf("workers=%d: got %d results, want %d", workers, len(scans), len(paths))
+		}
+		for i, path := range paths {
+			if scans[i].Path != path {
+				t.Fatalf("workers=%d: result %d is %s, want %s", workers, i, scans[i].Path, path)
+			}
+			want, err := e.ExtractFile(path)
+			if err != nil {
+				if scans[i].Err == nil {
+					t.Errorf("workers=%d: expected a read error for %s", workers, path)
+				}
+				continue
+			}
+			if scans[i].Err != nil || !reflect.DeepEqual(scans[i].Result, want) {
+				t.Errorf("workers=%d: %s = %+v (%v), want %+v", workers, path, scans[i].Result, scans[i].Err, want)
+			}
+		}
+	}
+}
+
+func BenchmarkScanFiles(b *testing.B) {
+	paths := writeScanFixtures(b, 1000)
+	e := Default()
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, path := range paths {
+				_, _ = e.ExtractFile(path)
+			}
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			e.ScanFiles(paths, 0)
+		}
+	})
+}
+
+func TestImplementationScanDir(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"auth/login.go":      "package auth\n\n// implements REQ-AUTH-001\nfunc Login() {}\n\n// Implements: REQ-AUTH-002 (partially)\n",
+		"auth/login_test.go": "package auth\n\n// implements REQ-AUTH-003\nfunc TestLogin(t *testing.T) {}\n",
+		"api/server.py":      "# implements REQ-API-001\ndef serve():\n    pass\n",
+		"vendor/lib/lib.go":  "// implements REQ-VENDOR-001\n",
+		".hidden/tool.go":    "// implements REQ-HIDDEN-001\n",
+		"docs/notes.md":      "implements REQ-DOCS-001\n",
+		"auth/session.go":    "package auth\n\n// REQ-AUTH-004 without the keyword\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	scanner, err := NewImplementationScanner(config.ImplementationMarkersConfig{}, "REQ")
+	if err != nil {
+		t.Fatalf("NewImplementationScanner failed: %v", err)
+	}
+	found, err := scanner.ScanDir(root, Default().IsTestFile)
+	if err != nil {
+		t.Fatalf("ScanDir failed: %v", err)
+	}
+
+	got := make(map[string][]string)
+	for id, ms := range ByRequirement(found) {
+		got[id] = Locations(ms)
+	}
+	want := map[string][]string{
+		"REQ-AUTH-001": {"auth/login.go:3"},
+		"REQ-AUTH-002": {"auth/login.go:6"},
+		"REQ-API-001":  {"api/server.py:1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("implementation markers = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+package markers
+
+import (
+	"runtime"
+	"sync"
+)
+
+// FileScan is the outcome of scanning one file with ScanFiles. Only the
+// extracted result is kept, not the file's content.
+type FileScan struct {
+	Path   string
+	Result *FileResult
+	Err    error
+}
+
+// ScanFiles reads and extracts paths with at most workers files in flight,
+// or one per CPU when workers is not positive. Results are in the order of
+// paths however the work is scheduled. A file that cannot be read has its
+// error in its FileScan and does not stop the others.
+func (e *Extractor) ScanFiles(paths []string, workers int) []FileScan {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(paths) {
+		workers = len(paths)
+	}
+
+	scans := make([]FileScan, len(paths))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				scans[i] = e.scanFile(paths[i])
+			}
+		}()
+	}
+	for i := range paths {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return scans
+}
+
+// scanFile reads and extracts one file.
+func (e *Extractor) scanFile(path string) FileScan {
+	result, err := e.ExtractFile(path)
+	if err != nil {
+		return FileScan{Path: path, Err: err}
+	}
+	return FileScan{Path: path, Result: result}
+}
@@ -2,8 +2,8 @@
 package output
 
 import (
-	"fmt"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -23,8 +23,15 @@ const (
 	BoldGreen = "\033[1;32m"
 )
 
+// defaultTerminalWidth is assumed when the terminal width is unknown.
+const defaultTerminalWidth = 80
+
 var useColor = true
 
+// decimalSeparator separates the integer and fractional parts of the
+// numbers Decimal and Percent format.
+var decimalSeparator = "."
+
 // DisableColor disables colored output.
 func DisableColor() {
 	useColor = false
@@ -49,6 +56,33 @@ func isTerminal() bool {
 	return info.Mode()&os.ModeCharDevice != 0
 }
 
+// TerminalWidth returns the screen width in columns, or a conventional
+// default when it is unknown.
+func TerminalWidth() int {
+	if cols, ok := DetectTerminalWidth(); ok {
+		return cols
+	}
+	return defaultTerminalWidth
+}
+
+// DetectTerminalWidth returns the screen width in columns from COLUMNS or
+// the terminal on stdout, and whether either gave it.
+func DetectTerminalWidth() (int, bool) {
+	if cols, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && cols > 0 {
+		return cols, true
+	}
+	if cols := ttyWidth(); cols > 0 {
+		return cols, true
+	}
+	return 0, false
+}
+
+// VisibleWidth returns the number of columns text takes on screen,
+// ignoring ANSI color codes.
+func VisibleWidth(text string) int {
+	return displayWidth(text)
+}
+
 // Color applies a color to text if color is enabled.
 func Color(text, color string) string {
 	if !IsColorEnabled() {
@@ -162,9 +196,33 @@ func StatusIcon(status string) string {
 	}
 }
 
+// SetDecimalSeparator sets the decimal separator of formatted numbers,
+// e.g. "," for 85,5%. Empty restores ".".
+func SetDecimalSeparator(sep string) {
+	if sep == "" {
+		sep = "."
+	}
+	decimalSeparator = sep
+}
+
+// Decimal formats value with precision fractional digits and the
+// configured decimal separator.
+func Decimal(value float64, precision int) string {
+	text := strconv.FormatFloat(value, 'f', precision, 64)
+	if decimalSeparator != "." {
+		text = strings.Replace(text, ".", decimalSeparator, 1)
+	}
+	return text
+}
+
+// Percent formats a percentage with one fractional digit, e.g. "85.5%".
+func Percent(percent float64) string {
+	return Decimal(percent, 1) + "%"
+}
+
 // FormatPercent formats a percentage with color.
 func FormatPercent(percent float64) string {
-	text := fmt.Sprintf("%.1f%%", percent)
+	text := Percent(percent)
 	var color string
 	switch {
 	case percent >= 80:
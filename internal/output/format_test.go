@@ -110,3 +110,29 @@ func TestFormatPercent(t *testing.T) {
 		}
 	}
 }
+
+func TestDecimalSeparator(t *testing.T) {
+	defer SetDecimalSeparator("")
+
+	if got := Percent(85.25); got != "85.2%" {
+		t.Errorf("Percent(85.25) = %q, want 85.2%%", got)
+	}
+	SetDecimalSeparator(",")
+	if got := Percent(85.5); got != "85,5%" {
+		t.Errorf("Percent(85.5) = %q, want 85,5%%", got)
+	}
+	if got := Decimal(1234.5, 2); got != "1234,50" {
+		t.Errorf("Decimal(1234.5, 2) = %q, want 1234,50", got)
+	}
+}
+
+func TestTerminalWidth(t *testing.T) {
+	t.Setenv("COLUMNS", "132")
+	if got, ok := DetectTerminalWidth(); !ok || got != 132 {
+		t.Errorf("DetectTerminalWidth() = %d, %v; want 132 from COLUMNS", got, ok)
+	}
+
+	if got := VisibleWidth(Magenta + "★ REQ-001" + Reset); got != 9 {
+		t.Errorf("VisibleWidth = %d, want 9 without the color codes", got)
+	}
+}
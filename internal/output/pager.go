@@ -0,0 +1,115 @@
+package output
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// defaultPager is used when neither RTMX_PAGER nor PAGER is set.
+const defaultPager = "less -R"
+
+// defaultTerminalHeight is assumed when LINES does not give the height.
+// less is started with -F so output that fits the screen is not paged
+// even when the real terminal is taller.
+const defaultTerminalHeight = 24
+
+// runPager runs the pager command with content on its stdin. It only
+// fails if the pager cannot be started; how the user leaves the pager is
+// not an error. Tests replace it.
+var runPager = func(command string, content []byte, w io.Writer) error {
+	parts := strings.Fields(command)
+	cmd := exec.Command(parts[0], parts[1:]...)
+	cmd.Stdin = bytes.NewReader(content)
+	cmd.Stdout = w
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	if os.Getenv("LESS") == "" {
+		cmd.Env = append(cmd.Env, "LESS=FRX")
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	_ = cmd.Wait()
+	return nil
+}
+
+// PagerCommand returns the pager to use: RTMX_PAGER, then PAGER, then
+// less -R.
+func PagerCommand(getenv func(string) string) string {
+	for _, key := range []string{"RTMX_PAGER", "PAGER"} {
+		if command := strings.TrimSpace(getenv(key)); command != "" {
+			return command
+		}
+	}
+	return defaultPager
+}
+
+// Pager collects output for a terminal and, once closed, shows it through
+// a pager if it is taller than the screen. Output to anything other than a
+// terminal passes straight through.
+type Pager struct {
+	w       io.Writer
+	command string
+	height  int
+	holding bool
+	buf     bytes.Buffer
+}
+
+// NewPager creates a pager writing to w using command. An empty command
+// disables paging.
+func NewPager(w io.Writer, command string) *Pager {
+	p := &Pager{w: w, command: command}
+	if strings.TrimSpace(command) != "" && isTerminalWriter(w) {
+		p.height = terminalHeight()
+		p.holding = true
+	}
+	return p
+}
+
+// Paging reports whether output is held back for the pager.
+func (p *Pager) Paging() bool {
+	return p.holding
+}
+
+// Write buffers b for the pager, or writes it through when not paging.
+func (p *Pager) Write(b []byte) (int, error) {
+	if !p.holding {
+		return p.w.Write(b)
+	}
+	return p.buf.Write(b)
+}
+
+// Close shows the buffered output, through the pager if it does not fit
+// on the screen. If the pager cannot be started, the output is written as
+// is.
+func (p *Pager) Close() error {
+	if !p.holding {
+		return nil
+	}
+	p.holding = false
+
+	content := p.buf.Bytes()
+	p.buf = bytes.Buffer{}
+	if bytes.Count(content, []byte("\n")) < p.height {
+		_, err := p.w.Write(content)
+		return err
+	}
+	if err := runPager(p.command, content, p.w); err != nil {
+		_, err := p.w.Write(content)
+		return err
+	}
+	return nil
+}
+
+// terminalHeight returns the screen height in lines from LINES, or a
+// conventional default.
+func terminalHeight() int {
+	if lines, err := strconv.Atoi(os.Getenv("LINES")); err == nil && lines > 0 {
+		return lines
+	}
+	return defaultTerminalHeight
+}
@@ -0,0 +1,106 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// stubPager replaces runPager for the duration of a test and records what
+// it was given.
+func stubPager(t *testing.T, err error) *[]string {
+	t.Helper()
+	var calls []string
+	orig := runPager
+	runPager = func(command string, content []byte, w io.Writer) error {
+		calls = append(calls, command+": "+string(content))
+		return err
+	}
+	t.Cleanup(func() { runPager = orig })
+	return &calls
+}
+
+func TestPagerPassesThroughWhenNotTerminal(t *testing.T) {
+	calls := stubPager(t, nil)
+	var buf bytes.Buffer
+	p := NewPager(&buf, "less -R")
+
+	if p.Paging() {
+		t.Error("Pager should not page a non-TTY writer")
+	}
+	_, _ = p.Write([]byte(strings.Repeat("line\n", 100)))
+	if buf.Len() == 0 {
+		t.Error("Expected output to be written straight through")
+	}
+	_ = p.Close()
+	if len(*calls) != 0 {
+		t.Errorf("Pager ran for a non-TTY writer: %v", *calls)
+	}
+}
+
+func TestPagerPagesLongTerminalOutput(t *testing.T) {
+	t.Setenv("LINES", "10")
+
+	tests := []struct {
+		name      string
+		lines     int
+		pagerErr  error
+		wantPaged bool
+		wantOut   bool
+	}{
+		{"fits on screen", 5, nil, false, true},
+		{"taller than screen", 30, nil, true, false},
+		{"pager fails to start", 30, errors.New("not found"), true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := stubPager(t, tt.pagerErr)
+			tty := &fakeTTY{}
+			p := NewPager(tty, "less -R")
+			if !p.Paging() {
+				t.Fatal("Pager should hold terminal output")
+			}
+
+			_, _ = p.Write([]byte(strings.Repeat("line\n", tt.lines)))
+			if tty.Len() != 0 {
+				t.Error("Output should be held until Close")
+			}
+			if err := p.Close(); err != nil {
+				t.Fatalf("Close failed: %v", err)
+			}
+
+			if paged := len(*calls) == 1; paged != tt.wantPaged {
+				t.Errorf("paged = %v, want %v", paged, tt.wantPaged)
+			}
+			if wrote := tty.Len() > 0; wrote != tt.wantOut {
+				t.Errorf("wrote directly = %v, want %v", wrote, tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestPagerCommand(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+	tests := []struct {
+		vars map[string]string
+		want string
+	}{
+		{map[string]string{"RTMX_PAGER": "more", "PAGER": "most"}, "more"},
+		{map[string]string{"PAGER": "most"}, "most"},
+		{map[string]string{"RTMX_PAGER": "  "}, "less -R"},
+		{nil, "less -R"},
+	}
+	for _, tt := range tests {
+		if got := PagerCommand(env(tt.vars)); got != tt.want {
+			t.Errorf("PagerCommand(%v) = %q, want %q", tt.vars, got, tt.want)
+		}
+	}
+
+	if NewPager(&fakeTTY{}, "").Paging() {
+		t.Error("An empty pager command should disable paging")
+	}
+}
@@ -0,0 +1,115 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// spinnerFrames are the animation frames for the progress spinner.
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+var quiet = false
+
+// SetQuiet suppresses progress output when enabled.
+func SetQuiet(q bool) {
+	quiet = q
+}
+
+// terminalWriter is implemented by writers that know whether they are a TTY.
+// It lets tests exercise progress rendering without a real terminal.
+type terminalWriter interface {
+	IsTerminal() bool
+}
+
+// Progress renders a single-line spinner with a status message.
+//
+// Progress is meant for stderr so it never mixes with machine-readable
+// stdout (e.g. --format json). It is silent when the writer is not a
+// terminal, when color is disabled, or when quiet mode is on.
+type Progress struct {
+	mu      sync.Mutex
+	w       io.Writer
+	enabled bool
+	frame   int
+	width   int
+}
+
+// NewProgress creates a progress indicator writing to w.
+func NewProgress(w io.Writer) *Progress {
+	return &Progress{
+		w:       w,
+		enabled: useColor && !quiet && isTerminalWriter(w),
+	}
+}
+
+// NewStderrProgress creates a progress indicator on stderr.
+func NewStderrProgress() *Progress {
+	return NewProgress(os.Stderr)
+}
+
+// Enabled reports whether the progress indicator renders anything.
+func (p *Progress) Enabled() bool {
+	return p != nil && p.enabled
+}
+
+// Update advances the spinner and replaces the status message.
+func (p *Progress) Update(message string) {
+	if !p.Enabled() {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	line := spinnerFrames[p.frame%len(spinnerFrames)] + " " + message
+	p.frame++
+
+	padding := ""
+	if n := len([]rune(line)); n < p.width {
+		padding = strings.Repeat(" ", p.width-n)
+	}
+	p.width = len([]rune(line))
+	fmt.Fprintf(p.w, "\r%s%s", line, padding)
+}
+
+// Updatef is like Update with a format string.
+func (p *Progress) Updatef(format string, args ...interface{}) {
+	if !p.Enabled() {
+		return
+	}
+	p.Update(fmt.Sprintf(format, args...))
+}
+
+// Stop clears the progress line.
+func (p *Progress) Stop() {
+	if !p.Enabled() {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.width > 0 {
+		fmt.Fprintf(p.w, "\r%s\r", strings.Repeat(" ", p.width))
+		p.width = 0
+	}
+}
+
+// isTerminalWriter reports whether w is attached to a terminal.
+func isTerminalWriter(w io.Writer) bool {
+	switch v := w.(type) {
+	case terminalWriter:
+		return v.IsTerminal()
+	case *os.File:
+		info, err := v.Stat()
+		if err != nil {
+			return false
+		}
+		return info.Mode()&os.ModeCharDevice != 0
+	default:
+		return false
+	}
+}
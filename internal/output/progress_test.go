@@ -0,0 +1,94 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// fakeTTY is a buffer that reports itself as a terminal.
+type fakeTTY struct {
+	bytes.Buffer
+}
+
+func (f *fakeTTY) IsTerminal() bool { return true }
+
+func TestProgressSilentWhenNotTerminal(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewProgress(&buf)
+
+	if p.Enabled() {
+		t.Error("Progress should be disabled for a non-TTY writer")
+	}
+	p.Update("Fetching page 1")
+	p.Updatef("Fetching page %d", 2)
+	p.Stop()
+
+	if buf.Len() != 0 {
+		t.Errorf("Expected no output for non-TTY writer, got %q", buf.String())
+	}
+}
+
+func TestProgressRendersOnTerminal(t *testing.T) {
+	EnableColor()
+	SetQuiet(false)
+
+	w := &fakeTTY{}
+	p := NewProgress(w)
+	if !p.Enabled() {
+		t.Fatal("Progress should be enabled for a TTY writer")
+	}
+
+	p.Update("Fetching page 1")
+	p.Updatef("Fetching page %d", 2)
+
+	out := w.String()
+	if !strings.Contains(out, "Fetching page 1") || !strings.Contains(out, "Fetching page 2") {
+		t.Errorf("Expected both updates in output, got %q", out)
+	}
+	if !strings.Contains(out, spinnerFrames[0]) || !strings.Contains(out, spinnerFrames[1]) {
+		t.Errorf("Expected spinner frames to advance, got %q", out)
+	}
+
+	w.Reset()
+	p.Stop()
+	if got := w.String(); !strings.HasPrefix(got, "\r") || strings.TrimSpace(got) != "" {
+		t.Errorf("Stop should clear the line, got %q", got)
+	}
+}
+
+func TestProgressDisabledByQuietAndNoColor(t *testing.T) {
+	defer EnableColor()
+	defer SetQuiet(false)
+
+	tests := []struct {
+		name  string
+		setup func()
+	}{
+		{"quiet", func() { EnableColor(); SetQuiet(true) }},
+		{"no-color", func() { SetQuiet(false); DisableColor() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			w := &fakeTTY{}
+			p := NewProgress(w)
+			p.Update("working")
+			p.Stop()
+			if w.Len() != 0 {
+				t.Errorf("Expected no output, got %q", w.String())
+			}
+		})
+	}
+}
+
+func TestProgressNilSafe(t *testing.T) {
+	var p *Progress
+	p.Update("ignored")
+	p.Updatef("ignored %d", 1)
+	p.Stop()
+	if p.Enabled() {
+		t.Error("nil Progress should report disabled")
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package output
+
+// ttyWidth reports the terminal width as unknown where it cannot be
+// queried.
+func ttyWidth() int {
+	return 0
+}